@@ -11,6 +11,7 @@ import (
 	"os"
 	"path/filepath"
 	"strings"
+	"text/tabwriter"
 
 	"github.com/siderolabs/go-cmd/pkg/cmd"
 	"github.com/spf13/cobra"
@@ -20,14 +21,17 @@ import (
 	"github.com/siderolabs/talos/cmd/installer/pkg/ova"
 	"github.com/siderolabs/talos/cmd/installer/pkg/qemuimg"
 	"github.com/siderolabs/talos/internal/app/machined/pkg/runtime"
+	"github.com/siderolabs/talos/internal/app/machined/pkg/runtime/v1alpha1/board"
 	"github.com/siderolabs/talos/internal/app/machined/pkg/runtime/v1alpha1/platform"
 	"github.com/siderolabs/talos/pkg/archiver"
+	"github.com/siderolabs/talos/pkg/copy"
 	"github.com/siderolabs/talos/pkg/machinery/constants"
 )
 
 var (
 	outputArg   string
 	tarToStdout bool
+	listBoards  bool
 )
 
 // imageCmd represents the image command.
@@ -36,6 +40,14 @@ var imageCmd = &cobra.Command{
 	Short: "",
 	Long:  ``,
 	Run: func(cmd *cobra.Command, args []string) {
+		if listBoards {
+			if err := runListBoards(); err != nil {
+				log.Fatal(err)
+			}
+
+			return
+		}
+
 		if err := runImageCmd(); err != nil {
 			log.Fatal(err)
 		}
@@ -45,9 +57,23 @@ var imageCmd = &cobra.Command{
 func init() {
 	imageCmd.Flags().StringVar(&outputArg, "output", "/out", "The output path")
 	imageCmd.Flags().BoolVar(&tarToStdout, "tar-to-stdout", false, "Tar output and send to stdout")
+	imageCmd.Flags().BoolVar(&listBoards, "list-boards", false, "List the supported boards and exit")
+	imageCmd.Flags().Var(&options.Layout, "layout", "The layout of the board image")
 	rootCmd.AddCommand(imageCmd)
 }
 
+func runListBoards() error {
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 3, ' ', 0)
+
+	fmt.Fprintln(w, "BOARD\tMODEL\tCOMPATIBLE")
+
+	for _, b := range board.Boards() {
+		fmt.Fprintf(w, "%s\t%s\t%s\n", b.Name, b.Model, strings.Join(b.Compatible, " "))
+	}
+
+	return w.Flush()
+}
+
 //nolint:gocyclo
 func runImageCmd() (err error) {
 	p, err := platform.NewPlatform(options.Platform)
@@ -244,32 +270,82 @@ func finalize(platform runtime.Platform, img, arch string) (err error) {
 		}
 	case "metal":
 		if options.Board != constants.BoardNone {
-			name := fmt.Sprintf("metal-%s-%s.img", options.Board, arch)
+			return finalizeBoard(img, arch)
+		}
+
+		name := fmt.Sprintf("metal-%s.tar.gz", arch)
 
-			file = filepath.Join(outputArg, name)
+		if err = tar(name, file, dir); err != nil {
+			return err
+		}
+	}
 
-			err = os.Rename(img, file)
-			if err != nil {
-				return err
-			}
+	return nil
+}
+
+// finalizeBoard compresses the board image, ships the bootloader separately for the NVMe layout, and writes
+// the manifest listing the artifacts with their checksums.
+func finalizeBoard(img, arch string) error {
+	layout := install.LayoutDisk
+
+	if options.Layout != "" {
+		layout = options.Layout
+	}
 
-			log.Println("compressing image")
+	name := fmt.Sprintf("metal-%s-%s", options.Board, arch)
 
-			if err = xz(file); err != nil {
+	if layout != install.LayoutDisk {
+		name = fmt.Sprintf("metal-%s-%s-%s", options.Board, layout, arch)
+	}
+
+	file := filepath.Join(outputArg, name+".img")
+
+	if err := os.Rename(img, file); err != nil {
+		return err
+	}
+
+	log.Println("compressing image")
+
+	if err := xz(file); err != nil {
+		return err
+	}
+
+	artifacts := []string{file + ".xz"}
+
+	if layout == install.LayoutNVMe {
+		b, err := board.NewBoard(options.Board)
+		if err != nil {
+			return err
+		}
+
+		for _, src := range b.(runtime.BoardBootloaderArtifacts).BootloaderArtifacts() {
+			dst := filepath.Join(outputArg, name+"-"+filepath.Base(src))
+
+			log.Printf("copying bootloader %s", filepath.Base(src))
+
+			if err = copy.File(src, dst); err != nil {
 				return err
 			}
 
-			break
+			artifacts = append(artifacts, dst)
 		}
+	}
 
-		name := fmt.Sprintf("metal-%s.tar.gz", arch)
+	manifest := pkg.ArtifactManifest{
+		Board:  options.Board,
+		Layout: string(layout),
+		Arch:   arch,
+	}
 
-		if err = tar(name, file, dir); err != nil {
+	for _, artifact := range artifacts {
+		if err := manifest.Add(artifact); err != nil {
 			return err
 		}
 	}
 
-	return nil
+	log.Println("writing manifest")
+
+	return manifest.Write(filepath.Join(outputArg, name+".manifest.json"))
 }
 
 func tar(filename, src, dir string) error {
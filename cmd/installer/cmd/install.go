@@ -78,6 +78,9 @@ func runInstallCmd() (err error) {
 		if config.Machine().Install().LegacyBIOSSupport() {
 			options.LegacyBIOSSupport = true
 		}
+
+		options.DeviceTreeOverlays = config.Machine().Install().DeviceTreeOverlays()
+		options.BootloaderTarget = config.Machine().Install().BootloaderTarget()
 	}
 
 	return install.Install(p, seq, options)
@@ -0,0 +1,64 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package pkg
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"io"
+	"os"
+	"path/filepath"
+)
+
+// Artifact describes a file produced by the image command.
+type Artifact struct {
+	Name   string `json:"name"`
+	Size   int64  `json:"size"`
+	SHA256 string `json:"sha256"`
+}
+
+// ArtifactManifest lists the artifacts produced for a board image.
+type ArtifactManifest struct {
+	Board     string     `json:"board"`
+	Layout    string     `json:"layout"`
+	Arch      string     `json:"arch"`
+	Artifacts []Artifact `json:"artifacts"`
+}
+
+// Add computes the checksum of the file and appends it to the manifest.
+func (m *ArtifactManifest) Add(path string) error {
+	f, err := os.Open(path)
+	if err != nil {
+		return err
+	}
+
+	defer f.Close() //nolint:errcheck
+
+	h := sha256.New()
+
+	size, err := io.Copy(h, f)
+	if err != nil {
+		return err
+	}
+
+	m.Artifacts = append(m.Artifacts, Artifact{
+		Name:   filepath.Base(path),
+		Size:   size,
+		SHA256: hex.EncodeToString(h.Sum(nil)),
+	})
+
+	return nil
+}
+
+// Write writes the manifest as JSON to the path.
+func (m *ArtifactManifest) Write(path string) error {
+	data, err := json.MarshalIndent(m, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	return os.WriteFile(path, append(data, '\n'), 0o644)
+}
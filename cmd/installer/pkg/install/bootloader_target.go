@@ -0,0 +1,56 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package install
+
+import (
+	"fmt"
+	"log"
+	"os"
+
+	"github.com/siderolabs/talos/internal/app/machined/pkg/runtime"
+	"github.com/siderolabs/talos/internal/app/machined/pkg/runtime/v1alpha1/board"
+	"github.com/siderolabs/talos/internal/pkg/mtd"
+	"github.com/siderolabs/talos/pkg/machinery/constants"
+)
+
+// checkBootloaderTarget validates that the board supports installing the bootloader
+// to the SPI flash, and the MTD device layout fits the bootloader image.
+func checkBootloaderTarget(boardName, target string) error {
+	if boardName == constants.BoardNone {
+		return fmt.Errorf("bootloader target %q is only supported on single board computers", target)
+	}
+
+	b, err := board.NewBoard(boardName)
+	if err != nil {
+		return err
+	}
+
+	spi, ok := b.(runtime.BoardSPIFlash)
+	if !ok || spi.SPIFlashImage() == "" {
+		return fmt.Errorf("board %q doesn't support installing the bootloader to SPI flash", b.Name())
+	}
+
+	dev, err := mtd.Lookup(target)
+	if err != nil {
+		return err
+	}
+
+	if dev.Type != "nor" {
+		return fmt.Errorf("MTD device %q has unsupported type %q, expected nor", target, dev.Type)
+	}
+
+	st, err := os.Stat(spi.SPIFlashImage())
+	if err != nil {
+		return err
+	}
+
+	if st.Size() > dev.Size {
+		return fmt.Errorf("bootloader image %q (%d bytes) doesn't fit into MTD device %q (%d bytes)", spi.SPIFlashImage(), st.Size(), target, dev.Size)
+	}
+
+	log.Printf("bootloader target %q: %s, %d bytes", target, dev.Name, dev.Size)
+
+	return nil
+}
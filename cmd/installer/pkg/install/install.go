@@ -21,6 +21,7 @@ import (
 	"github.com/siderolabs/talos/internal/pkg/mount"
 	"github.com/siderolabs/talos/pkg/machinery/constants"
 	"github.com/siderolabs/talos/pkg/machinery/kernel"
+	metamachinery "github.com/siderolabs/talos/pkg/machinery/meta"
 	"github.com/siderolabs/talos/pkg/version"
 )
 
@@ -38,6 +39,12 @@ type Options struct {
 	Zero              bool
 	LegacyBIOSSupport bool
 	MetaValues        MetaValues
+
+	DeviceTreeOverlays []string
+	BootloaderTarget   string
+
+	// Layout is set when building the disk images, it is recorded in the META, and the upgrades use the recorded layout.
+	Layout Layout
 }
 
 // Install installs Talos.
@@ -199,7 +206,10 @@ func (i *Installer) Install(seq runtime.Sequence) (err error) {
 			return err
 		}
 
-		i.cmdline.Append(constants.KernelParamBoard, b.Name())
+		// the kernel argument takes precedence over the device tree, so it is set only if the board can't be detected
+		if !board.Detectable(b.Name()) {
+			i.cmdline.Append(constants.KernelParamBoard, b.Name())
+		}
 
 		i.cmdline.SetAll(b.KernelArgs().Strings())
 	}
@@ -292,11 +302,56 @@ func (i *Installer) Install(seq runtime.Sequence) (err error) {
 			return err
 		}
 
-		log.Printf("installing U-Boot for %q", b.Name())
+		// the layout is not passed on upgrade, e.g. U-Boot is not written to the disk of the NVMe layout
+		if seq == runtime.SequenceUpgrade && i.options.Layout == "" {
+			if i.options.Layout, err = recordedLayout(); err != nil {
+				return err
+			}
+		}
+
+		// record the board and the layout, so that the upgrades install the same board
+		i.options.MetaValues.values = append(i.options.MetaValues.values,
+			metamachinery.Value{Key: meta.Board, Value: b.Name()},
+			metamachinery.Value{Key: meta.BoardLayout, Value: i.options.Layout.String()},
+		)
 
-		if err = b.Install(i.options.Disk); err != nil {
+		switch {
+		case i.options.Layout == LayoutNVMe:
+			log.Printf("skipping U-Boot for %q, the bootloader is shipped separately", b.Name())
+
+			if err = b.(runtime.BoardBootloaderArtifacts).InstallBootAssets(); err != nil {
+				return err
+			}
+		case i.options.BootloaderTarget != "":
+			log.Printf("installing U-Boot for %q to %s", b.Name(), i.options.BootloaderTarget)
+
+			if err = b.(runtime.BoardSPIFlash).InstallSPIFlash(i.options.BootloaderTarget); err != nil {
+				return err
+			}
+		default:
+			log.Printf("installing U-Boot for %q", b.Name())
+
+			if err = b.Install(i.options.Disk); err != nil {
+				return err
+			}
+		}
+
+		if err = i.installDeviceTreeOverlays(b); err != nil {
 			return err
 		}
+
+		// the version of the bootloader shipped separately is unknown
+		if bootloader, ok := b.(runtime.BoardBootloader); ok && i.options.Layout != LayoutNVMe {
+			var version string
+
+			if version, err = bootloader.BootloaderVersion(); err != nil {
+				return fmt.Errorf("error reading U-Boot version: %w", err)
+			}
+
+			if version != "" {
+				i.options.MetaValues.values = append(i.options.MetaValues.values, metamachinery.Value{Key: meta.BootloaderVersion, Value: version})
+			}
+		}
 	}
 
 	if seq == runtime.SequenceUpgrade || len(i.options.MetaValues.values) > 0 {
@@ -328,7 +383,25 @@ func (i *Installer) Install(seq runtime.Sequence) (err error) {
 	return nil
 }
 
+// recordedLayout returns the board layout recorded in the META by the previous install.
+func recordedLayout() (Layout, error) {
+	metaState, err := meta.New(context.Background(), nil)
+	if err != nil {
+		return "", err
+	}
+
+	layout, _ := metaState.ReadTag(meta.BoardLayout)
+
+	return Layout(layout), nil
+}
+
 func (i *Installer) runPreflightChecks(seq runtime.Sequence) error {
+	if i.options.BootloaderTarget != "" {
+		if err := checkBootloaderTarget(i.options.Board, i.options.BootloaderTarget); err != nil {
+			return fmt.Errorf("bootloader target pre-flight check failed: %w", err)
+		}
+	}
+
 	if seq != runtime.SequenceUpgrade {
 		// pre-flight checks only apply to upgrades
 		return nil
@@ -0,0 +1,101 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package install
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/spf13/pflag"
+
+	"github.com/siderolabs/talos/internal/app/machined/pkg/runtime"
+)
+
+// Layout is the layout of the disk image for a board.
+type Layout string
+
+// Supported layouts.
+const (
+	// LayoutDisk is the image for SD cards and eMMC: the bootloader is written to the image.
+	LayoutDisk Layout = "disk"
+	// LayoutNVMe is the image for NVMe drives: the bootloader is shipped separately, e.g. to be written to the SPI flash.
+	LayoutNVMe Layout = "nvme"
+	// LayoutUSBInstaller is the bootable image without the STATE and EPHEMERAL partitions: like the ISO, it installs
+	// Talos to the machine.install.disk (e.g. the eMMC) on first boot.
+	LayoutUSBInstaller Layout = "usb-installer"
+)
+
+// Layouts lists the supported layouts.
+var Layouts = []Layout{LayoutDisk, LayoutNVMe, LayoutUSBInstaller}
+
+// Interface check.
+var _ pflag.Value = new(Layout)
+
+// Set implements pflag.Value.
+func (l *Layout) Set(val string) error {
+	for _, layout := range Layouts {
+		if Layout(val) == layout {
+			*l = layout
+
+			return nil
+		}
+	}
+
+	return fmt.Errorf("unsupported layout %q, supported layouts: %s", val, l.Type())
+}
+
+// Type implements pflag.Value.
+func (l *Layout) Type() string {
+	layouts := make([]string, len(Layouts))
+
+	for i, layout := range Layouts {
+		layouts[i] = string(layout)
+	}
+
+	return strings.Join(layouts, "|")
+}
+
+// String implements pflag.Value.
+func (l *Layout) String() string {
+	if *l == "" {
+		return string(LayoutDisk)
+	}
+
+	return string(*l)
+}
+
+// PartitionOptions returns the partition options of the layout for the board.
+//
+// The NVMe layout doesn't reserve space for the bootloader, so the default partition offset is used.
+func (l Layout) PartitionOptions(b runtime.Board) *runtime.PartitionOptions {
+	if l == LayoutNVMe {
+		return nil
+	}
+
+	return b.PartitionOptions()
+}
+
+// SystemPartitions reports whether the layout contains the STATE and EPHEMERAL partitions.
+func (l Layout) SystemPartitions() bool {
+	return l != LayoutUSBInstaller
+}
+
+// Check verifies that the layout is supported by the board.
+func (l Layout) Check(b runtime.Board) error {
+	if l != LayoutNVMe {
+		return nil
+	}
+
+	if artifacts, ok := b.(runtime.BoardBootloaderArtifacts); !ok || len(artifacts.BootloaderArtifacts()) == 0 {
+		return fmt.Errorf("board %q doesn't support the %q layout", b.Name(), l)
+	}
+
+	// the board has to boot from the onboard SPI flash, as the bootloader is not written to the NVMe drive
+	if spi, ok := b.(runtime.BoardSPIFlash); !ok || spi.SPIFlashImage() == "" {
+		return fmt.Errorf("board %q doesn't support the %q layout", b.Name(), l)
+	}
+
+	return nil
+}
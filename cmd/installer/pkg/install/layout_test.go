@@ -0,0 +1,55 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package install_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/siderolabs/talos/cmd/installer/pkg/install"
+	"github.com/siderolabs/talos/internal/app/machined/pkg/runtime/v1alpha1/board"
+	"github.com/siderolabs/talos/pkg/machinery/constants"
+)
+
+func TestLayout(t *testing.T) {
+	t.Parallel()
+
+	var l install.Layout
+
+	assert.Equal(t, "disk", l.String())
+
+	require.NoError(t, l.Set("nvme"))
+	assert.Equal(t, install.LayoutNVMe, l)
+
+	assert.EqualError(t, l.Set("floppy"), `unsupported layout "floppy", supported layouts: disk|nvme|usb-installer`)
+
+	rock64, err := board.NewBoard(constants.BoardRock64)
+	require.NoError(t, err)
+
+	assert.Equal(t, uint64(2048*10), install.LayoutDisk.PartitionOptions(rock64).PartitionsOffset)
+	assert.Equal(t, uint64(2048*10), install.LayoutUSBInstaller.PartitionOptions(rock64).PartitionsOffset)
+	assert.Nil(t, install.LayoutNVMe.PartitionOptions(rock64))
+
+	assert.True(t, install.LayoutDisk.SystemPartitions())
+	assert.True(t, install.LayoutNVMe.SystemPartitions())
+	assert.False(t, install.LayoutUSBInstaller.SystemPartitions())
+
+	// rock64 boots only from the disk
+	assert.NoError(t, install.LayoutDisk.Check(rock64))
+	assert.EqualError(t, install.LayoutNVMe.Check(rock64), `board "rock64" doesn't support the "nvme" layout`)
+
+	rock5b, err := board.NewBoard(constants.BoardRock5b)
+	require.NoError(t, err)
+
+	assert.NoError(t, install.LayoutNVMe.Check(rock5b))
+
+	rpi4, err := board.NewBoard(constants.BoardRPi4)
+	require.NoError(t, err)
+
+	assert.NoError(t, install.LayoutDisk.Check(rpi4))
+	assert.EqualError(t, install.LayoutNVMe.Check(rpi4), `board "rpi_4" doesn't support the "nvme" layout`)
+}
@@ -66,7 +66,11 @@ func NewManifest(label string, sequence runtime.Sequence, bootPartitionFound boo
 			return nil, err
 		}
 
-		manifest.PartitionOptions = b.PartitionOptions()
+		if err = opts.Layout.Check(b); err != nil {
+			return nil, err
+		}
+
+		manifest.PartitionOptions = opts.Layout.PartitionOptions(b)
 	}
 
 	// TODO: legacy, to support old Talos initramfs, assume force if boot partition not found
@@ -186,16 +190,20 @@ func NewManifest(label string, sequence runtime.Sequence, bootPartitionFound boo
 		PreserveContents: bootPartitionFound,
 	})
 
-	stateTarget := StateTarget(opts.Disk, &Target{
-		PreserveContents: bootPartitionFound,
-		FormatOptions: &partition.FormatOptions{
-			FileSystemType: partition.FilesystemTypeNone,
-		},
-	})
+	targets = append(targets, bootTarget, metaTarget)
 
-	ephemeralTarget := EphemeralTarget(opts.Disk, NoFilesystem)
+	if opts.Layout.SystemPartitions() {
+		stateTarget := StateTarget(opts.Disk, &Target{
+			PreserveContents: bootPartitionFound,
+			FormatOptions: &partition.FormatOptions{
+				FileSystemType: partition.FilesystemTypeNone,
+			},
+		})
 
-	targets = append(targets, bootTarget, metaTarget, stateTarget, ephemeralTarget)
+		ephemeralTarget := EphemeralTarget(opts.Disk, NoFilesystem)
+
+		targets = append(targets, stateTarget, ephemeralTarget)
+	}
 
 	if !opts.Force {
 		for _, target := range targets {
@@ -0,0 +1,89 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package install
+
+import (
+	"fmt"
+	"log"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/siderolabs/talos/internal/app/machined/pkg/runtime"
+	"github.com/siderolabs/talos/internal/pkg/devicetree"
+	"github.com/siderolabs/talos/internal/pkg/extensions"
+	"github.com/siderolabs/talos/pkg/machinery/constants"
+)
+
+// installDeviceTreeOverlays applies the configured device tree overlays to the
+// device tree blob installed by the board.
+func (i *Installer) installDeviceTreeOverlays(b runtime.Board) error {
+	if len(i.options.DeviceTreeOverlays) == 0 {
+		return nil
+	}
+
+	boardDeviceTree, ok := b.(runtime.BoardDeviceTree)
+	if !ok || boardDeviceTree.DeviceTree() == "" {
+		return fmt.Errorf("board %q does not support device tree overlays", b.Name())
+	}
+
+	dtb := boardDeviceTree.DeviceTree()
+
+	searchPaths, err := deviceTreeOverlaySearchPaths(i.options.Arch, dtb)
+	if err != nil {
+		return err
+	}
+
+	overlays := make([]string, 0, len(i.options.DeviceTreeOverlays))
+
+	for _, name := range i.options.DeviceTreeOverlays {
+		path, err := findDeviceTreeOverlay(name, searchPaths)
+		if err != nil {
+			return err
+		}
+
+		log.Printf("applying device tree overlay %s", path)
+
+		overlays = append(overlays, path)
+	}
+
+	return devicetree.ApplyOverlayFiles(filepath.Join(constants.EFIMountPoint, dtb), overlays...)
+}
+
+// deviceTreeOverlaySearchPaths returns the directories to look up the overlays in:
+// the overlay directory next to the device tree blob in the installer image, and
+// the overlay directories of the system extensions.
+func deviceTreeOverlaySearchPaths(arch, dtb string) ([]string, error) {
+	searchPaths := []string{
+		filepath.Join("/usr/install", arch, filepath.Dir(dtb), "overlay"),
+	}
+
+	extensionsList, err := extensions.List(constants.SystemExtensionsPath)
+	if err != nil {
+		return nil, fmt.Errorf("error listing extensions: %w", err)
+	}
+
+	for _, ext := range extensionsList {
+		searchPaths = append(searchPaths, ext.DeviceTreeOverlaysDirectory())
+	}
+
+	return searchPaths, nil
+}
+
+func findDeviceTreeOverlay(name string, searchPaths []string) (string, error) {
+	if !strings.HasSuffix(name, ".dtbo") {
+		name += ".dtbo"
+	}
+
+	for _, dir := range searchPaths {
+		path := filepath.Join(dir, name)
+
+		if _, err := os.Stat(path); err == nil {
+			return path, nil
+		}
+	}
+
+	return "", fmt.Errorf("device tree overlay %q not found in %s", name, strings.Join(searchPaths, ", "))
+}
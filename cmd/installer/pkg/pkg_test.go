@@ -4,11 +4,53 @@
 
 package pkg_test
 
-import "testing"
+import (
+	"os"
+	"path/filepath"
+	"testing"
 
-func TestEmpty(t *testing.T) {
-	// added for accurate coverage estimation
-	//
-	// please remove it once any unit-test is added
-	// for this package
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/siderolabs/talos/cmd/installer/pkg"
+)
+
+func TestArtifactManifest(t *testing.T) {
+	dir := t.TempDir()
+
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "metal-rock_5b-nvme-arm64.img.xz"), []byte("image"), 0o644))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "metal-rock_5b-nvme-arm64-u-boot-rockchip-spi.bin"), []byte("u-boot"), 0o644))
+
+	manifest := pkg.ArtifactManifest{
+		Board:  "rock_5b",
+		Layout: "nvme",
+		Arch:   "arm64",
+	}
+
+	require.NoError(t, manifest.Add(filepath.Join(dir, "metal-rock_5b-nvme-arm64.img.xz")))
+	require.NoError(t, manifest.Add(filepath.Join(dir, "metal-rock_5b-nvme-arm64-u-boot-rockchip-spi.bin")))
+	assert.Error(t, manifest.Add(filepath.Join(dir, "missing")))
+
+	require.NoError(t, manifest.Write(filepath.Join(dir, "metal-rock_5b-nvme-arm64.manifest.json")))
+
+	contents, err := os.ReadFile(filepath.Join(dir, "metal-rock_5b-nvme-arm64.manifest.json"))
+	require.NoError(t, err)
+
+	assert.JSONEq(t, `{
+		"board": "rock_5b",
+		"layout": "nvme",
+		"arch": "arm64",
+		"artifacts": [
+			{
+				"name": "metal-rock_5b-nvme-arm64.img.xz",
+				"size": 5,
+				"sha256": "6105d6cc76af400325e94d588ce511be5bfdbb73b437dc51eca43917d7a43e3d"
+			},
+			{
+				"name": "metal-rock_5b-nvme-arm64-u-boot-rockchip-spi.bin",
+				"size": 6,
+				"sha256": "fdd9d7dafdf5d9f56032ef62548ba1d9b6752d0eca84e21556790a28be916329"
+			}
+		]
+	}`, string(contents))
 }
@@ -71,11 +71,14 @@ var genConfigCmdFlags struct {
 	withClusterDiscovery    bool
 	withKubeSpan            bool
 	withSecrets             string
+	inventory               string
+	board                   string
+	arch                    string
 }
 
 // NewConfigCmd builds the config generation subcommand with the given name.
 func NewConfigCmd(name string) *cobra.Command {
-	return &cobra.Command{
+	cmd := &cobra.Command{
 		Use:   fmt.Sprintf("%s <cluster name> <cluster endpoint>", name),
 		Short: "Generates a set of configuration files for Talos cluster",
 		Long: `The cluster endpoint is the URL for the Kubernetes API. If you decide to use
@@ -97,6 +100,36 @@ setup, usually involving a load balancer, use the IP and port of the load balanc
 			}
 		},
 	}
+
+	cmd.Flags().StringVar(&genConfigCmdFlags.installDisk, "install-disk", "/dev/sda", "the disk to install to")
+	cmd.Flags().StringVar(&genConfigCmdFlags.installImage, "install-image", helpers.DefaultImage(images.DefaultInstallerImageRepository), "the image used to perform an installation")
+	cmd.Flags().StringSliceVar(&genConfigCmdFlags.additionalSANs, "additional-sans", []string{}, "additional Subject-Alt-Names for the APIServer certificate")
+	cmd.Flags().StringVar(&genConfigCmdFlags.dnsDomain, "dns-domain", "cluster.local", "the dns domain to use for cluster")
+	cmd.Flags().StringVar(&genConfigCmdFlags.configVersion, "version", "v1alpha1", "the desired machine config version to generate")
+	cmd.Flags().StringVar(&genConfigCmdFlags.talosVersion, "talos-version", "", "the desired Talos version to generate config for (backwards compatibility, e.g. v0.8)")
+	cmd.Flags().StringVar(&genConfigCmdFlags.kubernetesVersion, "kubernetes-version", constants.DefaultKubernetesVersion, "desired kubernetes version to run")
+	cmd.Flags().StringArrayVar(&genConfigCmdFlags.configPatch, "config-patch", nil, "patch generated machineconfigs (applied to all node types), use @file to read a patch from file or @dir to read all patches from the directory")
+	cmd.Flags().StringArrayVar(&genConfigCmdFlags.configPatchControlPlane, "config-patch-control-plane", nil, "patch generated machineconfigs (applied to 'init' and 'controlplane' types)")
+	cmd.Flags().StringArrayVar(&genConfigCmdFlags.configPatchWorker, "config-patch-worker", nil, "patch generated machineconfigs (applied to 'worker' type)")
+	cmd.Flags().StringSliceVar(&genConfigCmdFlags.registryMirrors, "registry-mirror", []string{}, "list of registry mirrors to use in format: <registry host>=<mirror URL>")
+	cmd.Flags().BoolVarP(&genConfigCmdFlags.persistConfig, "persist", "p", true, "the desired persist value for configs")
+	cmd.Flags().BoolVarP(&genConfigCmdFlags.withExamples, "with-examples", "", true, "renders all machine configs with the commented examples")
+	cmd.Flags().BoolVarP(&genConfigCmdFlags.withDocs, "with-docs", "", true, "renders all machine configs adding the documentation for each field")
+	cmd.Flags().BoolVarP(&genConfigCmdFlags.withClusterDiscovery, "with-cluster-discovery", "", true, "enable cluster discovery feature")
+	cmd.Flags().BoolVarP(&genConfigCmdFlags.withKubeSpan, "with-kubespan", "", false, "enable KubeSpan feature")
+	cmd.Flags().StringVar(&genConfigCmdFlags.withSecrets, "with-secrets", "", "use a secrets file generated using 'gen secrets'")
+	cmd.Flags().StringVar(&genConfigCmdFlags.inventory, "inventory", "",
+		"render a config per node of the inventory file (YAML or CSV), config patches are templates rendered with the node variables")
+	cmd.Flags().StringVar(&genConfigCmdFlags.board, "board", "", "the board name to match the config patch selectors against (overridden by the 'board' inventory variable)")
+	cmd.Flags().StringVar(&genConfigCmdFlags.arch, "arch", "", "the architecture to match the config patch selectors against (overridden by the 'arch' inventory variable)")
+
+	cmd.Flags().StringSliceVarP(&genConfigCmdFlags.outputTypes, "output-types", "t", allOutputTypes, fmt.Sprintf("types of outputs to be generated. valid types are: %q", allOutputTypes))
+	cmd.Flags().StringVarP(&genConfigCmdFlags.output, "output", "o", "",
+		`destination to output generated files. when multiple output types are specified, it must be a directory. for a single output type, it must either be a file path, or "-" for stdout`)
+	cmd.Flags().StringVar(&genConfigCmdFlags.outputDir, "output-dir", "", "destination to output generated files") // kept for backwards compatibility
+	cmd.Flags().MarkHidden("output-dir")                                                                           //nolint:errcheck
+
+	return cmd
 }
 
 func fixControlPlaneEndpoint(u *url.URL) *url.URL {
@@ -125,6 +158,7 @@ func V1Alpha1Config(genOptions []generate.GenOption,
 	configPatch []string,
 	configPatchControlPlane []string,
 	configPatchWorker []string,
+	patchOptions ...configpatcher.ApplyOption,
 ) (*bundle.ConfigBundle, error) {
 	configBundleOpts := []bundle.Option{
 		bundle.WithInputOptions(
@@ -135,6 +169,7 @@ func V1Alpha1Config(genOptions []generate.GenOption,
 				GenOptions:  genOptions,
 			},
 		),
+		bundle.WithPatchOptions(patchOptions...),
 	}
 
 	addConfigPatch := func(configPatches []string, configOpt func([]configpatcher.Patch) bundle.Option) error {
@@ -234,6 +269,18 @@ func writeV1Alpha1Config(args []string) error {
 		commentsFlags |= encoder.CommentsExamples
 	}
 
+	if genConfigCmdFlags.inventory != "" {
+		// patches are templates rendered per node of the inventory
+		var configBundle *bundle.ConfigBundle
+
+		configBundle, err = V1Alpha1Config(genOptions, args[0], args[1], genConfigCmdFlags.kubernetesVersion, nil, nil, nil)
+		if err != nil {
+			return err
+		}
+
+		return writeInventoryConfigs(configBundle, paths, commentsFlags)
+	}
+
 	configBundle, err := V1Alpha1Config(
 		genOptions,
 		args[0],
@@ -241,7 +288,10 @@ func writeV1Alpha1Config(args []string) error {
 		genConfigCmdFlags.kubernetesVersion,
 		genConfigCmdFlags.configPatch,
 		genConfigCmdFlags.configPatchControlPlane,
-		genConfigCmdFlags.configPatchWorker)
+		genConfigCmdFlags.configPatchWorker,
+		configpatcher.WithBoard(genConfigCmdFlags.board),
+		configpatcher.WithArch(genConfigCmdFlags.arch),
+	)
 	if err != nil {
 		return err
 	}
@@ -262,6 +312,10 @@ func validateFlags() error {
 		return fmt.Errorf("can't use both output-dir and output")
 	}
 
+	if genConfigCmdFlags.inventory != "" && genConfigCmdFlags.output == stdoutOutput {
+		return fmt.Errorf("can't use inventory with stdout")
+	}
+
 	if genConfigCmdFlags.outputDir != "" {
 		genConfigCmdFlags.output = genConfigCmdFlags.outputDir
 	}
@@ -367,8 +421,8 @@ func outputPaths() (configOutputPaths, error) {
 	// output is specified
 
 	// if a single output type is specified, treat --output as a file path and not a directory
-	// except when the deprecated flag of --output-dir is specified - it is always treated as a directory
-	if len(genConfigCmdFlags.outputTypes) == 1 && genConfigCmdFlags.outputDir == "" { // specified output is a file
+	// except when the deprecated flag of --output-dir is specified or the inventory is used - it is always treated as a directory
+	if len(genConfigCmdFlags.outputTypes) == 1 && genConfigCmdFlags.outputDir == "" && genConfigCmdFlags.inventory == "" { // specified output is a file
 		return configOutputPaths{
 			controlPlane: genConfigCmdFlags.output,
 			worker:       genConfigCmdFlags.output,
@@ -418,31 +472,5 @@ func validateClusterEndpoint(endpoint string) error {
 }
 
 func init() {
-	genConfigCmd := NewConfigCmd("config")
-
-	genConfigCmd.Flags().StringVar(&genConfigCmdFlags.installDisk, "install-disk", "/dev/sda", "the disk to install to")
-	genConfigCmd.Flags().StringVar(&genConfigCmdFlags.installImage, "install-image", helpers.DefaultImage(images.DefaultInstallerImageRepository), "the image used to perform an installation")
-	genConfigCmd.Flags().StringSliceVar(&genConfigCmdFlags.additionalSANs, "additional-sans", []string{}, "additional Subject-Alt-Names for the APIServer certificate")
-	genConfigCmd.Flags().StringVar(&genConfigCmdFlags.dnsDomain, "dns-domain", "cluster.local", "the dns domain to use for cluster")
-	genConfigCmd.Flags().StringVar(&genConfigCmdFlags.configVersion, "version", "v1alpha1", "the desired machine config version to generate")
-	genConfigCmd.Flags().StringVar(&genConfigCmdFlags.talosVersion, "talos-version", "", "the desired Talos version to generate config for (backwards compatibility, e.g. v0.8)")
-	genConfigCmd.Flags().StringVar(&genConfigCmdFlags.kubernetesVersion, "kubernetes-version", constants.DefaultKubernetesVersion, "desired kubernetes version to run")
-	genConfigCmd.Flags().StringArrayVar(&genConfigCmdFlags.configPatch, "config-patch", nil, "patch generated machineconfigs (applied to all node types), use @file to read a patch from file")
-	genConfigCmd.Flags().StringArrayVar(&genConfigCmdFlags.configPatchControlPlane, "config-patch-control-plane", nil, "patch generated machineconfigs (applied to 'init' and 'controlplane' types)")
-	genConfigCmd.Flags().StringArrayVar(&genConfigCmdFlags.configPatchWorker, "config-patch-worker", nil, "patch generated machineconfigs (applied to 'worker' type)")
-	genConfigCmd.Flags().StringSliceVar(&genConfigCmdFlags.registryMirrors, "registry-mirror", []string{}, "list of registry mirrors to use in format: <registry host>=<mirror URL>")
-	genConfigCmd.Flags().BoolVarP(&genConfigCmdFlags.persistConfig, "persist", "p", true, "the desired persist value for configs")
-	genConfigCmd.Flags().BoolVarP(&genConfigCmdFlags.withExamples, "with-examples", "", true, "renders all machine configs with the commented examples")
-	genConfigCmd.Flags().BoolVarP(&genConfigCmdFlags.withDocs, "with-docs", "", true, "renders all machine configs adding the documentation for each field")
-	genConfigCmd.Flags().BoolVarP(&genConfigCmdFlags.withClusterDiscovery, "with-cluster-discovery", "", true, "enable cluster discovery feature")
-	genConfigCmd.Flags().BoolVarP(&genConfigCmdFlags.withKubeSpan, "with-kubespan", "", false, "enable KubeSpan feature")
-	genConfigCmd.Flags().StringVar(&genConfigCmdFlags.withSecrets, "with-secrets", "", "use a secrets file generated using 'gen secrets'")
-
-	genConfigCmd.Flags().StringSliceVarP(&genConfigCmdFlags.outputTypes, "output-types", "t", allOutputTypes, fmt.Sprintf("types of outputs to be generated. valid types are: %q", allOutputTypes))
-	genConfigCmd.Flags().StringVarP(&genConfigCmdFlags.output, "output", "o", "",
-		`destination to output generated files. when multiple output types are specified, it must be a directory. for a single output type, it must either be a file path, or "-" for stdout`)
-	genConfigCmd.Flags().StringVar(&genConfigCmdFlags.outputDir, "output-dir", "", "destination to output generated files") // kept for backwards compatibility
-	genConfigCmd.Flags().MarkHidden("output-dir")                                                                           //nolint:errcheck
-
-	Cmd.AddCommand(genConfigCmd)
+	Cmd.AddCommand(NewConfigCmd("config"))
 }
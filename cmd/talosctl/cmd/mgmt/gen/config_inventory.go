@@ -0,0 +1,137 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package gen
+
+import (
+	"fmt"
+	"path/filepath"
+
+	"github.com/siderolabs/gen/slices"
+	"gopkg.in/yaml.v3"
+
+	"github.com/siderolabs/talos/internal/app/machined/pkg/runtime"
+	"github.com/siderolabs/talos/pkg/cli"
+	"github.com/siderolabs/talos/pkg/machinery/config"
+	"github.com/siderolabs/talos/pkg/machinery/config/configpatcher"
+	"github.com/siderolabs/talos/pkg/machinery/config/encoder"
+	"github.com/siderolabs/talos/pkg/machinery/config/inventory"
+	"github.com/siderolabs/talos/pkg/machinery/config/secretref"
+	"github.com/siderolabs/talos/pkg/machinery/config/types/v1alpha1/bundle"
+	"github.com/siderolabs/talos/pkg/machinery/config/types/v1alpha1/machine"
+)
+
+// writeInventoryConfigs renders and validates a config per node of the inventory.
+//
+// The configs are written to the output directory as <node name>.yaml, all the configs are rendered
+// before anything is written, so that an invalid node doesn't leave a partial output.
+func writeInventoryConfigs(configBundle *bundle.ConfigBundle, outputPaths configOutputPaths, commentsFlags encoder.CommentsFlags) error {
+	inv, err := inventory.Load(genConfigCmdFlags.inventory)
+	if err != nil {
+		return fmt.Errorf("error loading inventory: %w", err)
+	}
+
+	outputTypesSet := slices.ToSet(genConfigCmdFlags.outputTypes)
+	outputDir := filepath.Dir(outputPaths.controlPlane)
+
+	rendered := map[string][]byte{}
+
+	for _, node := range inv.Nodes {
+		machineType, _ := node.MachineType() //nolint:errcheck // validated by the inventory
+
+		if _, ok := outputTypesSet[machineType.String()]; !ok {
+			continue
+		}
+
+		data, err := renderInventoryConfig(configBundle, node, machineType, commentsFlags)
+		if err != nil {
+			return err
+		}
+
+		rendered[filepath.Join(outputDir, node.Name+".yaml")] = data
+	}
+
+	for _, node := range inv.Nodes {
+		path := filepath.Join(outputDir, node.Name+".yaml")
+
+		if data, ok := rendered[path]; ok {
+			if err = writeToDestination(data, path, 0o644); err != nil {
+				return err
+			}
+		}
+	}
+
+	if _, ok := outputTypesSet[talosconfigOutputType]; ok {
+		data, err := yaml.Marshal(configBundle.TalosConfig())
+		if err != nil {
+			return fmt.Errorf("failed to marshal config: %+v", err)
+		}
+
+		if err = writeToDestination(data, outputPaths.talosconfig, 0o644); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func renderInventoryConfig(configBundle *bundle.ConfigBundle, node inventory.Node, machineType machine.Type, commentsFlags encoder.CommentsFlags) ([]byte, error) {
+	var (
+		base      config.Provider
+		templates []string
+	)
+
+	templates = append(templates, genConfigCmdFlags.configPatch...)
+
+	switch machineType { //nolint:exhaustive
+	case machine.TypeControlPlane:
+		base = configBundle.ControlPlane()
+		templates = append(templates, genConfigCmdFlags.configPatchControlPlane...)
+	case machine.TypeWorker:
+		base = configBundle.Worker()
+		templates = append(templates, genConfigCmdFlags.configPatchWorker...)
+	}
+
+	patches, err := node.RenderPatches(templates)
+	if err != nil {
+		return nil, err
+	}
+
+	board, arch := genConfigCmdFlags.board, genConfigCmdFlags.arch
+
+	if value, ok := node.Variables["board"]; ok {
+		board = value
+	}
+
+	if value, ok := node.Variables["arch"]; ok {
+		arch = value
+	}
+
+	patched, err := configpatcher.Apply(configpatcher.WithConfig(base), patches, configpatcher.WithBoard(board), configpatcher.WithArch(arch))
+	if err != nil {
+		return nil, fmt.Errorf("error patching config for node %q: %w", node.Name, err)
+	}
+
+	cfg, err := patched.Config()
+	if err != nil {
+		return nil, fmt.Errorf("error patching config for node %q: %w", node.Name, err)
+	}
+
+	// validate locally, as the install disk is not available on the host running talosctl
+	warnings, err := cfg.Validate(runtime.ModeMetal, config.WithLocal())
+	if err != nil {
+		return nil, fmt.Errorf("config for node %q is invalid: %w", node.Name, err)
+	}
+
+	for _, w := range warnings {
+		cli.Warning("node %q: %s", node.Name, w)
+	}
+
+	data, err := cfg.EncodeBytes(encoder.WithComments(commentsFlags))
+	if err != nil {
+		return nil, err
+	}
+
+	return secretref.RestoreValuesYAML(data, configBundle.SecretReferences)
+}
@@ -0,0 +1,57 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package gen
+
+import (
+	"fmt"
+	"os"
+
+	"filippo.io/age"
+	"github.com/spf13/cobra"
+
+	"github.com/siderolabs/talos/pkg/machinery/config/secretref"
+)
+
+var genSecretKeyCmdFlags struct {
+	outputFile string
+}
+
+// genSecretKeyCmd represents the `gen secretkey` command.
+var genSecretKeyCmd = &cobra.Command{
+	Use:   "secretkey",
+	Short: "Generates an age key to encrypt the secrets in the machine configuration",
+	Long: `The key file is used to decrypt the secrets, it is loaded from the path set in the $TALOS_SECRET_KEY_FILE,
+and from /system/state/secret.key on the node.
+
+The key is provisioned on the node via the META key 0x10, e.g. with 'talosctl meta write 0x10 "$(cat secret.key)"'
+(also available in the maintenance mode), or with the '--meta 0x10=...' flag of the installer and imager.
+
+The recipient printed to stdout is used to encrypt the secrets with 'gen secrets --encrypt-for'.`,
+	Args: cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		identity, err := age.GenerateX25519Identity()
+		if err != nil {
+			return fmt.Errorf("error generating key: %w", err)
+		}
+
+		if err = validateFileExists(genSecretKeyCmdFlags.outputFile); err != nil {
+			return err
+		}
+
+		if err = os.WriteFile(genSecretKeyCmdFlags.outputFile, secretref.FormatIdentity(identity), 0o600); err != nil {
+			return fmt.Errorf("error writing key: %w", err)
+		}
+
+		fmt.Println(identity.Recipient())
+
+		return nil
+	},
+}
+
+func init() {
+	genSecretKeyCmd.Flags().StringVarP(&genSecretKeyCmdFlags.outputFile, "output-file", "o", "secret.key", "path of the output file")
+
+	Cmd.AddCommand(genSecretKeyCmd)
+}
@@ -12,6 +12,7 @@ import (
 	"gopkg.in/yaml.v3"
 
 	"github.com/siderolabs/talos/pkg/machinery/config"
+	"github.com/siderolabs/talos/pkg/machinery/config/secretref"
 	"github.com/siderolabs/talos/pkg/machinery/config/types/v1alpha1/generate"
 )
 
@@ -20,6 +21,17 @@ var genSecretsCmdFlags struct {
 	talosVersion             string
 	fromKubernetesPki        string
 	kubernetesBootstrapToken string
+	encryptFor               []string
+}
+
+// secretsBundleFields are the fields of the secrets bundle encrypted with --encrypt-for.
+var secretsBundleFields = map[string]struct{}{
+	"secret":                    {},
+	"bootstraptoken":            {},
+	"aescbcencryptionsecret":    {},
+	"secretboxencryptionsecret": {},
+	"token":                     {},
+	"key":                       {},
 }
 
 // genSecretsCmd represents the `gen secrets` command.
@@ -69,6 +81,12 @@ func writeSecretsBundleToFile(bundle *generate.SecretsBundle) error {
 		return err
 	}
 
+	if len(genSecretsCmdFlags.encryptFor) > 0 {
+		if bundleBytes, err = encryptSecretsBundle(bundleBytes); err != nil {
+			return err
+		}
+	}
+
 	if err = validateFileExists(genSecretsCmdFlags.outputFile); err != nil {
 		return err
 	}
@@ -76,10 +94,24 @@ func writeSecretsBundleToFile(bundle *generate.SecretsBundle) error {
 	return os.WriteFile(genSecretsCmdFlags.outputFile, bundleBytes, 0o600)
 }
 
+func encryptSecretsBundle(bundleBytes []byte) ([]byte, error) {
+	recipients, err := secretref.ParseRecipients(genSecretsCmdFlags.encryptFor)
+	if err != nil {
+		return nil, err
+	}
+
+	return secretref.EncryptYAML(bundleBytes, recipients, func(path secretref.Path) bool {
+		_, ok := secretsBundleFields[path[len(path)-1]]
+
+		return ok
+	})
+}
+
 func init() {
 	genSecretsCmd.Flags().StringVarP(&genSecretsCmdFlags.outputFile, "output-file", "o", "secrets.yaml", "path of the output file")
 	genSecretsCmd.Flags().StringVar(&genSecretsCmdFlags.talosVersion, "talos-version", "", "the desired Talos version to generate secrets bundle for (backwards compatibility, e.g. v0.8)")
 	genSecretsCmd.Flags().StringVarP(&genSecretsCmdFlags.fromKubernetesPki, "from-kubernetes-pki", "p", "", "use a Kubernetes PKI directory (e.g. /etc/kubernetes/pki) as input")
+	genSecretsCmd.Flags().StringSliceVar(&genSecretsCmdFlags.encryptFor, "encrypt-for", nil, "encrypt the secrets for the age recipients, e.g. generated with 'gen secretkey'")
 	genSecretsCmd.Flags().StringVarP(&genSecretsCmdFlags.kubernetesBootstrapToken, "kubernetes-bootstrap-token", "t", "", "use the provided bootstrap token as input")
 
 	Cmd.AddCommand(genSecretsCmd)
@@ -17,13 +17,20 @@ import (
 var patchCmdFlags struct {
 	patches []string
 	output  string
+	board   string
+	arch    string
 }
 
 // patchCmd represents the `machineconfig patch` command.
 var patchCmd = &cobra.Command{
 	Use:   "patch <machineconfig-file>",
 	Short: "Patch a machine config",
-	Args:  cobra.ExactArgs(1),
+	Long: `Patches a machine config with the patches, use @dir to read all patches from the directory.
+
+The patches might start with a selector document, such patches are applied only to the machine config matching
+the selector. The machine type, hostname and node labels are taken from the patched machine config,
+the board and the architecture are set with the flags.`,
+	Args: cobra.ExactArgs(1),
 	RunE: func(cmd *cobra.Command, args []string) error {
 		data, err := os.ReadFile(args[0])
 		if err != nil {
@@ -35,7 +42,10 @@ var patchCmd = &cobra.Command{
 			return err
 		}
 
-		patched, err := configpatcher.Apply(configpatcher.WithBytes(data), patches)
+		patched, err := configpatcher.Apply(configpatcher.WithBytes(data), patches,
+			configpatcher.WithBoard(patchCmdFlags.board),
+			configpatcher.WithArch(patchCmdFlags.arch),
+		)
 		if err != nil {
 			return err
 		}
@@ -66,7 +76,9 @@ var patchCmd = &cobra.Command{
 
 func init() {
 	// use StringArrayVarP instead of StringSliceVarP to prevent cobra from splitting the patch string on commas
-	patchCmd.Flags().StringArrayVarP(&patchCmdFlags.patches, "patch", "p", nil, "patch generated machineconfigs (applied to all node types), use @file to read a patch from file")
+	patchCmd.Flags().StringArrayVarP(&patchCmdFlags.patches, "patch", "p", nil, "patch generated machineconfigs (applied to all node types), use @file to read a patch from file or @dir to read all patches from the directory")
+	patchCmd.Flags().StringVar(&patchCmdFlags.board, "board", "", "the board name to match the patch selectors against")
+	patchCmd.Flags().StringVar(&patchCmdFlags.arch, "arch", "", "the architecture to match the patch selectors against")
 	patchCmd.Flags().StringVarP(&patchCmdFlags.output, "output", "o", "", "output destination. if not specified, output will be printed to stdout")
 
 	Cmd.AddCommand(patchCmd)
@@ -6,12 +6,16 @@ package talos
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"os"
 	"strings"
+	"text/tabwriter"
 	"time"
 
 	"github.com/spf13/cobra"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/peer"
 	"google.golang.org/protobuf/types/known/durationpb"
 
 	"github.com/siderolabs/talos/cmd/talosctl/pkg/talos/helpers"
@@ -30,6 +34,8 @@ var applyConfigCmdFlags struct {
 	insecure         bool
 	dryRun           bool
 	configTryTimeout time.Duration
+	tryConditions    helpers.TryConditions
+	validateHardware bool
 }
 
 // applyConfigCmd represents the applyConfiguration command.
@@ -137,11 +143,18 @@ var applyConfigCmd = &cobra.Command{
 				return install.Run(conn)
 			}
 
+			if applyConfigCmdFlags.validateHardware {
+				if err := validateConfigHardware(ctx, c, cfgBytes); err != nil {
+					return err
+				}
+			}
+
 			resp, err := c.ApplyConfiguration(ctx, &machineapi.ApplyConfigurationRequest{
 				Data:           cfgBytes,
 				Mode:           applyConfigCmdFlags.Mode.Mode,
 				DryRun:         applyConfigCmdFlags.dryRun,
 				TryModeTimeout: durationpb.New(applyConfigCmdFlags.configTryTimeout),
+				TryConditions:  applyConfigCmdFlags.tryConditions.Request(),
 			})
 			if err != nil {
 				return fmt.Errorf("error applying new configuration: %s", err)
@@ -154,6 +167,57 @@ var applyConfigCmd = &cobra.Command{
 	},
 }
 
+// validateConfigHardware validates the configuration against the node hardware and prints the findings.
+//
+// The configuration is not applied if any of the nodes reports an error.
+func validateConfigHardware(ctx context.Context, c *client.Client, cfgBytes []byte) error {
+	var remotePeer peer.Peer
+
+	resp, err := c.ValidateConfiguration(ctx, &machineapi.ValidateConfigurationRequest{
+		Data: cfgBytes,
+	}, grpc.Peer(&remotePeer))
+	if err != nil {
+		return fmt.Errorf("error validating configuration: %w", err)
+	}
+
+	defaultNode := client.AddrFromPeer(&remotePeer)
+	failed := false
+
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 3, ' ', 0)
+	fmt.Fprintln(w, "NODE\tFIELD\tSEVERITY\tMESSAGE")
+
+	for _, msg := range resp.Messages {
+		node := defaultNode
+
+		if msg.Metadata != nil {
+			node = msg.Metadata.Hostname
+		}
+
+		for _, finding := range msg.Findings {
+			if finding.Severity == machineapi.ValidateConfigurationFinding_ERROR {
+				failed = true
+			}
+
+			field := finding.Field
+			if field == "" {
+				field = "-"
+			}
+
+			fmt.Fprintf(w, "%s\t%s\t%s\t%s\n", node, field, finding.Severity, finding.Message)
+		}
+	}
+
+	if err = w.Flush(); err != nil {
+		return err
+	}
+
+	if failed {
+		return errors.New("configuration doesn't match the node hardware, not applying")
+	}
+
+	return nil
+}
+
 func init() {
 	applyConfigCmd.Flags().StringVarP(&applyConfigCmdFlags.filename, "file", "f", "", "the filename of the updated configuration")
 	applyConfigCmd.Flags().BoolVarP(&applyConfigCmdFlags.insecure, "insecure", "i", false, "apply the config using the insecure (encrypted with no auth) maintenance service")
@@ -161,6 +225,8 @@ func init() {
 	applyConfigCmd.Flags().StringSliceVar(&applyConfigCmdFlags.certFingerprints, "cert-fingerprint", nil, "list of server certificate fingeprints to accept (defaults to no check)")
 	applyConfigCmd.Flags().StringSliceVarP(&applyConfigCmdFlags.patches, "config-patch", "p", nil, "the list of config patches to apply to the local config file before sending it to the node")
 	applyConfigCmd.Flags().DurationVar(&applyConfigCmdFlags.configTryTimeout, "timeout", constants.ConfigTryTimeout, "the config will be rolled back after specified timeout (if try mode is selected)")
+	applyConfigCmd.Flags().BoolVar(&applyConfigCmdFlags.validateHardware, "validate-hardware", false, "validate the config against the node hardware (disks, network devices, kernel modules) before applying it")
 	helpers.AddModeFlags(&applyConfigCmdFlags.Mode, applyConfigCmd)
+	helpers.AddTryConditionsFlags(&applyConfigCmdFlags.tryConditions, applyConfigCmd)
 	addCommand(applyConfigCmd)
 }
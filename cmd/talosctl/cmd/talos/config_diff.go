@@ -0,0 +1,156 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package talos
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/spf13/cobra"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/peer"
+
+	"github.com/siderolabs/talos/pkg/cli"
+	machineapi "github.com/siderolabs/talos/pkg/machinery/api/machine"
+	"github.com/siderolabs/talos/pkg/machinery/client"
+)
+
+var configDiffCmdFlags struct {
+	filename string
+	output   string
+	exitCode bool
+}
+
+// configDiffEntry is a single difference in the machine configuration of the node.
+type configDiffEntry struct {
+	Node    string `json:"node"`
+	Source  string `json:"source"`
+	Path    string `json:"path"`
+	Current string `json:"current"`
+	Desired string `json:"desired"`
+}
+
+// configDiffCmd represents the `config diff` command.
+var configDiffCmd = &cobra.Command{
+	Use:   "diff --file <config>",
+	Short: "Compare the machine configuration with the active and the staged configuration",
+	Long: `Compare the supplied machine configuration with the active and the staged configuration of the nodes.
+
+The differences are reported per path, the secrets are redacted.
+The staged configuration is compared only if it differs from the active one.`,
+	Args: cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		if configDiffCmdFlags.filename == "" {
+			return errors.New("no filename supplied for configuration")
+		}
+
+		switch configDiffCmdFlags.output {
+		case "table", "json":
+		default:
+			return fmt.Errorf("unsupported output format %q", configDiffCmdFlags.output)
+		}
+
+		cfgBytes, err := os.ReadFile(configDiffCmdFlags.filename)
+		if err != nil {
+			return fmt.Errorf("failed to read configuration from %q: %w", configDiffCmdFlags.filename, err)
+		}
+
+		return WithClient(func(ctx context.Context, c *client.Client) error {
+			var remotePeer peer.Peer
+
+			resp, err := c.ConfigDiff(ctx, &machineapi.ConfigDiffRequest{
+				Data: cfgBytes,
+			}, grpc.Peer(&remotePeer))
+			if err != nil {
+				if resp == nil {
+					return fmt.Errorf("error comparing configuration: %w", err)
+				}
+
+				cli.Warning("%s", err)
+			}
+
+			defaultNode := client.AddrFromPeer(&remotePeer)
+
+			entries := []configDiffEntry{}
+
+			for _, msg := range resp.Messages {
+				node := defaultNode
+
+				if msg.Metadata != nil {
+					node = msg.Metadata.Hostname
+				}
+
+				entries = appendConfigDiffEntries(entries, node, "active", msg.Active)
+
+				if msg.Staged {
+					entries = appendConfigDiffEntries(entries, node, "staged", msg.StagedDiff)
+				}
+			}
+
+			if err = printConfigDiff(entries); err != nil {
+				return err
+			}
+
+			if configDiffCmdFlags.exitCode && len(entries) > 0 {
+				return errors.New("configuration differs")
+			}
+
+			return nil
+		})
+	},
+}
+
+func appendConfigDiffEntries(entries []configDiffEntry, node, source string, diff []*machineapi.ConfigDiffEntry) []configDiffEntry {
+	for _, entry := range diff {
+		entries = append(entries, configDiffEntry{
+			Node:    node,
+			Source:  source,
+			Path:    entry.Path,
+			Current: entry.Current,
+			Desired: entry.Desired,
+		})
+	}
+
+	return entries
+}
+
+func printConfigDiff(entries []configDiffEntry) error {
+	if configDiffCmdFlags.output == "json" {
+		enc := json.NewEncoder(os.Stdout)
+		enc.SetIndent("", "  ")
+
+		return enc.Encode(entries)
+	}
+
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 3, ' ', 0)
+	fmt.Fprintln(w, "NODE\tSOURCE\tPATH\tCURRENT\tDESIRED")
+
+	// multi-line values (lists, maps) are folded to keep the table readable
+	fold := strings.NewReplacer("\n", "; ")
+
+	for _, entry := range entries {
+		path := entry.Path
+		if path == "" {
+			path = "."
+		}
+
+		fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\n", entry.Node, entry.Source, path, fold.Replace(entry.Current), fold.Replace(entry.Desired))
+	}
+
+	return w.Flush()
+}
+
+func init() {
+	configDiffCmd.Flags().StringVarP(&configDiffCmdFlags.filename, "file", "f", "", "the filename of the configuration to compare")
+	configDiffCmd.Flags().StringVarP(&configDiffCmdFlags.output, "output", "o", "table", "output format (table|json)")
+	configDiffCmd.Flags().BoolVar(&configDiffCmdFlags.exitCode, "exit-code", false, "exit with an error if the configuration differs")
+
+	configCmd.AddCommand(configDiffCmd)
+}
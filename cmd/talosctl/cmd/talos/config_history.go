@@ -0,0 +1,131 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package talos
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strconv"
+	"text/tabwriter"
+	"time"
+
+	"github.com/spf13/cobra"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/protobuf/types/known/durationpb"
+
+	"github.com/siderolabs/talos/cmd/talosctl/pkg/talos/helpers"
+	"github.com/siderolabs/talos/pkg/cli"
+	"github.com/siderolabs/talos/pkg/machinery/api/machine"
+	"github.com/siderolabs/talos/pkg/machinery/client"
+	"github.com/siderolabs/talos/pkg/machinery/constants"
+)
+
+// configHistoryCmd represents the `config history` command.
+var configHistoryCmd = &cobra.Command{
+	Use:   "history",
+	Short: "Show the history of the configurations applied to the node",
+	Long: fmt.Sprintf(`Show the last %d configurations written to the STATE partition of the node with the time they were applied,
+the identity of the client which applied them (client certificate common name or serial number), and the apply mode.
+
+The configurations saved on boot have the 'boot' mode. Any revision can be restored with 'talosctl config rollback'.`, constants.ConfigHistoryDepth),
+	Args: cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return WithClient(func(ctx context.Context, c *client.Client) error {
+			var remotePeer peer.Peer
+
+			resp, err := c.ConfigHistory(ctx, grpc.Peer(&remotePeer))
+			if err != nil {
+				if resp == nil {
+					return fmt.Errorf("error getting config history: %w", err)
+				}
+
+				cli.Warning("%s", err)
+			}
+
+			defaultNode := client.AddrFromPeer(&remotePeer)
+
+			w := tabwriter.NewWriter(os.Stdout, 0, 0, 3, ' ', 0)
+			fmt.Fprintln(w, "NODE\tREVISION\tAPPLIED\tIDENTITY\tMODE\tROLLBACK OF")
+
+			for _, msg := range resp.Messages {
+				node := defaultNode
+
+				if msg.Metadata != nil {
+					node = msg.Metadata.Hostname
+				}
+
+				for _, rev := range msg.Revisions {
+					identity := rev.Identity
+					if identity == "" {
+						identity = "-"
+					}
+
+					rollbackOf := ""
+					if rev.RollbackOf != 0 {
+						rollbackOf = strconv.FormatInt(rev.RollbackOf, 10)
+					}
+
+					fmt.Fprintf(w, "%s\t%d\t%s\t%s\t%s\t%s\n",
+						node, rev.Id, rev.Timestamp.AsTime().Local().Format(time.RFC3339), identity, rev.Mode, rollbackOf)
+				}
+			}
+
+			return w.Flush()
+		})
+	},
+}
+
+var configRollbackCmdFlags struct {
+	helpers.Mode
+
+	dryRun           bool
+	configTryTimeout time.Duration
+	tryConditions    helpers.TryConditions
+}
+
+// configRollbackCmd represents the `config rollback` command.
+var configRollbackCmd = &cobra.Command{
+	Use:   "rollback <revision>",
+	Short: "Apply the configuration revision from the history",
+	Long: `Apply the configuration revision from the node configuration history ('talosctl config history').
+
+The revision is applied the same way as with 'talosctl apply-config', and it is recorded in the history as a new revision.`,
+	Args: cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		revision, err := strconv.ParseInt(args[0], 10, 64)
+		if err != nil || revision <= 0 {
+			return fmt.Errorf("invalid revision %q", args[0])
+		}
+
+		return WithClient(func(ctx context.Context, c *client.Client) error {
+			resp, err := c.ConfigRollback(ctx, &machine.ConfigRollbackRequest{
+				Revision:       revision,
+				Mode:           configRollbackCmdFlags.Mode.Mode,
+				DryRun:         configRollbackCmdFlags.dryRun,
+				TryModeTimeout: durationpb.New(configRollbackCmdFlags.configTryTimeout),
+				TryConditions:  configRollbackCmdFlags.tryConditions.Request(),
+			})
+			if err != nil {
+				return fmt.Errorf("error rolling back configuration: %w", err)
+			}
+
+			helpers.PrintApplyResults(resp)
+
+			return nil
+		})
+	},
+}
+
+func init() {
+	configRollbackCmd.Flags().BoolVar(&configRollbackCmdFlags.dryRun, "dry-run", false, "check how the config change will be applied in dry-run mode")
+	configRollbackCmd.Flags().DurationVar(&configRollbackCmdFlags.configTryTimeout, "timeout", constants.ConfigTryTimeout,
+		"the config will be rolled back after specified timeout (if try mode is selected)")
+	helpers.AddModeFlags(&configRollbackCmdFlags.Mode, configRollbackCmd)
+	helpers.AddTryConditionsFlags(&configRollbackCmdFlags.tryConditions, configRollbackCmd)
+
+	configCmd.AddCommand(configHistoryCmd, configRollbackCmd)
+}
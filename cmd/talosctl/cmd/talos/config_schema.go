@@ -0,0 +1,147 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package talos
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path"
+	"path/filepath"
+
+	"github.com/spf13/cobra"
+
+	"github.com/siderolabs/talos/internal/app/machined/pkg/runtime"
+	"github.com/siderolabs/talos/pkg/machinery/config/schema"
+)
+
+var configSchemaCmdFlags struct {
+	outputDir string
+}
+
+// configSchemaCmd represents the `config schema` command.
+var configSchemaCmd = &cobra.Command{
+	Use:   "schema [<name>]",
+	Short: "Print the JSON Schema of the configuration documents",
+	Long: `Prints the JSON Schema of the machine configuration (v1alpha1), of the standalone documents (by kind),
+or of the client configuration (talosconfig).
+
+The schemas can be used for the editor integration, e.g. with the YAML language server:
+
+	# yaml-language-server: $schema=v1alpha1_config.schema.json`,
+	Args: cobra.MaximumNArgs(1),
+	ValidArgsFunction: func(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+		return schema.Names(), cobra.ShellCompDirectiveNoFileComp
+	},
+	RunE: func(cmd *cobra.Command, args []string) error {
+		if configSchemaCmdFlags.outputDir != "" {
+			if len(args) > 0 {
+				return errors.New("schema name can't be used with --output-dir")
+			}
+
+			return writeSchemas(configSchemaCmdFlags.outputDir)
+		}
+
+		name := schema.MachineConfig
+
+		if len(args) > 0 {
+			name = args[0]
+		}
+
+		data, err := schema.Get(name)
+		if err != nil {
+			return err
+		}
+
+		_, err = os.Stdout.Write(data)
+
+		return err
+	},
+}
+
+func writeSchemas(dir string) error {
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return err
+	}
+
+	for _, name := range schema.Names() {
+		data, err := schema.Get(name)
+		if err != nil {
+			return err
+		}
+
+		file := filepath.Join(dir, path.Base(schema.ID(name)))
+
+		if err = os.WriteFile(file, data, 0o644); err != nil {
+			return err
+		}
+
+		fmt.Fprintf(os.Stderr, "created %s\n", file)
+	}
+
+	return nil
+}
+
+var configLintCmdFlags struct {
+	mode   string
+	strict bool
+}
+
+// configLintCmd represents the `config lint` command.
+var configLintCmd = &cobra.Command{
+	Use:   "lint <file>...",
+	Short: "Validate the configuration files offline",
+	Long: `Validates the configuration files against the JSON Schemas and then validates the machine configuration
+for the runtime mode. The findings are printed with the line and column positions where available.`,
+	Args: cobra.MinimumNArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		mode, err := runtime.ParseMode(configLintCmdFlags.mode)
+		if err != nil {
+			return err
+		}
+
+		failed := false
+
+		for _, file := range args {
+			data, err := os.ReadFile(file)
+			if err != nil {
+				return err
+			}
+
+			findings, err := schema.Lint(data, mode)
+			if err != nil {
+				return fmt.Errorf("error linting %q: %w", file, err)
+			}
+
+			for _, finding := range findings {
+				if !finding.Warning || configLintCmdFlags.strict {
+					failed = true
+				}
+
+				if finding.Line > 0 {
+					fmt.Printf("%s:%s\n", file, finding)
+				} else {
+					fmt.Printf("%s: %s\n", file, finding)
+				}
+			}
+		}
+
+		if failed {
+			return errors.New("lint failed")
+		}
+
+		return nil
+	},
+}
+
+func init() {
+	configSchemaCmd.Flags().StringVarP(&configSchemaCmdFlags.outputDir, "output-dir", "o", "", "write all schemas to the directory")
+
+	configLintCmd.Flags().StringVarP(&configLintCmdFlags.mode, "mode", "m", runtime.ModeMetal.String(),
+		fmt.Sprintf("the mode to validate the config for (valid values are %s, %s, and %s)", runtime.ModeMetal, runtime.ModeCloud, runtime.ModeContainer))
+	configLintCmd.Flags().BoolVar(&configLintCmdFlags.strict, "strict", false, "treat validation warnings as errors")
+
+	configCmd.AddCommand(configSchemaCmd, configLintCmd)
+}
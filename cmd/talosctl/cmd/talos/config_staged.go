@@ -0,0 +1,172 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package talos
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/cosi-project/runtime/pkg/resource"
+	"github.com/spf13/cobra"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/peer"
+
+	"github.com/siderolabs/talos/pkg/cli"
+	"github.com/siderolabs/talos/pkg/machinery/client"
+	"github.com/siderolabs/talos/pkg/machinery/resources/config"
+)
+
+var configStagedCmdFlags struct {
+	output string
+}
+
+// configStagedCmd represents the `config staged` command.
+var configStagedCmd = &cobra.Command{
+	Use:   "staged",
+	Short: "Show the configuration staged to be applied after the next reboot",
+	Long: `Show the differences between the active configuration and the configuration staged with 'apply-config --mode=staged'.
+
+The staged configuration is also available as the 'staged' machineconfig resource ('talosctl get machineconfig staged').
+Secrets are redacted.`,
+	Args: cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		switch configStagedCmdFlags.output {
+		case "table", "yaml":
+		default:
+			return fmt.Errorf("unsupported output format %q", configStagedCmdFlags.output)
+		}
+
+		return WithClient(func(ctx context.Context, c *client.Client) error {
+			var remotePeer peer.Peer
+
+			resp, err := c.StagedConfiguration(ctx, grpc.Peer(&remotePeer))
+			if err != nil {
+				if resp == nil {
+					return fmt.Errorf("error getting staged configuration: %w", err)
+				}
+
+				cli.Warning("%s", err)
+			}
+
+			defaultNode := client.AddrFromPeer(&remotePeer)
+
+			if configStagedCmdFlags.output == "yaml" {
+				for _, msg := range resp.Messages {
+					node := defaultNode
+
+					if msg.Metadata != nil {
+						node = msg.Metadata.Hostname
+					}
+
+					fmt.Printf("# node: %s\n", node)
+
+					if !msg.Staged {
+						fmt.Printf("# no configuration is staged\n---\n")
+
+						continue
+					}
+
+					fmt.Printf("%s---\n", msg.Data)
+				}
+
+				return nil
+			}
+
+			w := tabwriter.NewWriter(os.Stdout, 0, 0, 3, ' ', 0)
+			fmt.Fprintln(w, "NODE\tSTAGED\tPATH\tACTIVE\tSTAGED VALUE")
+
+			// multi-line values (lists, maps) are folded to keep the table readable
+			fold := strings.NewReplacer("\n", "; ")
+
+			for _, msg := range resp.Messages {
+				node := defaultNode
+
+				if msg.Metadata != nil {
+					node = msg.Metadata.Hostname
+				}
+
+				if !msg.Staged {
+					fmt.Fprintf(w, "%s\tno\t\t\t\n", node)
+
+					continue
+				}
+
+				if len(msg.Diff) == 0 {
+					fmt.Fprintf(w, "%s\tyes\t\t\t\n", node)
+				}
+
+				for _, entry := range msg.Diff {
+					path := entry.Path
+					if path == "" {
+						path = "."
+					}
+
+					fmt.Fprintf(w, "%s\tyes\t%s\t%s\t%s\n", node, path, fold.Replace(entry.Current), fold.Replace(entry.Desired))
+				}
+			}
+
+			return w.Flush()
+		})
+	},
+}
+
+// configStagedCancelCmd represents the `config staged cancel` command.
+var configStagedCancelCmd = &cobra.Command{
+	Use:   "cancel",
+	Short: "Discard the staged configuration",
+	Long: `Discard the configuration staged with 'apply-config --mode=staged', the active configuration is used after the next reboot.
+
+The staged configuration can't be discarded while a configuration is applied in the try mode.`,
+	Args: cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return WithClient(func(ctx context.Context, c *client.Client) error {
+			var remotePeer peer.Peer
+
+			resp, err := c.CancelStagedConfiguration(ctx, grpc.Peer(&remotePeer))
+			if err != nil {
+				if resp == nil {
+					return fmt.Errorf("error discarding staged configuration: %w", err)
+				}
+
+				cli.Warning("%s", err)
+			}
+
+			defaultNode := client.AddrFromPeer(&remotePeer)
+
+			for _, msg := range resp.Messages {
+				node := defaultNode
+
+				if msg.Metadata != nil {
+					node = msg.Metadata.Hostname
+				}
+
+				fmt.Printf("%s: staged configuration discarded\n", node)
+			}
+
+			return nil
+		})
+	},
+}
+
+// skipStagedConfig wraps the resource callback to skip the staged machine config unless it is requested by the ID.
+func skipStagedConfig(args []string, fn func(context.Context, string, resource.Resource, error) error) func(context.Context, string, resource.Resource, error) error {
+	return func(ctx context.Context, node string, r resource.Resource, callError error) error {
+		if callError == nil && len(args) < 2 && r.Metadata().Type() == config.MachineConfigType && r.Metadata().ID() == config.StagedID {
+			return nil
+		}
+
+		return fn(ctx, node, r, callError)
+	}
+}
+
+func init() {
+	configStagedCmd.Flags().StringVarP(&configStagedCmdFlags.output, "output", "o", "table", "output format (table|yaml)")
+
+	configStagedCmd.AddCommand(configStagedCancelCmd)
+	configCmd.AddCommand(configStagedCmd)
+}
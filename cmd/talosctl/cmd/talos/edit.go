@@ -35,6 +35,7 @@ var editCmdFlags struct {
 	namespace        string
 	dryRun           bool
 	configTryTimeout time.Duration
+	tryConditions    helpers.TryConditions
 }
 
 //nolint:gocyclo
@@ -137,6 +138,7 @@ func editFn(c *client.Client) func(context.Context, string, resource.Resource, e
 				Mode:           editCmdFlags.Mode.Mode,
 				DryRun:         editCmdFlags.dryRun,
 				TryModeTimeout: durationpb.New(editCmdFlags.configTryTimeout),
+				TryConditions:  editCmdFlags.tryConditions.Request(),
 			})
 			if err != nil {
 				lastError = err.Error()
@@ -193,7 +195,7 @@ or 'notepad' for Windows.`,
 
 			for _, node := range GlobalArgs.Nodes {
 				nodeCtx := client.WithNodes(ctx, node)
-				if err := helpers.ForEachResource(nodeCtx, c, nil, editFn(c), editCmdFlags.namespace, args...); err != nil {
+				if err := helpers.ForEachResource(nodeCtx, c, nil, skipStagedConfig(args, editFn(c)), editCmdFlags.namespace, args...); err != nil {
 					return err
 				}
 			}
@@ -206,6 +208,7 @@ or 'notepad' for Windows.`,
 func init() {
 	editCmd.Flags().StringVar(&editCmdFlags.namespace, "namespace", "", "resource namespace (default is to use default namespace per resource)")
 	helpers.AddModeFlags(&editCmdFlags.Mode, editCmd)
+	helpers.AddTryConditionsFlags(&editCmdFlags.tryConditions, editCmd)
 	editCmd.Flags().BoolVar(&editCmdFlags.dryRun, "dry-run", false, "do not apply the change after editing and print the change summary instead")
 	editCmd.Flags().DurationVar(&editCmdFlags.configTryTimeout, "timeout", constants.ConfigTryTimeout, "the config will be rolled back after specified timeout (if try mode is selected)")
 	addCommand(editCmd)
@@ -13,6 +13,10 @@ import (
 	"github.com/siderolabs/talos/pkg/machinery/client"
 )
 
+var metaCmdFlags struct {
+	insecure bool
+}
+
 var metaCmd = &cobra.Command{
 	Use:   "meta",
 	Short: "Write and delete keys in the META partition",
@@ -26,14 +30,20 @@ var metaWriteCmd = &cobra.Command{
 	Long:  ``,
 	Args:  cobra.ExactArgs(2),
 	RunE: func(cmd *cobra.Command, args []string) error {
-		return WithClient(func(ctx context.Context, c *client.Client) error {
+		fn := func(ctx context.Context, c *client.Client) error {
 			key, err := strconv.ParseUint(args[0], 0, 8)
 			if err != nil {
 				return err
 			}
 
 			return c.MetaWrite(ctx, uint8(key), []byte(args[1]))
-		})
+		}
+
+		if metaCmdFlags.insecure {
+			return WithClientMaintenance(nil, fn)
+		}
+
+		return WithClient(fn)
 	},
 }
 
@@ -55,6 +65,7 @@ var metaDeleteCmd = &cobra.Command{
 }
 
 func init() {
+	metaWriteCmd.Flags().BoolVarP(&metaCmdFlags.insecure, "insecure", "i", false, "write the key using the insecure (encrypted with no auth) maintenance service, only the secret key (0x10) can be written")
 	metaCmd.AddCommand(metaWriteCmd)
 	metaCmd.AddCommand(metaDeleteCmd)
 	addCommand(metaCmd)
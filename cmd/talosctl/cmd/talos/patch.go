@@ -32,6 +32,7 @@ var patchCmdFlags struct {
 	patchFile        string
 	dryRun           bool
 	configTryTimeout time.Duration
+	tryConditions    helpers.TryConditions
 }
 
 func patchFn(c *client.Client, patches []configpatcher.Patch) func(context.Context, string, resource.Resource, error) error {
@@ -64,6 +65,7 @@ func patchFn(c *client.Client, patches []configpatcher.Patch) func(context.Conte
 			Mode:           patchCmdFlags.Mode.Mode,
 			DryRun:         patchCmdFlags.dryRun,
 			TryModeTimeout: durationpb.New(patchCmdFlags.configTryTimeout),
+			TryConditions:  patchCmdFlags.tryConditions.Request(),
 		})
 
 		if bytes.Equal(
@@ -113,7 +115,7 @@ var patchCmd = &cobra.Command{
 
 			for _, node := range GlobalArgs.Nodes {
 				nodeCtx := client.WithNodes(ctx, node)
-				if err := helpers.ForEachResource(nodeCtx, c, nil, patchFn(c, patches), patchCmdFlags.namespace, args...); err != nil {
+				if err := helpers.ForEachResource(nodeCtx, c, nil, skipStagedConfig(args, patchFn(c, patches)), patchCmdFlags.namespace, args...); err != nil {
 					return err
 				}
 			}
@@ -130,5 +132,6 @@ func init() {
 	patchCmd.Flags().BoolVar(&patchCmdFlags.dryRun, "dry-run", false, "print the change summary and patch preview without applying the changes")
 	patchCmd.Flags().DurationVar(&patchCmdFlags.configTryTimeout, "timeout", constants.ConfigTryTimeout, "the config will be rolled back after specified timeout (if try mode is selected)")
 	helpers.AddModeFlags(&patchCmdFlags.Mode, patchCmd)
+	helpers.AddTryConditionsFlags(&patchCmdFlags.tryConditions, patchCmd)
 	addCommand(patchCmd)
 }
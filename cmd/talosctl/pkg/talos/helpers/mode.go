@@ -9,12 +9,15 @@ import (
 	"os"
 	"sort"
 	"strings"
+	"time"
 
 	"github.com/siderolabs/gen/maps"
 	"github.com/spf13/cobra"
+	"google.golang.org/protobuf/types/known/durationpb"
 
 	"github.com/siderolabs/talos/pkg/cli"
 	"github.com/siderolabs/talos/pkg/machinery/api/machine"
+	"github.com/siderolabs/talos/pkg/machinery/constants"
 )
 
 // InteractiveMode fake mode value for the interactive config mode.
@@ -95,6 +98,44 @@ func AddModeFlags(mode *Mode, command *cobra.Command) {
 	command.Flags().VarP(mode, "mode", "m", "apply config mode")
 }
 
+// TryConditions apply, patch, edit config health conditions of the try mode.
+type TryConditions struct {
+	Probes           []string
+	Services         []string
+	CommitOnHealthy  bool
+	HealthyPeriod    time.Duration
+	GracePeriod      time.Duration
+	FailureThreshold uint32
+}
+
+// AddTryConditionsFlags registers the try mode conditions flags with the command.
+func AddTryConditionsFlags(conditions *TryConditions, command *cobra.Command) {
+	command.Flags().StringSliceVar(&conditions.Probes, "try-probe", nil, "the config is rolled back if any of the network probes fails (if try mode is selected)")
+	command.Flags().StringSliceVar(&conditions.Services, "try-service", nil, "the config is rolled back if any of the services is unhealthy, e.g. apid or kubelet (if try mode is selected)")
+	command.Flags().BoolVar(&conditions.CommitOnHealthy, "commit-on-healthy", false,
+		"commit the config once the try mode conditions are healthy, apid health is checked if no conditions are specified (if try mode is selected)")
+	command.Flags().DurationVar(&conditions.HealthyPeriod, "healthy-period", constants.ConfigTryHealthyPeriod, "the try mode conditions should stay healthy for the period for the config to be committed")
+	command.Flags().DurationVar(&conditions.GracePeriod, "try-grace-period", constants.ConfigTryGracePeriod, "the try mode conditions failing within the period after the config apply are ignored")
+	command.Flags().Uint32Var(&conditions.FailureThreshold, "try-failure-threshold", constants.ConfigTryFailureThreshold,
+		"the config is rolled back after the number of consecutive failed checks of the try mode conditions")
+}
+
+// Request returns the try mode conditions of the apply configuration request.
+func (c *TryConditions) Request() *machine.ApplyConfigurationTryConditions {
+	if len(c.Probes) == 0 && len(c.Services) == 0 && !c.CommitOnHealthy {
+		return nil
+	}
+
+	return &machine.ApplyConfigurationTryConditions{
+		Probes:           c.Probes,
+		Services:         c.Services,
+		CommitOnHealthy:  c.CommitOnHealthy,
+		HealthyPeriod:    durationpb.New(c.HealthyPeriod),
+		GracePeriod:      durationpb.New(c.GracePeriod),
+		FailureThreshold: c.FailureThreshold,
+	}
+}
+
 // PrintApplyResults prints out all warnings and auto apply results.
 func PrintApplyResults(resp *machine.ApplyConfigurationResponse) {
 	for _, m := range resp.GetMessages() {
@@ -0,0 +1,97 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package runtime
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/cosi-project/runtime/pkg/resource"
+	"github.com/cosi-project/runtime/pkg/safe"
+	"github.com/cosi-project/runtime/pkg/state"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/siderolabs/talos/pkg/machinery/api/machine"
+	"github.com/siderolabs/talos/pkg/machinery/config"
+	"github.com/siderolabs/talos/pkg/machinery/config/configdiff"
+	"github.com/siderolabs/talos/pkg/machinery/config/configloader"
+	configresource "github.com/siderolabs/talos/pkg/machinery/resources/config"
+)
+
+// ConfigDiff implements the machine.MachineServer interface.
+//
+// The staged configuration is the configuration applied in the staged mode,
+// it is the configuration the machine is going to use after a reboot.
+func (s *Server) ConfigDiff(ctx context.Context, in *machine.ConfigDiffRequest) (*machine.ConfigDiffResponse, error) {
+	desired, err := configloader.NewFromBytes(in.GetData())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	active := s.Controller.Runtime().Config()
+
+	reply := &machine.ConfigDiff{}
+
+	if reply.Active, err = configDiffEntries(active, desired); err != nil {
+		return nil, err
+	}
+
+	staged, err := s.stagedConfig(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if staged != nil {
+		reply.Staged = true
+
+		if reply.StagedDiff, err = configDiffEntries(staged, desired); err != nil {
+			return nil, err
+		}
+	}
+
+	return &machine.ConfigDiffResponse{
+		Messages: []*machine.ConfigDiff{
+			reply,
+		},
+	}, nil
+}
+
+// stagedConfig returns the configuration staged to be applied after the next reboot, nil if nothing is staged.
+func (s *Server) stagedConfig(ctx context.Context) (config.Provider, error) {
+	staged, err := safe.StateGet[*configresource.MachineConfig](
+		ctx,
+		s.Controller.Runtime().State().V1Alpha2().Resources(),
+		resource.NewMetadata(configresource.NamespaceName, configresource.MachineConfigType, configresource.StagedID, resource.VersionUndefined),
+	)
+	if err != nil {
+		if state.IsNotFoundError(err) {
+			return nil, nil
+		}
+
+		return nil, fmt.Errorf("error reading staged config: %w", err)
+	}
+
+	return staged.Config(), nil
+}
+
+func configDiffEntries(current, desired config.Provider) ([]*machine.ConfigDiffEntry, error) {
+	changes, err := configdiff.Diff(current, desired)
+	if err != nil {
+		return nil, err
+	}
+
+	entries := make([]*machine.ConfigDiffEntry, 0, len(changes))
+
+	for _, change := range changes {
+		entries = append(entries, &machine.ConfigDiffEntry{
+			Path:    change.Path,
+			Current: change.Current,
+			Desired: change.Desired,
+		})
+	}
+
+	return entries, nil
+}
@@ -0,0 +1,72 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package runtime
+
+import (
+	"context"
+	"errors"
+	"log"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/siderolabs/talos/internal/pkg/confighistory"
+	"github.com/siderolabs/talos/pkg/machinery/api/machine"
+	"github.com/siderolabs/talos/pkg/machinery/constants"
+)
+
+// ConfigHistory implements the machine.MachineServer interface.
+func (s *Server) ConfigHistory(ctx context.Context, in *emptypb.Empty) (*machine.ConfigHistoryResponse, error) {
+	revisions, err := confighistory.New(constants.ConfigHistoryPath, constants.ConfigHistoryDepth).List()
+	if err != nil {
+		return nil, err
+	}
+
+	reply := &machine.ConfigHistory{
+		Revisions: make([]*machine.ConfigRevision, 0, len(revisions)),
+	}
+
+	for _, rev := range revisions {
+		reply.Revisions = append(reply.Revisions, &machine.ConfigRevision{
+			Id:         int64(rev.ID),
+			Timestamp:  timestamppb.New(rev.Timestamp),
+			Identity:   rev.Identity,
+			Mode:       rev.Mode,
+			RollbackOf: int64(rev.RollbackOf),
+		})
+	}
+
+	return &machine.ConfigHistoryResponse{
+		Messages: []*machine.ConfigHistory{
+			reply,
+		},
+	}, nil
+}
+
+// ConfigRollback implements the machine.MachineServer interface.
+//
+// The config from the history is applied the same way as with ApplyConfiguration.
+func (s *Server) ConfigRollback(ctx context.Context, in *machine.ConfigRollbackRequest) (*machine.ApplyConfigurationResponse, error) {
+	rev, data, err := confighistory.New(constants.ConfigHistoryPath, constants.ConfigHistoryDepth).Get(int(in.Revision))
+	if err != nil {
+		if errors.Is(err, confighistory.ErrNotFound) {
+			return nil, status.Error(codes.NotFound, err.Error())
+		}
+
+		return nil, err
+	}
+
+	log.Printf("config rollback request: revision %d", rev.ID)
+
+	return s.applyConfiguration(ctx, &machine.ApplyConfigurationRequest{
+		Data:           data,
+		Mode:           in.Mode,
+		DryRun:         in.DryRun,
+		TryModeTimeout: in.TryModeTimeout,
+		TryConditions:  in.TryConditions,
+	}, rev.ID)
+}
@@ -0,0 +1,94 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package runtime
+
+import (
+	"context"
+	"log"
+	"os"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+
+	"github.com/siderolabs/talos/internal/pkg/confighistory"
+	"github.com/siderolabs/talos/pkg/grpc/middleware/authz"
+	"github.com/siderolabs/talos/pkg/machinery/api/machine"
+	"github.com/siderolabs/talos/pkg/machinery/config/configdiff"
+	"github.com/siderolabs/talos/pkg/machinery/constants"
+)
+
+// StagedConfiguration implements the machine.MachineServer interface.
+func (s *Server) StagedConfiguration(ctx context.Context, in *emptypb.Empty) (*machine.StagedConfigurationResponse, error) {
+	staged, err := s.stagedConfig(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	reply := &machine.StagedConfiguration{}
+
+	if staged != nil {
+		reply.Staged = true
+
+		if reply.Data, err = staged.RedactSecrets(configdiff.Redacted).Bytes(); err != nil {
+			return nil, err
+		}
+
+		if reply.Diff, err = configDiffEntries(s.Controller.Runtime().Config(), staged); err != nil {
+			return nil, err
+		}
+	}
+
+	return &machine.StagedConfigurationResponse{
+		Messages: []*machine.StagedConfiguration{
+			reply,
+		},
+	}, nil
+}
+
+// CancelStagedConfiguration implements the machine.MachineServer interface.
+//
+// The active configuration is written back to the STATE partition, so that it is used after the next reboot.
+func (s *Server) CancelStagedConfiguration(ctx context.Context, in *emptypb.Empty) (*machine.CancelStagedConfigurationResponse, error) {
+	staged, err := s.stagedConfig(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if staged == nil {
+		return nil, status.Error(codes.FailedPrecondition, "no configuration is staged")
+	}
+
+	// the active config in try mode is not persisted, it can't replace the staged one
+	if s.Controller.Runtime().ConfigRollbackPending() {
+		return nil, status.Error(codes.FailedPrecondition, "configuration is applied in try mode, wait for it to be committed or rolled back")
+	}
+
+	active, err := s.Controller.Runtime().Config().Bytes()
+	if err != nil {
+		return nil, err
+	}
+
+	log.Printf("cancel staged config request")
+
+	if err = os.WriteFile(constants.ConfigPath, active, 0o600); err != nil {
+		return nil, err
+	}
+
+	recordConfigHistory(active, confighistory.Revision{
+		Identity: authz.GetIdentity(ctx),
+		Mode:     confighistory.ModeCancelStaged,
+	})
+
+	if err = s.Controller.Runtime().State().V1Alpha2().SetStagedConfig(nil); err != nil {
+		return nil, err
+	}
+
+	return &machine.CancelStagedConfigurationResponse{
+		Messages: []*machine.CancelStagedConfiguration{
+			{},
+		},
+	}, nil
+}
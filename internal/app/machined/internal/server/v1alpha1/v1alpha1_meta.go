@@ -12,6 +12,8 @@ import (
 	"google.golang.org/grpc/status"
 
 	"github.com/siderolabs/talos/internal/app/machined/pkg/runtime"
+	"github.com/siderolabs/talos/internal/pkg/meta"
+	"github.com/siderolabs/talos/internal/pkg/secretkey"
 	"github.com/siderolabs/talos/pkg/machinery/api/machine"
 )
 
@@ -41,6 +43,12 @@ func (s *Server) MetaWrite(ctx context.Context, req *machine.MetaWriteRequest) (
 		return nil, err
 	}
 
+	if req.Key == meta.SecretKey {
+		if err = secretkey.Provision(s.Controller.Runtime().State().Machine().Meta()); err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "error provisioning secret key: %s", err)
+		}
+	}
+
 	return &machine.MetaWriteResponse{
 		Messages: []*machine.MetaWrite{
 			{},
@@ -74,6 +82,12 @@ func (s *Server) MetaDelete(ctx context.Context, req *machine.MetaDeleteRequest)
 		return nil, err
 	}
 
+	if req.Key == meta.SecretKey {
+		if err = secretkey.Remove(); err != nil {
+			return nil, err
+		}
+	}
+
 	return &machine.MetaDeleteResponse{
 		Messages: []*machine.MetaDelete{
 			{},
@@ -59,6 +59,7 @@ import (
 	"github.com/siderolabs/talos/internal/app/machined/pkg/system"
 	"github.com/siderolabs/talos/internal/app/resources"
 	storaged "github.com/siderolabs/talos/internal/app/storaged"
+	"github.com/siderolabs/talos/internal/pkg/confighistory"
 	"github.com/siderolabs/talos/internal/pkg/configuration"
 	"github.com/siderolabs/talos/internal/pkg/containers"
 	taloscontainerd "github.com/siderolabs/talos/internal/pkg/containers/containerd"
@@ -71,6 +72,7 @@ import (
 	"github.com/siderolabs/talos/pkg/archiver"
 	"github.com/siderolabs/talos/pkg/chunker"
 	"github.com/siderolabs/talos/pkg/chunker/stream"
+	"github.com/siderolabs/talos/pkg/grpc/middleware/authz"
 	"github.com/siderolabs/talos/pkg/kubeconfig"
 	"github.com/siderolabs/talos/pkg/machinery/api/cluster"
 	"github.com/siderolabs/talos/pkg/machinery/api/common"
@@ -80,6 +82,7 @@ import (
 	"github.com/siderolabs/talos/pkg/machinery/api/storage"
 	timeapi "github.com/siderolabs/talos/pkg/machinery/api/time"
 	clientconfig "github.com/siderolabs/talos/pkg/machinery/client/config"
+	"github.com/siderolabs/talos/pkg/machinery/config"
 	"github.com/siderolabs/talos/pkg/machinery/config/types/v1alpha1"
 	"github.com/siderolabs/talos/pkg/machinery/config/types/v1alpha1/generate"
 	machinetype "github.com/siderolabs/talos/pkg/machinery/config/types/v1alpha1/machine"
@@ -156,9 +159,14 @@ func (s *Server) Register(obj *grpc.Server) {
 }
 
 // ApplyConfiguration implements machine.MachineService.
+func (s *Server) ApplyConfiguration(ctx context.Context, in *machine.ApplyConfigurationRequest) (*machine.ApplyConfigurationResponse, error) {
+	return s.applyConfiguration(ctx, in, 0)
+}
+
+// applyConfiguration applies the config, rollbackOf is the config history revision the config is restored from.
 //
 //nolint:gocyclo,cyclop
-func (s *Server) ApplyConfiguration(ctx context.Context, in *machine.ApplyConfigurationRequest) (*machine.ApplyConfigurationResponse, error) {
+func (s *Server) applyConfiguration(ctx context.Context, in *machine.ApplyConfigurationRequest, rollbackOf int) (*machine.ApplyConfigurationResponse, error) {
 	mode := in.Mode.String()
 	modeDetails := "Applied configuration with a reboot"
 	modeErr := ""
@@ -227,6 +235,12 @@ Config diff:
 
 	log.Printf("apply config request: mode %s", strings.ToLower(mode))
 
+	revision := confighistory.Revision{
+		Identity:   authz.GetIdentity(ctx),
+		Mode:       strings.ToLower(in.Mode.String()),
+		RollbackOf: rollbackOf,
+	}
+
 	cfg, err := cfgProvider.Bytes()
 	if err != nil {
 		return nil, err
@@ -236,6 +250,19 @@ Config diff:
 		if err := os.WriteFile(constants.ConfigPath, cfg, 0o600); err != nil {
 			return nil, err
 		}
+
+		// the config on disk is either staged or replaces the previously staged config
+		var staged config.Provider
+
+		if in.Mode == machine.ApplyConfigurationRequest_STAGED {
+			staged = cfgProvider
+		}
+
+		if err := s.Controller.Runtime().State().V1Alpha2().SetStagedConfig(staged); err != nil {
+			return nil, err
+		}
+
+		recordConfigHistory(cfg, revision)
 	}
 
 	//nolint:exhaustive
@@ -254,7 +281,19 @@ Config diff:
 
 		modeDetails += fmt.Sprintf("\nThe config is applied in 'try' mode and will be automatically reverted back in %s", timeout.String())
 
-		if err := s.Controller.Runtime().RollbackToConfigAfter(oldConfig, timeout); err != nil {
+		conditions := tryConditions(in.TryConditions)
+		conditions.Revision = revision
+
+		if !conditions.Empty() {
+			modeDetails += fmt.Sprintf("\nThe config is reverted back early if any of the conditions fails %d times in a row after the %s grace period: %s",
+				conditions.FailureThreshold, conditions.GracePeriod, conditions)
+
+			if conditions.CommitOnHealthy {
+				modeDetails += fmt.Sprintf("\nThe config is committed once the conditions are healthy for %s", conditions.HealthyPeriod)
+			}
+		}
+
+		if err := s.Controller.Runtime().RollbackToConfigAfter(oldConfig, timeout, conditions); err != nil {
 			return nil, err
 		}
 
@@ -289,6 +328,51 @@ Config diff:
 	}, nil
 }
 
+// recordConfigHistory records the config written to the STATE partition in the config history.
+//
+// The config is already applied at this point, so the error is only logged.
+func recordConfigHistory(cfg []byte, revision confighistory.Revision) {
+	if _, err := confighistory.New(constants.ConfigHistoryPath, constants.ConfigHistoryDepth).Record(cfg, revision); err != nil {
+		log.Printf("error recording config history: %s", err)
+	}
+}
+
+// tryConditions converts the try mode conditions of the request.
+//
+// If the config should be committed on healthy conditions, but no conditions are specified, apid health is checked.
+func tryConditions(in *machine.ApplyConfigurationTryConditions) runtime.TryConditions {
+	if in == nil {
+		return runtime.TryConditions{}
+	}
+
+	conditions := runtime.TryConditions{
+		Probes:           in.Probes,
+		Services:         in.Services,
+		CommitOnHealthy:  in.CommitOnHealthy,
+		HealthyPeriod:    constants.ConfigTryHealthyPeriod,
+		GracePeriod:      constants.ConfigTryGracePeriod,
+		FailureThreshold: constants.ConfigTryFailureThreshold,
+	}
+
+	if in.HealthyPeriod != nil {
+		conditions.HealthyPeriod = in.HealthyPeriod.AsDuration()
+	}
+
+	if in.GracePeriod != nil {
+		conditions.GracePeriod = in.GracePeriod.AsDuration()
+	}
+
+	if in.FailureThreshold > 0 {
+		conditions.FailureThreshold = int(in.FailureThreshold)
+	}
+
+	if conditions.CommitOnHealthy && conditions.Empty() {
+		conditions.Services = []string{"apid"}
+	}
+
+	return conditions
+}
+
 // GenerateConfiguration implements the machine.MachineServer interface.
 func (s *Server) GenerateConfiguration(ctx context.Context, in *machine.GenerateConfigurationRequest) (reply *machine.GenerateConfigurationResponse, err error) {
 	if s.Controller.Runtime().Config().Machine().Type() == machinetype.TypeWorker {
@@ -298,6 +382,11 @@ func (s *Server) GenerateConfiguration(ctx context.Context, in *machine.Generate
 	return configuration.Generate(ctx, in)
 }
 
+// ValidateConfiguration implements the machine.MachineServer interface.
+func (s *Server) ValidateConfiguration(ctx context.Context, in *machine.ValidateConfigurationRequest) (*machine.ValidateConfigurationResponse, error) {
+	return configuration.Validate(ctx, s.Controller.Runtime().State().V1Alpha2().Resources(), s.Controller.Runtime().State().Platform().Mode(), in)
+}
+
 // Reboot implements the machine.MachineServer interface.
 //
 //nolint:dupl
@@ -0,0 +1,118 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package network
+
+import (
+	"encoding/binary"
+	"net/netip"
+
+	"github.com/mdlayher/netlink"
+	"golang.org/x/sys/unix"
+
+	"github.com/siderolabs/talos/pkg/machinery/resources/network"
+)
+
+// DefaultVXLANPort is the IANA assigned VXLAN port.
+//
+// Linux kernel defaults to 8472 for historical reasons, so the port is always set explicitly.
+const DefaultVXLANPort = 4789
+
+// VXLANSpec adapter provides encoding/decoding to netlink structures.
+//
+//nolint:revive,golint
+func VXLANSpec(r *network.VXLANSpec) vxlanSpec {
+	return vxlanSpec{
+		VXLANSpec: r,
+	}
+}
+
+type vxlanSpec struct {
+	*network.VXLANSpec
+}
+
+// FillDefaults fills zero values with proper default values.
+func (a vxlanSpec) FillDefaults() {
+	if a.Port == 0 {
+		a.Port = DefaultVXLANPort
+	}
+}
+
+// Encode the VXLANSpec into netlink attributes.
+//
+// The parentIndex is the index of the link used for the encapsulated traffic, zero if not set.
+func (a vxlanSpec) Encode(parentIndex uint32) ([]byte, error) {
+	vxlan := a.VXLANSpec
+
+	encoder := netlink.NewAttributeEncoder()
+
+	encoder.Uint32(unix.IFLA_VXLAN_ID, vxlan.VNI)
+
+	// remote and group are the same attribute, the kernel tells them apart by the address type,
+	// so only one of them is encoded (they are mutually exclusive in the config)
+	switch {
+	case vxlan.Group.IsValid():
+		encodeAddr(encoder, unix.IFLA_VXLAN_GROUP, unix.IFLA_VXLAN_GROUP6, vxlan.Group)
+	case vxlan.Remote.IsValid():
+		encodeAddr(encoder, unix.IFLA_VXLAN_GROUP, unix.IFLA_VXLAN_GROUP6, vxlan.Remote)
+	}
+
+	if vxlan.Local.IsValid() {
+		encodeAddr(encoder, unix.IFLA_VXLAN_LOCAL, unix.IFLA_VXLAN_LOCAL6, vxlan.Local)
+	}
+
+	if parentIndex != 0 {
+		encoder.Uint32(unix.IFLA_VXLAN_LINK, parentIndex)
+	}
+
+	buf := make([]byte, 2)
+	binary.BigEndian.PutUint16(buf, vxlan.Port)
+	encoder.Bytes(unix.IFLA_VXLAN_PORT, buf)
+
+	return encoder.Encode()
+}
+
+// Decode the VXLANSpec from netlink attributes.
+func (a vxlanSpec) Decode(data []byte) error {
+	vxlan := a.VXLANSpec
+
+	decoder, err := netlink.NewAttributeDecoder(data)
+	if err != nil {
+		return err
+	}
+
+	for decoder.Next() {
+		switch decoder.Type() {
+		case unix.IFLA_VXLAN_ID:
+			vxlan.VNI = decoder.Uint32()
+		case unix.IFLA_VXLAN_GROUP, unix.IFLA_VXLAN_GROUP6:
+			addr, ok := netip.AddrFromSlice(decoder.Bytes())
+			if !ok {
+				continue
+			}
+
+			if addr.IsMulticast() {
+				vxlan.Group = addr
+			} else {
+				vxlan.Remote = addr
+			}
+		case unix.IFLA_VXLAN_LOCAL, unix.IFLA_VXLAN_LOCAL6:
+			if addr, ok := netip.AddrFromSlice(decoder.Bytes()); ok {
+				vxlan.Local = addr
+			}
+		case unix.IFLA_VXLAN_PORT:
+			vxlan.Port = binary.BigEndian.Uint16(decoder.Bytes())
+		}
+	}
+
+	return decoder.Err()
+}
+
+func encodeAddr(encoder *netlink.AttributeEncoder, typ4, typ6 uint16, addr netip.Addr) {
+	if addr.Is4() {
+		encoder.Bytes(typ4, addr.AsSlice())
+	} else {
+		encoder.Bytes(typ6, addr.AsSlice())
+	}
+}
@@ -0,0 +1,66 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package network_test
+
+import (
+	"net/netip"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	networkadapter "github.com/siderolabs/talos/internal/app/machined/pkg/adapters/network"
+	"github.com/siderolabs/talos/pkg/machinery/resources/network"
+)
+
+func TestVXLANSpec(t *testing.T) {
+	for _, spec := range []network.VXLANSpec{
+		{
+			VNI:    42,
+			Remote: netip.MustParseAddr("10.0.0.1"),
+			Local:  netip.MustParseAddr("10.0.0.2"),
+			Port:   4789,
+		},
+		{
+			VNI:   16777215,
+			Group: netip.MustParseAddr("ff05::100"),
+			Port:  8472,
+		},
+	} {
+		b, err := networkadapter.VXLANSpec(&spec).Encode(3)
+		require.NoError(t, err)
+
+		var decodedSpec network.VXLANSpec
+
+		require.NoError(t, networkadapter.VXLANSpec(&decodedSpec).Decode(b))
+
+		require.Equal(t, spec, decodedSpec)
+	}
+}
+
+func TestVXLANSpecGroupOverRemote(t *testing.T) {
+	b, err := networkadapter.VXLANSpec(&network.VXLANSpec{
+		VNI:    42,
+		Remote: netip.MustParseAddr("10.0.0.1"),
+		Group:  netip.MustParseAddr("239.1.1.1"),
+	}).Encode(0)
+	require.NoError(t, err)
+
+	var decodedSpec network.VXLANSpec
+
+	require.NoError(t, networkadapter.VXLANSpec(&decodedSpec).Decode(b))
+
+	require.Equal(t, netip.MustParseAddr("239.1.1.1"), decodedSpec.Group)
+	require.False(t, decodedSpec.Remote.IsValid())
+}
+
+func TestVXLANSpecFillDefaults(t *testing.T) {
+	spec := network.VXLANSpec{
+		VNI: 42,
+	}
+
+	networkadapter.VXLANSpec(&spec).FillDefaults()
+
+	require.EqualValues(t, networkadapter.DefaultVXLANPort, spec.Port)
+}
@@ -0,0 +1,172 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package hardware
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/cosi-project/runtime/pkg/controller"
+	"github.com/cosi-project/runtime/pkg/safe"
+	"go.uber.org/zap"
+
+	runtimetalos "github.com/siderolabs/talos/internal/app/machined/pkg/runtime"
+	"github.com/siderolabs/talos/pkg/machinery/resources/hardware"
+)
+
+// DefaultDeviceTreePath is the path to the device tree exposed by the kernel.
+const DefaultDeviceTreePath = "/proc/device-tree"
+
+// BoardInfoController populates SBC information reported by the board implementation.
+type BoardInfoController struct {
+	V1Alpha1Mode runtimetalos.Mode
+	Board        runtimetalos.Board
+
+	// DeviceTreePath defaults to DefaultDeviceTreePath.
+	DeviceTreePath string
+}
+
+// Name implements controller.Controller interface.
+func (ctrl *BoardInfoController) Name() string {
+	return "hardware.BoardInfoController"
+}
+
+// Inputs implements controller.Controller interface.
+func (ctrl *BoardInfoController) Inputs() []controller.Input {
+	return nil
+}
+
+// Outputs implements controller.Controller interface.
+func (ctrl *BoardInfoController) Outputs() []controller.Output {
+	return []controller.Output{
+		{
+			Type: hardware.BoardInformationType,
+			Kind: controller.OutputExclusive,
+		},
+	}
+}
+
+// Run implements controller.Controller interface.
+func (ctrl *BoardInfoController) Run(ctx context.Context, r controller.Runtime, logger *zap.Logger) error {
+	select {
+	case <-ctx.Done():
+		return nil
+	case <-r.EventCh():
+	}
+
+	// controller runs only once
+	if ctrl.V1Alpha1Mode == runtimetalos.ModeContainer || ctrl.Board == nil {
+		return nil
+	}
+
+	if ctrl.DeviceTreePath == "" {
+		ctrl.DeviceTreePath = DefaultDeviceTreePath
+	}
+
+	model, err := ctrl.readStrings("model")
+	if err != nil {
+		return fmt.Errorf("error reading device tree model: %w", err)
+	}
+
+	compatible, err := ctrl.readStrings("compatible")
+	if err != nil {
+		return fmt.Errorf("error reading device tree compatible: %w", err)
+	}
+
+	var (
+		soc        string
+		bootMedium string
+		features   []string
+	)
+
+	if reporter, ok := ctrl.Board.(runtimetalos.BoardHardwareReporter); ok && reporter.Hardware() != nil {
+		hw := reporter.Hardware()
+
+		soc = hw.SOC
+
+		for feature, node := range hw.Features {
+			if ctrl.nodeEnabled(node) {
+				features = append(features, feature)
+			}
+		}
+
+		sort.Strings(features)
+
+		bootDevice, err := ctrl.readStrings("chosen/u-boot,spl-boot-device")
+		if err != nil {
+			return fmt.Errorf("error reading boot device: %w", err)
+		}
+
+		if len(bootDevice) > 0 {
+			bootMedium = hw.BootDevices[bootDevice[0]]
+
+			if bootMedium == "" {
+				logger.Warn("unknown boot device", zap.String("node", bootDevice[0]))
+			}
+		}
+	}
+
+	if err = safe.WriterModify(ctx, r, hardware.NewBoardInformation(hardware.BoardInformationID), func(res *hardware.BoardInformation) error {
+		spec := res.TypedSpec()
+
+		spec.Name = ctrl.Board.Name()
+		spec.SOC = soc
+		spec.BootMedium = bootMedium
+		spec.Compatible = compatible
+		spec.Features = features
+
+		if len(model) > 0 {
+			spec.Model = model[0]
+		}
+
+		return nil
+	}); err != nil {
+		return fmt.Errorf("error updating objects: %w", err)
+	}
+
+	return nil
+}
+
+// readStrings reads a device tree property holding a NUL-separated string list.
+//
+// Missing properties are not an error.
+func (ctrl *BoardInfoController) readStrings(property string) ([]string, error) {
+	contents, err := os.ReadFile(filepath.Join(ctrl.DeviceTreePath, property))
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return nil, nil
+		}
+
+		return nil, err
+	}
+
+	contents = bytes.TrimRight(contents, "\x00")
+	if len(contents) == 0 {
+		return nil, nil
+	}
+
+	return strings.Split(string(contents), "\x00"), nil
+}
+
+// nodeEnabled checks whether the device tree node exists and its status is not disabled.
+func (ctrl *BoardInfoController) nodeEnabled(node string) bool {
+	if _, err := os.Stat(filepath.Join(ctrl.DeviceTreePath, node)); err != nil {
+		return false
+	}
+
+	status, err := ctrl.readStrings(filepath.Join(node, "status"))
+	if err != nil {
+		return false
+	}
+
+	// absent status property means the node is enabled
+	return len(status) == 0 || status[0] == "okay" || status[0] == "ok"
+}
@@ -0,0 +1,90 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package hardware_test
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/cosi-project/runtime/pkg/resource"
+	"github.com/siderolabs/go-retry/retry"
+	"github.com/stretchr/testify/suite"
+
+	hardwarectrl "github.com/siderolabs/talos/internal/app/machined/pkg/controllers/hardware"
+	runtimetalos "github.com/siderolabs/talos/internal/app/machined/pkg/runtime"
+	"github.com/siderolabs/talos/internal/app/machined/pkg/runtime/v1alpha1/board/descriptor"
+	"github.com/siderolabs/talos/internal/app/machined/pkg/runtime/v1alpha1/board/rock5b"
+	"github.com/siderolabs/talos/pkg/machinery/resources/hardware"
+)
+
+type BoardInfoSuite struct {
+	HardwareSuite
+}
+
+func (suite *BoardInfoSuite) TestPopulateBoardInformation() {
+	suite.Require().NoError(
+		suite.runtime.RegisterController(
+			&hardwarectrl.BoardInfoController{
+				Board:          descriptor.New(rock5b.Descriptor),
+				DeviceTreePath: "testdata/devicetree/rock5b",
+			},
+		),
+	)
+
+	suite.startRuntime()
+
+	expected := hardware.BoardInformationSpec{
+		Name:       "rock_5b",
+		Model:      "Radxa ROCK 5 Model B",
+		SOC:        "rk3588",
+		BootMedium: "emmc",
+		Compatible: []string{"radxa,rock-5b", "rockchip,rk3588"},
+		Features:   []string{"emmc", "ethernet-2.5g", "npu", "pcie-m2"},
+	}
+
+	suite.Assert().NoError(
+		retry.Constant(1*time.Second, retry.WithUnits(100*time.Millisecond)).Retry(
+			suite.assertResource(*hardware.NewBoardInformation(hardware.BoardInformationID).Metadata(), func(r resource.Resource) error {
+				spec := *r.(*hardware.BoardInformation).TypedSpec()
+				if !suite.Assert().Equal(expected, spec) {
+					return retry.ExpectedError(fmt.Errorf("board information doesn't match: %v != %v", expected, spec))
+				}
+
+				return nil
+			}),
+		),
+	)
+}
+
+func (suite *BoardInfoSuite) TestPopulateBoardInformationIsDisabledInContainerMode() {
+	suite.Require().NoError(
+		suite.runtime.RegisterController(
+			&hardwarectrl.BoardInfoController{
+				V1Alpha1Mode:   runtimetalos.ModeContainer,
+				Board:          descriptor.New(rock5b.Descriptor),
+				DeviceTreePath: "testdata/devicetree/rock5b",
+			},
+		),
+	)
+
+	suite.startRuntime()
+
+	suite.Assert().NoError(retry.Constant(1*time.Second, retry.WithUnits(100*time.Millisecond)).Retry(suite.assertNoResource(*hardware.NewBoardInformation(hardware.BoardInformationID).Metadata())))
+}
+
+func TestBoardInfoSuite(t *testing.T) {
+	suite.Run(t, new(BoardInfoSuite))
+}
+
+func (suite *BoardInfoSuite) startRuntime() {
+	suite.wg.Add(1)
+
+	go func() {
+		defer suite.wg.Done()
+
+		suite.Assert().NoError(suite.runtime.Run(suite.ctx))
+	}()
+}
@@ -0,0 +1,275 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package hardware
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+
+	"github.com/cosi-project/runtime/pkg/controller"
+	"github.com/cosi-project/runtime/pkg/resource"
+	"github.com/cosi-project/runtime/pkg/safe"
+	"github.com/cosi-project/runtime/pkg/state"
+	"github.com/siderolabs/go-pointer"
+	"go.uber.org/zap"
+
+	runtimetalos "github.com/siderolabs/talos/internal/app/machined/pkg/runtime"
+	talosconfig "github.com/siderolabs/talos/pkg/machinery/config"
+	"github.com/siderolabs/talos/pkg/machinery/resources/config"
+	"github.com/siderolabs/talos/pkg/machinery/resources/hardware"
+)
+
+const maxPWM = 255
+
+// FanController drives the PWM fan according to the fan curve from the machine config.
+type FanController struct {
+	V1Alpha1Mode runtimetalos.Mode
+
+	// SysfsPath defaults to DefaultSysfsPath.
+	SysfsPath string
+
+	lastPWM int
+
+	// state of the fan before the controller took it over, restored when the fan config is removed
+	pwmPath        string
+	originalPWM    []byte
+	originalEnable []byte
+}
+
+// Name implements controller.Controller interface.
+func (ctrl *FanController) Name() string {
+	return "hardware.FanController"
+}
+
+// Inputs implements controller.Controller interface.
+func (ctrl *FanController) Inputs() []controller.Input {
+	return []controller.Input{
+		{
+			Namespace: config.NamespaceName,
+			Type:      config.MachineConfigType,
+			ID:        pointer.To(config.V1Alpha1ID),
+			Kind:      controller.InputWeak,
+		},
+		{
+			Namespace: hardware.NamespaceName,
+			Type:      hardware.ThermalZoneStatusType,
+			Kind:      controller.InputWeak,
+		},
+	}
+}
+
+// Outputs implements controller.Controller interface.
+func (ctrl *FanController) Outputs() []controller.Output {
+	return nil
+}
+
+// Run implements controller.Controller interface.
+func (ctrl *FanController) Run(ctx context.Context, r controller.Runtime, logger *zap.Logger) error {
+	if ctrl.V1Alpha1Mode == runtimetalos.ModeContainer {
+		return nil
+	}
+
+	if ctrl.SysfsPath == "" {
+		ctrl.SysfsPath = DefaultSysfsPath
+	}
+
+	ctrl.lastPWM = -1
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-r.EventCh():
+		}
+
+		cfg, err := safe.ReaderGet[*config.MachineConfig](ctx, r, resource.NewMetadata(config.NamespaceName, config.MachineConfigType, config.V1Alpha1ID, resource.VersionUndefined))
+		if err != nil && !state.IsNotFoundError(err) {
+			return fmt.Errorf("error getting config: %w", err)
+		}
+
+		if cfg == nil || !cfg.Config().Machine().Fan().Enabled() {
+			if err = ctrl.release(logger); err != nil {
+				return err
+			}
+
+			continue
+		}
+
+		fan := cfg.Config().Machine().Fan()
+
+		temperature, found, err := ctrl.zoneTemperature(ctx, r, fan.ThermalZone())
+		if err != nil {
+			return err
+		}
+
+		if !found {
+			continue
+		}
+
+		pwm := fanSpeed(fan.Curve(), temperature) * maxPWM / 100
+
+		if pwm == ctrl.lastPWM {
+			continue
+		}
+
+		pwmPath, err := ctrl.findPWM(fan.Hwmon())
+		if err != nil {
+			return err
+		}
+
+		if pwmPath != ctrl.pwmPath {
+			if err = ctrl.release(logger); err != nil {
+				return err
+			}
+
+			if err = ctrl.takeOver(pwmPath); err != nil {
+				return err
+			}
+		}
+
+		if err = os.WriteFile(pwmPath, []byte(strconv.Itoa(pwm)), 0o644); err != nil {
+			return fmt.Errorf("error setting fan speed: %w", err)
+		}
+
+		logger.Info("fan speed updated", zap.Int("pwm", pwm), zap.Int32("temperature", temperature/1000))
+
+		ctrl.lastPWM = pwm
+
+		r.ResetRestartBackoff()
+	}
+}
+
+// takeOver saves the current state of the fan and switches it to the manual control.
+func (ctrl *FanController) takeOver(pwmPath string) error {
+	originalPWM, err := os.ReadFile(pwmPath)
+	if err != nil {
+		return fmt.Errorf("error reading fan speed: %w", err)
+	}
+
+	// pwm1_enable is optional, if it is missing, the fan is always under the manual control
+	originalEnable, err := os.ReadFile(pwmPath + "_enable")
+	if err != nil && !os.IsNotExist(err) {
+		return fmt.Errorf("error reading fan control mode: %w", err)
+	}
+
+	if originalEnable != nil {
+		if err = os.WriteFile(pwmPath+"_enable", []byte("1"), 0o644); err != nil {
+			return fmt.Errorf("error switching fan to manual control: %w", err)
+		}
+	}
+
+	ctrl.pwmPath = pwmPath
+	ctrl.originalPWM = originalPWM
+	ctrl.originalEnable = originalEnable
+
+	return nil
+}
+
+// release restores the state of the fan saved by takeOver, returning the fan to the automatic control if it was enabled.
+func (ctrl *FanController) release(logger *zap.Logger) error {
+	if ctrl.pwmPath == "" {
+		return nil
+	}
+
+	if err := os.WriteFile(ctrl.pwmPath, ctrl.originalPWM, 0o644); err != nil {
+		return fmt.Errorf("error restoring fan speed: %w", err)
+	}
+
+	if ctrl.originalEnable != nil {
+		if err := os.WriteFile(ctrl.pwmPath+"_enable", ctrl.originalEnable, 0o644); err != nil {
+			return fmt.Errorf("error restoring fan control mode: %w", err)
+		}
+	}
+
+	logger.Info("fan control released", zap.String("pwm", ctrl.pwmPath))
+
+	ctrl.pwmPath = ""
+	ctrl.originalPWM = nil
+	ctrl.originalEnable = nil
+	ctrl.lastPWM = -1
+
+	return nil
+}
+
+// zoneTemperature returns the temperature of the zone with the specified type, or the hottest zone if the type is empty.
+func (ctrl *FanController) zoneTemperature(ctx context.Context, r controller.Runtime, zoneType string) (int32, bool, error) {
+	list, err := safe.ReaderList[*hardware.ThermalZoneStatus](ctx, r, resource.NewMetadata(hardware.NamespaceName, hardware.ThermalZoneStatusType, "", resource.VersionUndefined))
+	if err != nil {
+		return 0, false, fmt.Errorf("error listing thermal zones: %w", err)
+	}
+
+	var (
+		temperature int32
+		found       bool
+	)
+
+	for iter := safe.IteratorFromList(list); iter.Next(); {
+		spec := iter.Value().TypedSpec()
+
+		if zoneType != "" && spec.Type != zoneType {
+			continue
+		}
+
+		if !found || spec.Temperature > temperature {
+			temperature = spec.Temperature
+			found = true
+		}
+	}
+
+	return temperature, found, nil
+}
+
+// findPWM returns the path to the PWM control of the hwmon device with the specified name.
+func (ctrl *FanController) findPWM(name string) (string, error) {
+	devices, err := filepath.Glob(filepath.Join(ctrl.SysfsPath, "class", "hwmon", "hwmon*"))
+	if err != nil {
+		return "", err
+	}
+
+	for _, device := range devices {
+		deviceName, err := readSysfsString(filepath.Join(device, "name"))
+		if err != nil {
+			continue
+		}
+
+		if deviceName == name {
+			return filepath.Join(device, "pwm1"), nil
+		}
+	}
+
+	return "", fmt.Errorf("hwmon device %q not found", name)
+}
+
+// fanSpeed calculates the fan speed in percent for the temperature (in millidegrees Celsius) using the fan curve.
+//
+// The speed is interpolated linearly between the curve points.
+func fanSpeed(curve []talosconfig.FanCurvePoint, temperature int32) int {
+	if len(curve) == 0 {
+		return 100
+	}
+
+	celsius := float64(temperature) / 1000
+
+	if celsius <= float64(curve[0].Temperature()) {
+		return curve[0].Speed()
+	}
+
+	for i := 1; i < len(curve); i++ {
+		lo, hi := curve[i-1], curve[i]
+
+		if celsius > float64(hi.Temperature()) {
+			continue
+		}
+
+		ratio := (celsius - float64(lo.Temperature())) / float64(hi.Temperature()-lo.Temperature())
+
+		return lo.Speed() + int(ratio*float64(hi.Speed()-lo.Speed()))
+	}
+
+	return curve[len(curve)-1].Speed()
+}
@@ -0,0 +1,203 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package hardware
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/cosi-project/runtime/pkg/controller"
+	"github.com/cosi-project/runtime/pkg/resource"
+	"github.com/cosi-project/runtime/pkg/safe"
+	"github.com/cosi-project/runtime/pkg/state"
+	"github.com/siderolabs/go-pointer"
+	"go.uber.org/zap"
+
+	runtimetalos "github.com/siderolabs/talos/internal/app/machined/pkg/runtime"
+	"github.com/siderolabs/talos/pkg/machinery/resources/config"
+	"github.com/siderolabs/talos/pkg/machinery/resources/runtime"
+)
+
+// LED patterns.
+const (
+	LEDPatternOn        = "on"
+	LEDPatternOff       = "off"
+	LEDPatternBlink     = "blink"
+	LEDPatternBlinkFast = "blink-fast"
+)
+
+// LEDController drives the LEDs to indicate the machine stage.
+//
+// LED patterns are taken from the machine config, falling back to the board defaults.
+type LEDController struct {
+	V1Alpha1Mode runtimetalos.Mode
+	Board        runtimetalos.Board
+
+	// SysfsPath defaults to DefaultSysfsPath.
+	SysfsPath string
+
+	applied map[string]string
+}
+
+// Name implements controller.Controller interface.
+func (ctrl *LEDController) Name() string {
+	return "hardware.LEDController"
+}
+
+// Inputs implements controller.Controller interface.
+func (ctrl *LEDController) Inputs() []controller.Input {
+	return []controller.Input{
+		{
+			Namespace: config.NamespaceName,
+			Type:      config.MachineConfigType,
+			ID:        pointer.To(config.V1Alpha1ID),
+			Kind:      controller.InputWeak,
+		},
+		{
+			Namespace: runtime.NamespaceName,
+			Type:      runtime.MachineStatusType,
+			ID:        pointer.To(runtime.MachineStatusID),
+			Kind:      controller.InputWeak,
+		},
+	}
+}
+
+// Outputs implements controller.Controller interface.
+func (ctrl *LEDController) Outputs() []controller.Output {
+	return nil
+}
+
+// Run implements controller.Controller interface.
+func (ctrl *LEDController) Run(ctx context.Context, r controller.Runtime, logger *zap.Logger) error {
+	if ctrl.V1Alpha1Mode == runtimetalos.ModeContainer {
+		return nil
+	}
+
+	if ctrl.SysfsPath == "" {
+		ctrl.SysfsPath = DefaultSysfsPath
+	}
+
+	ctrl.applied = map[string]string{}
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-r.EventCh():
+		}
+
+		machineStatus, err := safe.ReaderGet[*runtime.MachineStatus](ctx, r, resource.NewMetadata(runtime.NamespaceName, runtime.MachineStatusType, runtime.MachineStatusID, resource.VersionUndefined))
+		if err != nil {
+			if !state.IsNotFoundError(err) {
+				return fmt.Errorf("error getting machine status: %w", err)
+			}
+
+			continue
+		}
+
+		leds, err := ctrl.leds(ctx, r)
+		if err != nil {
+			return err
+		}
+
+		stage := machineStatus.TypedSpec().Stage.String()
+
+		for _, led := range leds {
+			pattern, ok := led.Stages[stage]
+			if !ok || ctrl.applied[led.Name] == pattern {
+				continue
+			}
+
+			if err = ctrl.setPattern(led.Name, pattern); err != nil {
+				// LEDs are not critical, so don't fail the controller
+				logger.Warn("failed to set LED pattern", zap.String("led", led.Name), zap.String("pattern", pattern), zap.Error(err))
+
+				continue
+			}
+
+			logger.Debug("LED pattern updated", zap.String("led", led.Name), zap.String("pattern", pattern), zap.String("stage", stage))
+
+			ctrl.applied[led.Name] = pattern
+		}
+
+		r.ResetRestartBackoff()
+	}
+}
+
+// leds returns the LED configuration from the machine config or the board defaults.
+func (ctrl *LEDController) leds(ctx context.Context, r controller.Runtime) ([]runtimetalos.BoardLED, error) {
+	cfg, err := safe.ReaderGet[*config.MachineConfig](ctx, r, resource.NewMetadata(config.NamespaceName, config.MachineConfigType, config.V1Alpha1ID, resource.VersionUndefined))
+	if err != nil && !state.IsNotFoundError(err) {
+		return nil, fmt.Errorf("error getting config: %w", err)
+	}
+
+	if cfg != nil {
+		if configLEDs := cfg.Config().Machine().LEDs(); len(configLEDs) > 0 {
+			leds := make([]runtimetalos.BoardLED, 0, len(configLEDs))
+
+			for _, led := range configLEDs {
+				leds = append(leds, runtimetalos.BoardLED{Name: led.Name(), Stages: led.Stages()})
+			}
+
+			return leds, nil
+		}
+	}
+
+	if boardLEDs, ok := ctrl.Board.(runtimetalos.BoardLEDs); ok {
+		return boardLEDs.LEDs(), nil
+	}
+
+	return nil, nil
+}
+
+// setPattern configures the LED trigger for the pattern.
+func (ctrl *LEDController) setPattern(name, pattern string) error {
+	dir := filepath.Join(ctrl.SysfsPath, "class", "leds", name)
+
+	switch pattern {
+	case LEDPatternOn, LEDPatternOff:
+		if err := writeSysfs(filepath.Join(dir, "trigger"), "none"); err != nil {
+			return err
+		}
+
+		brightness := "0"
+
+		if pattern == LEDPatternOn {
+			var err error
+
+			if brightness, err = readSysfsString(filepath.Join(dir, "max_brightness")); err != nil {
+				return err
+			}
+		}
+
+		return writeSysfs(filepath.Join(dir, "brightness"), brightness)
+	case LEDPatternBlink, LEDPatternBlinkFast:
+		delay := "500"
+
+		if pattern == LEDPatternBlinkFast {
+			delay = "100"
+		}
+
+		if err := writeSysfs(filepath.Join(dir, "trigger"), "timer"); err != nil {
+			return err
+		}
+
+		// delay attributes appear once the timer trigger is activated
+		if err := writeSysfs(filepath.Join(dir, "delay_on"), delay); err != nil {
+			return err
+		}
+
+		return writeSysfs(filepath.Join(dir, "delay_off"), delay)
+	default:
+		// any other pattern is a kernel LED trigger, e.g. heartbeat
+		return writeSysfs(filepath.Join(dir, "trigger"), pattern)
+	}
+}
+
+func writeSysfs(path, value string) error {
+	return os.WriteFile(path, []byte(value), 0o644)
+}
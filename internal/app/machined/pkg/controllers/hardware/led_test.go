@@ -0,0 +1,179 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package hardware_test
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/siderolabs/go-retry/retry"
+	"github.com/stretchr/testify/suite"
+
+	hardwarectrl "github.com/siderolabs/talos/internal/app/machined/pkg/controllers/hardware"
+	"github.com/siderolabs/talos/internal/app/machined/pkg/runtime/v1alpha1/board/descriptor"
+	"github.com/siderolabs/talos/internal/app/machined/pkg/runtime/v1alpha1/board/rock5b"
+	"github.com/siderolabs/talos/pkg/machinery/config/types/v1alpha1"
+	"github.com/siderolabs/talos/pkg/machinery/resources/config"
+	"github.com/siderolabs/talos/pkg/machinery/resources/runtime"
+)
+
+type LEDSuite struct {
+	HardwareSuite
+
+	sysfs string
+}
+
+func (suite *LEDSuite) SetupTest() {
+	suite.HardwareSuite.SetupTest()
+
+	suite.sysfs = suite.T().TempDir()
+
+	for _, led := range []string{"blue:status", "green:activity"} {
+		suite.writeSysfs("class/leds/"+led+"/trigger", "none")
+		suite.writeSysfs("class/leds/"+led+"/brightness", "0")
+		suite.writeSysfs("class/leds/"+led+"/max_brightness", "255")
+	}
+}
+
+func (suite *LEDSuite) writeSysfs(path, contents string) {
+	path = filepath.Join(suite.sysfs, path)
+
+	suite.Require().NoError(os.MkdirAll(filepath.Dir(path), 0o755))
+	suite.Require().NoError(os.WriteFile(path, []byte(contents+"\n"), 0o644))
+}
+
+func (suite *LEDSuite) assertSysfs(expected map[string]string) {
+	suite.Assert().NoError(retry.Constant(5*time.Second, retry.WithUnits(100*time.Millisecond)).Retry(
+		func() error {
+			for path, value := range expected {
+				contents, err := os.ReadFile(filepath.Join(suite.sysfs, path))
+				if err != nil {
+					return err
+				}
+
+				if actual := strings.TrimSpace(string(contents)); actual != value {
+					return retry.ExpectedErrorf("%s = %q, expected %q", path, actual, value)
+				}
+			}
+
+			return nil
+		},
+	))
+}
+
+func (suite *LEDSuite) setStage(stage runtime.MachineStage) {
+	machineStatus := runtime.NewMachineStatus()
+	machineStatus.TypedSpec().Stage = stage
+
+	existing, err := suite.state.Get(suite.ctx, machineStatus.Metadata())
+	if err == nil {
+		machineStatus.Metadata().SetVersion(existing.Metadata().Version())
+		suite.Require().NoError(suite.state.Update(suite.ctx, machineStatus))
+
+		return
+	}
+
+	suite.Require().NoError(suite.state.Create(suite.ctx, machineStatus))
+}
+
+func (suite *LEDSuite) TestBoardDefaults() {
+	suite.Require().NoError(
+		suite.runtime.RegisterController(
+			&hardwarectrl.LEDController{
+				Board:     descriptor.New(rock5b.Descriptor),
+				SysfsPath: suite.sysfs,
+			},
+		),
+	)
+
+	suite.startRuntime()
+
+	suite.setStage(runtime.MachineStageBooting)
+
+	suite.assertSysfs(map[string]string{
+		"class/leds/blue:status/trigger":   "timer",
+		"class/leds/blue:status/delay_on":  "100",
+		"class/leds/blue:status/delay_off": "100",
+	})
+
+	suite.setStage(runtime.MachineStageRunning)
+
+	suite.assertSysfs(map[string]string{
+		"class/leds/blue:status/trigger": "heartbeat",
+	})
+
+	suite.setStage(runtime.MachineStageRebooting)
+
+	suite.assertSysfs(map[string]string{
+		"class/leds/blue:status/trigger":    "none",
+		"class/leds/blue:status/brightness": "255",
+	})
+}
+
+func (suite *LEDSuite) TestConfig() {
+	suite.Require().NoError(
+		suite.runtime.RegisterController(
+			&hardwarectrl.LEDController{
+				Board:     descriptor.New(rock5b.Descriptor),
+				SysfsPath: suite.sysfs,
+			},
+		),
+	)
+
+	suite.startRuntime()
+
+	cfg := config.NewMachineConfig(&v1alpha1.Config{
+		ConfigVersion: "v1alpha1",
+		MachineConfig: &v1alpha1.MachineConfig{
+			MachineLEDs: []*v1alpha1.LEDConfig{
+				{
+					LEDName: "green:activity",
+					LEDStages: map[string]string{
+						"maintenance": "blink",
+						"running":     "off",
+					},
+				},
+			},
+		},
+	})
+
+	suite.Require().NoError(suite.state.Create(suite.ctx, cfg))
+
+	suite.writeSysfs("class/leds/green:activity/brightness", "255")
+
+	suite.setStage(runtime.MachineStageMaintenance)
+
+	suite.assertSysfs(map[string]string{
+		"class/leds/green:activity/trigger":   "timer",
+		"class/leds/green:activity/delay_on":  "500",
+		"class/leds/green:activity/delay_off": "500",
+		// board defaults are not used when the config has LEDs
+		"class/leds/blue:status/trigger": "none",
+	})
+
+	suite.setStage(runtime.MachineStageRunning)
+
+	suite.assertSysfs(map[string]string{
+		"class/leds/green:activity/trigger":    "none",
+		"class/leds/green:activity/brightness": "0",
+	})
+}
+
+func (suite *LEDSuite) startRuntime() {
+	suite.wg.Add(1)
+
+	go func() {
+		defer suite.wg.Done()
+
+		suite.Assert().NoError(suite.runtime.Run(suite.ctx))
+	}()
+}
+
+func TestLEDSuite(t *testing.T) {
+	suite.Run(t, new(LEDSuite))
+}
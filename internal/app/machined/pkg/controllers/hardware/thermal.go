@@ -0,0 +1,199 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package hardware
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/cosi-project/runtime/pkg/controller"
+	"github.com/cosi-project/runtime/pkg/resource"
+	"github.com/cosi-project/runtime/pkg/safe"
+	"go.uber.org/zap"
+
+	runtimetalos "github.com/siderolabs/talos/internal/app/machined/pkg/runtime"
+	"github.com/siderolabs/talos/pkg/machinery/resources/hardware"
+)
+
+// DefaultSysfsPath is the mount point of sysfs.
+const DefaultSysfsPath = "/sys"
+
+const defaultThermalUpdateInterval = 5 * time.Second
+
+// ThermalZoneStatusController publishes the state of the kernel thermal zones.
+type ThermalZoneStatusController struct {
+	V1Alpha1Mode runtimetalos.Mode
+
+	// SysfsPath defaults to DefaultSysfsPath.
+	SysfsPath string
+	// UpdateInterval defaults to 5 seconds.
+	UpdateInterval time.Duration
+}
+
+// Name implements controller.Controller interface.
+func (ctrl *ThermalZoneStatusController) Name() string {
+	return "hardware.ThermalZoneStatusController"
+}
+
+// Inputs implements controller.Controller interface.
+func (ctrl *ThermalZoneStatusController) Inputs() []controller.Input {
+	return nil
+}
+
+// Outputs implements controller.Controller interface.
+func (ctrl *ThermalZoneStatusController) Outputs() []controller.Output {
+	return []controller.Output{
+		{
+			Type: hardware.ThermalZoneStatusType,
+			Kind: controller.OutputExclusive,
+		},
+	}
+}
+
+// Run implements controller.Controller interface.
+func (ctrl *ThermalZoneStatusController) Run(ctx context.Context, r controller.Runtime, logger *zap.Logger) error {
+	if ctrl.V1Alpha1Mode == runtimetalos.ModeContainer {
+		return nil
+	}
+
+	if ctrl.SysfsPath == "" {
+		ctrl.SysfsPath = DefaultSysfsPath
+	}
+
+	if ctrl.UpdateInterval == 0 {
+		ctrl.UpdateInterval = defaultThermalUpdateInterval
+	}
+
+	ticker := time.NewTicker(ctrl.UpdateInterval)
+	defer ticker.Stop()
+
+	for {
+		if err := ctrl.updateZones(ctx, r, logger); err != nil {
+			return err
+		}
+
+		r.ResetRestartBackoff()
+
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-r.EventCh():
+		case <-ticker.C:
+		}
+	}
+}
+
+func (ctrl *ThermalZoneStatusController) updateZones(ctx context.Context, r controller.Runtime, logger *zap.Logger) error {
+	zones, err := filepath.Glob(filepath.Join(ctrl.SysfsPath, "class", "thermal", "thermal_zone*"))
+	if err != nil {
+		return err
+	}
+
+	touchedIDs := make(map[resource.ID]struct{}, len(zones))
+
+	for _, zone := range zones {
+		id := filepath.Base(zone)
+
+		zoneType, err := readSysfsString(filepath.Join(zone, "type"))
+		if err != nil {
+			logger.Debug("skipping thermal zone", zap.String("zone", id), zap.Error(err))
+
+			continue
+		}
+
+		temperature, err := readSysfsInt(filepath.Join(zone, "temp"))
+		if err != nil {
+			// some zones report an error on read until the sensor is ready
+			continue
+		}
+
+		critical, err := criticalTripPoint(zone)
+		if err != nil {
+			logger.Debug("error reading thermal zone trip points", zap.String("zone", id), zap.Error(err))
+		}
+
+		touchedIDs[id] = struct{}{}
+
+		if err = safe.WriterModify(ctx, r, hardware.NewThermalZoneStatus(id), func(res *hardware.ThermalZoneStatus) error {
+			spec := res.TypedSpec()
+
+			spec.Type = zoneType
+			spec.Temperature = temperature
+			spec.CriticalTemperature = critical
+
+			return nil
+		}); err != nil {
+			return fmt.Errorf("error updating objects: %w", err)
+		}
+	}
+
+	list, err := safe.ReaderList[*hardware.ThermalZoneStatus](ctx, r, resource.NewMetadata(hardware.NamespaceName, hardware.ThermalZoneStatusType, "", resource.VersionUndefined))
+	if err != nil {
+		return fmt.Errorf("error listing resources: %w", err)
+	}
+
+	for iter := safe.IteratorFromList(list); iter.Next(); {
+		res := iter.Value()
+
+		if _, ok := touchedIDs[res.Metadata().ID()]; !ok {
+			if err = r.Destroy(ctx, res.Metadata()); err != nil {
+				return fmt.Errorf("error cleaning up thermal zones: %w", err)
+			}
+		}
+	}
+
+	return nil
+}
+
+// criticalTripPoint returns the temperature of the critical trip point of the zone, if any.
+func criticalTripPoint(zone string) (int32, error) {
+	tripTypes, err := filepath.Glob(filepath.Join(zone, "trip_point_*_type"))
+	if err != nil {
+		return 0, err
+	}
+
+	for _, tripType := range tripTypes {
+		typ, err := readSysfsString(tripType)
+		if err != nil {
+			return 0, err
+		}
+
+		if typ != "critical" {
+			continue
+		}
+
+		return readSysfsInt(strings.TrimSuffix(tripType, "_type") + "_temp")
+	}
+
+	return 0, nil
+}
+
+func readSysfsString(path string) (string, error) {
+	contents, err := os.ReadFile(path)
+	if err != nil {
+		return "", err
+	}
+
+	return strings.TrimSpace(string(contents)), nil
+}
+
+func readSysfsInt(path string) (int32, error) {
+	contents, err := readSysfsString(path)
+	if err != nil {
+		return 0, err
+	}
+
+	v, err := strconv.ParseInt(contents, 10, 32)
+	if err != nil {
+		return 0, err
+	}
+
+	return int32(v), nil
+}
@@ -0,0 +1,198 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package hardware_test
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/cosi-project/runtime/pkg/resource"
+	"github.com/siderolabs/go-retry/retry"
+	"github.com/stretchr/testify/suite"
+
+	hardwarectrl "github.com/siderolabs/talos/internal/app/machined/pkg/controllers/hardware"
+	"github.com/siderolabs/talos/pkg/machinery/config/types/v1alpha1"
+	"github.com/siderolabs/talos/pkg/machinery/resources/config"
+	"github.com/siderolabs/talos/pkg/machinery/resources/hardware"
+)
+
+type ThermalSuite struct {
+	HardwareSuite
+
+	sysfs string
+}
+
+func (suite *ThermalSuite) SetupTest() {
+	suite.HardwareSuite.SetupTest()
+
+	suite.sysfs = suite.T().TempDir()
+
+	suite.writeSysfs("class/thermal/thermal_zone0/type", "soc-thermal")
+	suite.writeSysfs("class/thermal/thermal_zone0/temp", "61250")
+	suite.writeSysfs("class/thermal/thermal_zone0/trip_point_0_type", "passive")
+	suite.writeSysfs("class/thermal/thermal_zone0/trip_point_0_temp", "85000")
+	suite.writeSysfs("class/thermal/thermal_zone0/trip_point_1_type", "critical")
+	suite.writeSysfs("class/thermal/thermal_zone0/trip_point_1_temp", "115000")
+
+	suite.writeSysfs("class/thermal/thermal_zone1/type", "gpu-thermal")
+	suite.writeSysfs("class/thermal/thermal_zone1/temp", "48000")
+
+	suite.writeSysfs("class/hwmon/hwmon0/name", "tcpm_source_psy")
+	suite.writeSysfs("class/hwmon/hwmon1/name", "pwmfan")
+	suite.writeSysfs("class/hwmon/hwmon1/pwm1", "0")
+	suite.writeSysfs("class/hwmon/hwmon1/pwm1_enable", "2")
+}
+
+func (suite *ThermalSuite) writeSysfs(path, contents string) {
+	path = filepath.Join(suite.sysfs, path)
+
+	suite.Require().NoError(os.MkdirAll(filepath.Dir(path), 0o755))
+	suite.Require().NoError(os.WriteFile(path, []byte(contents+"\n"), 0o644))
+}
+
+func (suite *ThermalSuite) TestThermalZoneStatus() {
+	// the zone without the type is skipped
+	suite.writeSysfs("class/thermal/thermal_zone2/temp", "50000")
+
+	suite.Require().NoError(
+		suite.runtime.RegisterController(
+			&hardwarectrl.ThermalZoneStatusController{
+				SysfsPath:      suite.sysfs,
+				UpdateInterval: 100 * time.Millisecond,
+			},
+		),
+	)
+
+	suite.startRuntime()
+
+	for id, expected := range map[string]hardware.ThermalZoneStatusSpec{
+		"thermal_zone0": {
+			Type:                "soc-thermal",
+			Temperature:         61250,
+			CriticalTemperature: 115000,
+		},
+		"thermal_zone1": {
+			Type:        "gpu-thermal",
+			Temperature: 48000,
+		},
+	} {
+		expected := expected
+
+		suite.Assert().NoError(
+			retry.Constant(1*time.Second, retry.WithUnits(100*time.Millisecond)).Retry(
+				suite.assertResource(*hardware.NewThermalZoneStatus(id).Metadata(), func(r resource.Resource) error {
+					spec := *r.(*hardware.ThermalZoneStatus).TypedSpec()
+					if spec != expected {
+						return retry.ExpectedError(fmt.Errorf("thermal zone doesn't match: %v != %v", expected, spec))
+					}
+
+					return nil
+				}),
+			),
+		)
+	}
+
+	suite.Assert().NoError(retry.Constant(1*time.Second, retry.WithUnits(100*time.Millisecond)).Retry(suite.assertNoResource(*hardware.NewThermalZoneStatus("thermal_zone2").Metadata())))
+
+	suite.Require().NoError(os.RemoveAll(filepath.Join(suite.sysfs, "class/thermal/thermal_zone1")))
+
+	suite.Assert().NoError(retry.Constant(1*time.Second, retry.WithUnits(100*time.Millisecond)).Retry(suite.assertNoResource(*hardware.NewThermalZoneStatus("thermal_zone1").Metadata())))
+}
+
+func (suite *ThermalSuite) TestFanCurve() {
+	suite.Require().NoError(
+		suite.runtime.RegisterController(
+			&hardwarectrl.ThermalZoneStatusController{
+				SysfsPath:      suite.sysfs,
+				UpdateInterval: 100 * time.Millisecond,
+			},
+		),
+	)
+
+	suite.Require().NoError(
+		suite.runtime.RegisterController(
+			&hardwarectrl.FanController{
+				SysfsPath: suite.sysfs,
+			},
+		),
+	)
+
+	suite.startRuntime()
+
+	cfg := config.NewMachineConfig(&v1alpha1.Config{
+		ConfigVersion: "v1alpha1",
+		MachineConfig: &v1alpha1.MachineConfig{
+			MachineFan: &v1alpha1.FanConfig{
+				FanHwmon:       "pwmfan",
+				FanThermalZone: "soc-thermal",
+				FanCurve: []v1alpha1.FanCurvePoint{
+					{FanCurveTemperature: 40, FanCurveSpeed: 0},
+					{FanCurveTemperature: 55, FanCurveSpeed: 40},
+					{FanCurveTemperature: 70, FanCurveSpeed: 100},
+				},
+			},
+		},
+	})
+
+	suite.Require().NoError(suite.state.Create(suite.ctx, cfg))
+
+	// 61.25 °C: 40% + (6.25/15)*60% = 65% -> 165/255
+	suite.assertPWM("165")
+	suite.assertSysfs("class/hwmon/hwmon1/pwm1_enable", "1")
+
+	suite.writeSysfs("class/thermal/thermal_zone0/temp", "30000")
+
+	suite.assertPWM("0")
+
+	suite.writeSysfs("class/thermal/thermal_zone0/temp", "90000")
+
+	suite.assertPWM("255")
+
+	// removing the fan config returns the fan to the automatic control
+	cfg.Config().Raw().(*v1alpha1.Config).MachineConfig.MachineFan = nil
+	suite.Require().NoError(suite.state.Update(suite.ctx, cfg))
+
+	suite.assertPWM("0")
+	suite.assertSysfs("class/hwmon/hwmon1/pwm1_enable", "2")
+}
+
+func (suite *ThermalSuite) assertPWM(expected string) {
+	suite.assertSysfs("class/hwmon/hwmon1/pwm1", expected)
+}
+
+func (suite *ThermalSuite) assertSysfs(path, expected string) {
+	suite.Assert().NoError(
+		retry.Constant(3*time.Second, retry.WithUnits(100*time.Millisecond)).Retry(func() error {
+			contents, err := os.ReadFile(filepath.Join(suite.sysfs, path))
+			if err != nil {
+				return err
+			}
+
+			if actual := strings.TrimSpace(string(contents)); actual != expected {
+				return retry.ExpectedError(fmt.Errorf("%s doesn't match: %q != %q", path, expected, actual))
+			}
+
+			return nil
+		}),
+	)
+}
+
+func TestThermalSuite(t *testing.T) {
+	suite.Run(t, new(ThermalSuite))
+}
+
+func (suite *ThermalSuite) startRuntime() {
+	suite.wg.Add(1)
+
+	go func() {
+		defer suite.wg.Done()
+
+		suite.Assert().NoError(suite.runtime.Run(suite.ctx))
+	}()
+}
@@ -307,6 +307,10 @@ func NewKubeletConfiguration(cfgSpec *k8s.KubeletConfigSpec) (*kubeletconfig.Kub
 		config.ClusterDNS = cfgSpec.ClusterDNS
 	}
 
+	if config.ResolverConfig == nil {
+		config.ResolverConfig = pointer.To(constants.PodResolvConfPath)
+	}
+
 	if config.SerializeImagePulls == nil {
 		config.SerializeImagePulls = pointer.To(false)
 	}
@@ -428,6 +428,7 @@ func TestNewKubeletConfigurationMerge(t *testing.T) {
 		OOMScoreAdj:           pointer.To[int32](constants.KubeletOOMScoreAdj),
 		ClusterDomain:         "cluster.local",
 		ClusterDNS:            []string{"10.0.0.5"},
+		ResolverConfig:        pointer.To(constants.PodResolvConfPath),
 		SerializeImagePulls:   pointer.To(false),
 		FailSwapOn:            pointer.To(false),
 		SystemReserved: map[string]string{
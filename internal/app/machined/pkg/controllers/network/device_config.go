@@ -174,7 +174,7 @@ func (ctrl *DeviceConfigController) Run(ctx context.Context, r controller.Runtim
 func (ctrl *DeviceConfigController) getDeviceBySelector(device *v1alpha1.Device, links safe.List[*network.LinkStatus]) error {
 	selector := device.Selector()
 
-	matches := ctrl.selectDevices(selector, links)
+	matches := SelectLinks(selector, links)
 	if len(matches) == 0 {
 		return fmt.Errorf("no matching network device for defined selector: %+v", selector)
 	}
@@ -193,7 +193,7 @@ func (ctrl *DeviceConfigController) expandBondSelector(device *v1alpha1.Device,
 		matches = append(matches,
 			// filter out bond device itself, as it will inherit the MAC address of the first link
 			slices.Filter(
-				ctrl.selectDevices(selector, links),
+				SelectLinks(selector, links),
 				func(link *network.LinkStatus) bool {
 					return link.Metadata().ID() != device.Interface()
 				})...)
@@ -216,7 +216,8 @@ func (ctrl *DeviceConfigController) expandBondSelector(device *v1alpha1.Device,
 	return nil
 }
 
-func (ctrl *DeviceConfigController) selectDevices(selector talosconfig.NetworkDeviceSelector, links safe.List[*network.LinkStatus]) []*network.LinkStatus {
+// SelectLinks returns the links matching the network device selector.
+func SelectLinks(selector talosconfig.NetworkDeviceSelector, links safe.List[*network.LinkStatus]) []*network.LinkStatus {
 	var result []*network.LinkStatus
 
 	for iter := safe.IteratorFromList(links); iter.Next(); {
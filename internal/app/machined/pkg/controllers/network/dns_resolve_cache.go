@@ -0,0 +1,312 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package network
+
+import (
+	"context"
+	"fmt"
+	"net/netip"
+	"time"
+
+	"github.com/cosi-project/runtime/pkg/controller"
+	"github.com/cosi-project/runtime/pkg/resource"
+	"github.com/cosi-project/runtime/pkg/state"
+	"github.com/siderolabs/go-pointer"
+	"go.uber.org/zap"
+
+	"github.com/siderolabs/talos/internal/pkg/dns"
+	talosconfig "github.com/siderolabs/talos/pkg/machinery/config"
+	"github.com/siderolabs/talos/pkg/machinery/resources/config"
+	"github.com/siderolabs/talos/pkg/machinery/resources/network"
+)
+
+// dnsStatusInterval is the interval the cache and upstream status is refreshed.
+const dnsStatusInterval = 10 * time.Second
+
+// DNSResolveCacheController runs the caching DNS resolver on the host based on network.HostDNSConfig.
+type DNSResolveCacheController struct{}
+
+// Name implements controller.Controller interface.
+func (ctrl *DNSResolveCacheController) Name() string {
+	return "network.DNSResolveCacheController"
+}
+
+// Inputs implements controller.Controller interface.
+func (ctrl *DNSResolveCacheController) Inputs() []controller.Input {
+	return []controller.Input{
+		{
+			Namespace: network.NamespaceName,
+			Type:      network.HostDNSConfigType,
+			ID:        pointer.To(network.HostDNSConfigID),
+			Kind:      controller.InputWeak,
+		},
+		{
+			Namespace: network.NamespaceName,
+			Type:      network.ResolverStatusType,
+			ID:        pointer.To(network.ResolverID),
+			Kind:      controller.InputWeak,
+		},
+		{
+			Namespace: network.NamespaceName,
+			Type:      network.HostnameStatusType,
+			ID:        pointer.To(network.HostnameID),
+			Kind:      controller.InputWeak,
+		},
+		{
+			Namespace: network.NamespaceName,
+			Type:      network.NodeAddressType,
+			ID:        pointer.To(network.NodeAddressDefaultID),
+			Kind:      controller.InputWeak,
+		},
+		{
+			Namespace: config.NamespaceName,
+			Type:      config.MachineConfigType,
+			ID:        pointer.To(config.V1Alpha1ID),
+			Kind:      controller.InputWeak,
+		},
+	}
+}
+
+// Outputs implements controller.Controller interface.
+func (ctrl *DNSResolveCacheController) Outputs() []controller.Output {
+	return []controller.Output{
+		{
+			Type: network.DNSResolveCacheType,
+			Kind: controller.OutputExclusive,
+		},
+		{
+			Type: network.DNSUpstreamType,
+			Kind: controller.OutputExclusive,
+		},
+	}
+}
+
+// Run implements controller.Controller interface.
+//
+//nolint:gocyclo,cyclop
+func (ctrl *DNSResolveCacheController) Run(ctx context.Context, r controller.Runtime, logger *zap.Logger) error {
+	var (
+		server          *dns.Server
+		listenAddresses []netip.AddrPort
+	)
+
+	stopServer := func() {
+		if server != nil {
+			logger.Info("stopping host DNS resolver")
+
+			server.Stop()
+
+			server = nil
+			listenAddresses = nil
+		}
+	}
+
+	defer stopServer()
+
+	ticker := time.NewTicker(dnsStatusInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-r.EventCh():
+		case <-ticker.C:
+		}
+
+		var hostDNSConfig *network.HostDNSConfigSpec
+
+		cfg, err := r.Get(ctx, resource.NewMetadata(network.NamespaceName, network.HostDNSConfigType, network.HostDNSConfigID, resource.VersionUndefined))
+		if err != nil {
+			if !state.IsNotFoundError(err) {
+				return fmt.Errorf("error getting host DNS config: %w", err)
+			}
+		} else {
+			hostDNSConfig = cfg.(*network.HostDNSConfig).TypedSpec()
+		}
+
+		if hostDNSConfig == nil || !hostDNSConfig.Enabled || !equalAddrPorts(listenAddresses, hostDNSConfig.ListenAddresses) {
+			stopServer()
+		}
+
+		touchedIDs := map[resource.Type]map[resource.ID]struct{}{
+			network.DNSResolveCacheType: {},
+			network.DNSUpstreamType:     {},
+		}
+
+		if hostDNSConfig != nil && hostDNSConfig.Enabled {
+			if server == nil {
+				newServer := dns.NewServer(logger, hostDNSConfig.ListenAddresses)
+
+				if err = newServer.Start(); err != nil {
+					return fmt.Errorf("error starting host DNS resolver: %w", err)
+				}
+
+				logger.Info("started host DNS resolver", zap.Stringers("addresses", hostDNSConfig.ListenAddresses))
+
+				server = newServer
+				listenAddresses = append([]netip.AddrPort(nil), hostDNSConfig.ListenAddresses...)
+			}
+
+			if err = ctrl.updateServer(ctx, r, server, listenAddresses); err != nil {
+				return err
+			}
+
+			if err = ctrl.updateStatus(ctx, r, server, listenAddresses, touchedIDs); err != nil {
+				return err
+			}
+		}
+
+		for resourceType, touched := range touchedIDs {
+			list, err := r.List(ctx, resource.NewMetadata(network.NamespaceName, resourceType, "", resource.VersionUndefined))
+			if err != nil {
+				return fmt.Errorf("error listing resources: %w", err)
+			}
+
+			for _, res := range list.Items {
+				if _, ok := touched[res.Metadata().ID()]; !ok {
+					if err = r.Destroy(ctx, res.Metadata()); err != nil {
+						return fmt.Errorf("error cleaning up resources: %w", err)
+					}
+				}
+			}
+		}
+
+		r.ResetRestartBackoff()
+	}
+}
+
+// updateServer updates the upstream nameservers and the local host names of the resolver.
+//
+//nolint:gocyclo
+func (ctrl *DNSResolveCacheController) updateServer(ctx context.Context, r controller.Runtime, server *dns.Server, listenAddresses []netip.AddrPort) error {
+	var upstreams []netip.AddrPort
+
+	resolverStatus, err := r.Get(ctx, resource.NewMetadata(network.NamespaceName, network.ResolverStatusType, network.ResolverID, resource.VersionUndefined))
+	if err != nil {
+		if !state.IsNotFoundError(err) {
+			return fmt.Errorf("error getting resolver status: %w", err)
+		}
+	} else {
+	outer:
+		for _, addr := range resolverStatus.(*network.ResolverStatus).TypedSpec().DNSServers {
+			// never forward the queries to itself
+			for _, listenAddr := range listenAddresses {
+				if listenAddr.Addr() == addr {
+					continue outer
+				}
+			}
+
+			upstreams = append(upstreams, netip.AddrPortFrom(addr, 53))
+		}
+	}
+
+	server.SetUpstreams(upstreams)
+
+	hosts := map[string][]netip.Addr{
+		"localhost": {netip.MustParseAddr("127.0.0.1"), netip.IPv6Loopback()},
+	}
+
+	hostnameStatus, err := r.Get(ctx, resource.NewMetadata(network.NamespaceName, network.HostnameStatusType, network.HostnameID, resource.VersionUndefined))
+	if err != nil && !state.IsNotFoundError(err) {
+		return fmt.Errorf("error getting hostname status: %w", err)
+	}
+
+	nodeAddress, err := r.Get(ctx, resource.NewMetadata(network.NamespaceName, network.NodeAddressType, network.NodeAddressDefaultID, resource.VersionUndefined))
+	if err != nil && !state.IsNotFoundError(err) {
+		return fmt.Errorf("error getting node address: %w", err)
+	}
+
+	if hostnameStatus != nil && nodeAddress != nil && len(nodeAddress.(*network.NodeAddress).TypedSpec().Addresses) > 0 {
+		hostname := hostnameStatus.(*network.HostnameStatus).TypedSpec()
+		addr := nodeAddress.(*network.NodeAddress).TypedSpec().Addresses[0].Addr()
+
+		hosts[hostname.FQDN()] = []netip.Addr{addr}
+		hosts[hostname.Hostname] = []netip.Addr{addr}
+	}
+
+	cfg, err := r.Get(ctx, resource.NewMetadata(config.NamespaceName, config.MachineConfigType, config.V1Alpha1ID, resource.VersionUndefined))
+	if err != nil && !state.IsNotFoundError(err) {
+		return fmt.Errorf("error getting config: %w", err)
+	}
+
+	if cfg != nil {
+		addExtraHosts(hosts, cfg.(*config.MachineConfig).Config())
+	}
+
+	server.SetHosts(hosts)
+
+	return nil
+}
+
+// updateStatus publishes the cache statistics and the upstream status.
+func (ctrl *DNSResolveCacheController) updateStatus(ctx context.Context, r controller.Runtime, server *dns.Server, listenAddresses []netip.AddrPort,
+	touchedIDs map[resource.Type]map[resource.ID]struct{},
+) error {
+	stats := server.Stats()
+
+	if err := r.Modify(ctx, network.NewDNSResolveCache(network.NamespaceName, network.DNSResolveCacheID), func(res resource.Resource) error {
+		*res.(*network.DNSResolveCache).TypedSpec() = network.DNSResolveCacheSpec{
+			ListenAddresses: append([]netip.AddrPort(nil), listenAddresses...),
+			Entries:         uint32(stats.Entries),
+			Hits:            stats.Hits,
+			Misses:          stats.Misses,
+		}
+
+		return nil
+	}); err != nil {
+		return fmt.Errorf("error modifying DNS resolve cache: %w", err)
+	}
+
+	touchedIDs[network.DNSResolveCacheType][network.DNSResolveCacheID] = struct{}{}
+
+	for _, upstream := range server.Upstreams() {
+		id := upstream.Address.Addr().String()
+
+		if err := r.Modify(ctx, network.NewDNSUpstream(network.NamespaceName, id), func(res resource.Resource) error {
+			*res.(*network.DNSUpstream).TypedSpec() = network.DNSUpstreamSpec{
+				Address:   upstream.Address.Addr(),
+				Healthy:   upstream.Healthy,
+				LastError: upstream.LastError,
+			}
+
+			return nil
+		}); err != nil {
+			return fmt.Errorf("error modifying DNS upstream: %w", err)
+		}
+
+		touchedIDs[network.DNSUpstreamType][id] = struct{}{}
+	}
+
+	return nil
+}
+
+// addExtraHosts adds the extra host entries from the machine configuration.
+func addExtraHosts(hosts map[string][]netip.Addr, cfgProvider talosconfig.Provider) {
+	for _, extraHost := range cfgProvider.Machine().Network().ExtraHosts() {
+		addr, err := netip.ParseAddr(extraHost.IP())
+		if err != nil {
+			continue
+		}
+
+		for _, alias := range extraHost.Aliases() {
+			hosts[alias] = append(hosts[alias], addr)
+		}
+	}
+}
+
+func equalAddrPorts(a, b []netip.AddrPort) bool {
+	if len(a) != len(b) {
+		return false
+	}
+
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+
+	return true
+}
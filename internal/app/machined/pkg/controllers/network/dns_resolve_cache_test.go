@@ -0,0 +1,214 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package network_test
+
+import (
+	"net"
+	"net/netip"
+	"testing"
+	"time"
+
+	"github.com/cosi-project/runtime/pkg/resource/rtestutils"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+	"golang.org/x/net/dns/dnsmessage"
+
+	"github.com/siderolabs/talos/internal/app/machined/pkg/controllers/ctest"
+	netctrl "github.com/siderolabs/talos/internal/app/machined/pkg/controllers/network"
+	"github.com/siderolabs/talos/pkg/machinery/config/types/v1alpha1"
+	"github.com/siderolabs/talos/pkg/machinery/resources/config"
+	"github.com/siderolabs/talos/pkg/machinery/resources/network"
+)
+
+type DNSResolveCacheSuite struct {
+	ctest.DefaultSuite
+}
+
+// freeAddrPort returns the loopback address with the port free both for UDP and TCP.
+func (suite *DNSResolveCacheSuite) freeAddrPort() netip.AddrPort {
+	for i := 0; i < 10; i++ {
+		udpConn, err := net.ListenUDP("udp", &net.UDPAddr{IP: net.IPv4(127, 0, 0, 1)})
+		suite.Require().NoError(err)
+
+		addr := udpConn.LocalAddr().(*net.UDPAddr).AddrPort()
+
+		tcpListener, err := net.ListenTCP("tcp", net.TCPAddrFromAddrPort(addr))
+
+		udpConn.Close() //nolint:errcheck
+
+		if err == nil {
+			tcpListener.Close() //nolint:errcheck
+
+			return addr
+		}
+	}
+
+	suite.FailNow("failed to find a free port")
+
+	return netip.AddrPort{}
+}
+
+// lookup sends A query for the name to the resolver.
+func lookup(addr netip.AddrPort, name string) ([]netip.Addr, error) {
+	query := dnsmessage.Message{
+		Header: dnsmessage.Header{
+			ID:               1,
+			RecursionDesired: true,
+		},
+		Questions: []dnsmessage.Question{
+			{
+				Name:  dnsmessage.MustNewName(name),
+				Type:  dnsmessage.TypeA,
+				Class: dnsmessage.ClassINET,
+			},
+		},
+	}
+
+	raw, err := query.Pack()
+	if err != nil {
+		return nil, err
+	}
+
+	conn, err := net.Dial("udp", addr.String())
+	if err != nil {
+		return nil, err
+	}
+
+	defer conn.Close() //nolint:errcheck
+
+	if err = conn.SetDeadline(time.Now().Add(time.Second)); err != nil {
+		return nil, err
+	}
+
+	if _, err = conn.Write(raw); err != nil {
+		return nil, err
+	}
+
+	buf := make([]byte, 65535)
+
+	n, err := conn.Read(buf)
+	if err != nil {
+		return nil, err
+	}
+
+	var resp dnsmessage.Message
+
+	if err = resp.Unpack(buf[:n]); err != nil {
+		return nil, err
+	}
+
+	var result []netip.Addr
+
+	for _, answer := range resp.Answers {
+		if a, ok := answer.Body.(*dnsmessage.AResource); ok {
+			result = append(result, netip.AddrFrom4(a.A))
+		}
+	}
+
+	return result, nil
+}
+
+func (suite *DNSResolveCacheSuite) TestResolver() {
+	listenAddr := suite.freeAddrPort()
+
+	hostDNSConfig := network.NewHostDNSConfig(network.NamespaceName, network.HostDNSConfigID)
+	hostDNSConfig.TypedSpec().Enabled = true
+	hostDNSConfig.TypedSpec().ListenAddresses = []netip.AddrPort{listenAddr}
+	suite.Require().NoError(suite.State().Create(suite.Ctx(), hostDNSConfig))
+
+	resolverStatus := network.NewResolverStatus(network.NamespaceName, network.ResolverID)
+	resolverStatus.TypedSpec().DNSServers = []netip.Addr{
+		netip.MustParseAddr("192.0.2.1"),
+		listenAddr.Addr(),
+		netip.MustParseAddr("192.0.2.2"),
+	}
+	suite.Require().NoError(suite.State().Create(suite.Ctx(), resolverStatus))
+
+	hostnameStatus := network.NewHostnameStatus(network.NamespaceName, network.HostnameID)
+	hostnameStatus.TypedSpec().Hostname = "foo"
+	hostnameStatus.TypedSpec().Domainname = "example.com"
+	suite.Require().NoError(suite.State().Create(suite.Ctx(), hostnameStatus))
+
+	nodeAddress := network.NewNodeAddress(network.NamespaceName, network.NodeAddressDefaultID)
+	nodeAddress.TypedSpec().Addresses = []netip.Prefix{netip.MustParsePrefix("172.20.0.2/24")}
+	suite.Require().NoError(suite.State().Create(suite.Ctx(), nodeAddress))
+
+	suite.Require().NoError(suite.State().Create(suite.Ctx(), config.NewMachineConfig(&v1alpha1.Config{
+		ConfigVersion: "v1alpha1",
+		MachineConfig: &v1alpha1.MachineConfig{
+			MachineNetwork: &v1alpha1.NetworkConfig{
+				ExtraHostEntries: []*v1alpha1.ExtraHost{
+					{
+						HostIP:      "10.0.0.1",
+						HostAliases: []string{"bar", "bar.example.com"},
+					},
+				},
+			},
+		},
+	})))
+
+	rtestutils.AssertResources(suite.Ctx(), suite.T(), suite.State(), []string{network.DNSResolveCacheID},
+		func(r *network.DNSResolveCache, asrt *assert.Assertions) {
+			asrt.Equal([]netip.AddrPort{listenAddr}, r.TypedSpec().ListenAddresses)
+		},
+	)
+
+	// the listen address is never used as the upstream
+	rtestutils.AssertResources(suite.Ctx(), suite.T(), suite.State(), []string{"192.0.2.1", "192.0.2.2"},
+		func(r *network.DNSUpstream, asrt *assert.Assertions) {
+			asrt.True(r.TypedSpec().Healthy)
+		},
+	)
+	rtestutils.AssertNoResource[*network.DNSUpstream](suite.Ctx(), suite.T(), suite.State(), listenAddr.Addr().String())
+
+	for name, expected := range map[string][]netip.Addr{
+		"foo.example.com.": {netip.MustParseAddr("172.20.0.2")},
+		"FOO.":             {netip.MustParseAddr("172.20.0.2")},
+		"bar.example.com.": {netip.MustParseAddr("10.0.0.1")},
+		"localhost.":       {netip.MustParseAddr("127.0.0.1")},
+	} {
+		name, expected := name, expected
+
+		suite.AssertWithin(3*time.Second, 100*time.Millisecond, ctest.WrapRetry(func(asrt *assert.Assertions, _ *require.Assertions) {
+			addrs, err := lookup(listenAddr, name)
+			if !asrt.NoError(err) {
+				return
+			}
+
+			asrt.Equal(expected, addrs, "name %q", name)
+		}))
+	}
+
+	// disable the resolver
+	ctest.UpdateWithConflicts(suite, hostDNSConfig, func(r *network.HostDNSConfig) error {
+		r.TypedSpec().Enabled = false
+
+		return nil
+	})
+
+	rtestutils.AssertNoResource[*network.DNSResolveCache](suite.Ctx(), suite.T(), suite.State(), network.DNSResolveCacheID)
+	rtestutils.AssertNoResource[*network.DNSUpstream](suite.Ctx(), suite.T(), suite.State(), "192.0.2.1")
+	rtestutils.AssertNoResource[*network.DNSUpstream](suite.Ctx(), suite.T(), suite.State(), "192.0.2.2")
+
+	// the listen address is released
+	suite.AssertWithin(3*time.Second, 100*time.Millisecond, ctest.WrapRetry(func(asrt *assert.Assertions, _ *require.Assertions) {
+		conn, err := net.ListenUDP("udp", net.UDPAddrFromAddrPort(listenAddr))
+		if asrt.NoError(err) {
+			conn.Close() //nolint:errcheck
+		}
+	}))
+}
+
+func TestDNSResolveCacheSuite(t *testing.T) {
+	suite.Run(t, &DNSResolveCacheSuite{
+		DefaultSuite: ctest.DefaultSuite{
+			Timeout: 10 * time.Second,
+			AfterSetup: func(suite *ctest.DefaultSuite) {
+				suite.Require().NoError(suite.Runtime().RegisterController(&netctrl.DNSResolveCacheController{}))
+			},
+		},
+	})
+}
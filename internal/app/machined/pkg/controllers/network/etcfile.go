@@ -8,6 +8,8 @@ import (
 	"bytes"
 	"context"
 	"fmt"
+	"os"
+	"path/filepath"
 	"strings"
 	"text/tabwriter"
 
@@ -24,7 +26,12 @@ import (
 )
 
 // EtcFileController creates /etc/hostname and /etc/resolv.conf files based on finalized network configuration.
-type EtcFileController struct{}
+type EtcFileController struct {
+	// PodResolvConfPath is the path to the resolv.conf with the upstream nameservers used by the pods.
+	//
+	// If empty, the file is not written.
+	PodResolvConfPath string
+}
 
 // Name implements controller.Controller interface.
 func (ctrl *EtcFileController) Name() string {
@@ -58,6 +65,18 @@ func (ctrl *EtcFileController) Inputs() []controller.Input {
 			ID:        pointer.To(network.NodeAddressDefaultID),
 			Kind:      controller.InputWeak,
 		},
+		{
+			Namespace: network.NamespaceName,
+			Type:      network.HostDNSConfigType,
+			ID:        pointer.To(network.HostDNSConfigID),
+			Kind:      controller.InputWeak,
+		},
+		{
+			Namespace: network.NamespaceName,
+			Type:      network.DNSResolveCacheType,
+			ID:        pointer.To(network.DNSResolveCacheID),
+			Kind:      controller.InputWeak,
+		},
 	}
 }
 
@@ -126,16 +145,56 @@ func (ctrl *EtcFileController) Run(ctx context.Context, r controller.Runtime, lo
 			nodeAddressStatus = naStatus.(*network.NodeAddress).TypedSpec()
 		}
 
+		var hostDNSConfig *network.HostDNSConfigSpec
+
+		hdConfig, err := r.Get(ctx, resource.NewMetadata(network.NamespaceName, network.HostDNSConfigType, network.HostDNSConfigID, resource.VersionUndefined))
+		if err != nil {
+			if !state.IsNotFoundError(err) {
+				return fmt.Errorf("error getting host DNS config: %w", err)
+			}
+		} else {
+			hostDNSConfig = hdConfig.(*network.HostDNSConfig).TypedSpec()
+		}
+
+		var dnsResolveCache *network.DNSResolveCacheSpec
+
+		drCache, err := r.Get(ctx, resource.NewMetadata(network.NamespaceName, network.DNSResolveCacheType, network.DNSResolveCacheID, resource.VersionUndefined))
+		if err != nil {
+			if !state.IsNotFoundError(err) {
+				return fmt.Errorf("error getting DNS resolve cache: %w", err)
+			}
+		} else {
+			dnsResolveCache = drCache.(*network.DNSResolveCache).TypedSpec()
+		}
+
 		if resolverStatus != nil {
+			hostResolverStatus := resolverStatus
+
+			// host processes use the caching resolver running on the host, once it is started
+			if hostDNSConfig != nil && hostDNSConfig.Enabled && dnsResolveCache != nil && len(dnsResolveCache.ListenAddresses) > 0 {
+				hostResolverStatus = &network.ResolverStatusSpec{}
+
+				for _, addr := range dnsResolveCache.ListenAddresses {
+					hostResolverStatus.DNSServers = append(hostResolverStatus.DNSServers, addr.Addr())
+				}
+			}
+
 			if err = r.Modify(ctx, files.NewEtcFileSpec(files.NamespaceName, "resolv.conf"),
 				func(r resource.Resource) error {
-					r.(*files.EtcFileSpec).TypedSpec().Contents = ctrl.renderResolvConf(resolverStatus, hostnameStatus, cfgProvider)
+					r.(*files.EtcFileSpec).TypedSpec().Contents = ctrl.renderResolvConf(hostResolverStatus, hostnameStatus, cfgProvider)
 					r.(*files.EtcFileSpec).TypedSpec().Mode = 0o644
 
 					return nil
 				}); err != nil {
 				return fmt.Errorf("error modifying resolv.conf: %w", err)
 			}
+
+			// pods can't reach the host loopback addresses, so they always use the upstream nameservers
+			if ctrl.PodResolvConfPath != "" {
+				if err = writePodResolvConf(ctrl.PodResolvConfPath, ctrl.renderResolvConf(resolverStatus, hostnameStatus, cfgProvider)); err != nil {
+					return fmt.Errorf("error writing pod resolv.conf: %w", err)
+				}
+			}
 		}
 
 		if hostnameStatus != nil && nodeAddressStatus != nil {
@@ -178,6 +237,21 @@ func (ctrl *EtcFileController) renderResolvConf(resolverStatus *network.Resolver
 	return buf.Bytes()
 }
 
+// writePodResolvConf atomically replaces the pod resolv.conf.
+func writePodResolvConf(path string, contents []byte) error {
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return err
+	}
+
+	tmpPath := path + ".tmp"
+
+	if err := os.WriteFile(tmpPath, contents, 0o644); err != nil {
+		return err
+	}
+
+	return os.Rename(tmpPath, path)
+}
+
 func (ctrl *EtcFileController) renderHosts(hostnameStatus *network.HostnameStatusSpec, nodeAddressStatus *network.NodeAddressSpec, cfgProvider talosconfig.Provider) ([]byte, error) {
 	var buf bytes.Buffer
 
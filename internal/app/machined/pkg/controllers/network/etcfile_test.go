@@ -10,6 +10,8 @@ import (
 	"log"
 	"net/netip"
 	"net/url"
+	"os"
+	"path/filepath"
 	"sync"
 	"testing"
 	"time"
@@ -46,6 +48,8 @@ type EtcFileConfigSuite struct {
 	defaultAddress *network.NodeAddress
 	hostnameStatus *network.HostnameStatus
 	resolverStatus *network.ResolverStatus
+
+	podResolvConfPath string
 }
 
 func (suite *EtcFileConfigSuite) SetupTest() {
@@ -60,7 +64,11 @@ func (suite *EtcFileConfigSuite) SetupTest() {
 
 	suite.startRuntime()
 
-	suite.Require().NoError(suite.runtime.RegisterController(&netctrl.EtcFileController{}))
+	suite.podResolvConfPath = filepath.Join(suite.T().TempDir(), "resolved", "resolv.conf")
+
+	suite.Require().NoError(suite.runtime.RegisterController(&netctrl.EtcFileController{
+		PodResolvConfPath: suite.podResolvConfPath,
+	}))
 
 	u, err := url.Parse("https://foo:6443")
 	suite.Require().NoError(err)
@@ -216,6 +224,37 @@ func (suite *EtcFileConfigSuite) TestOnlyResolvers() {
 	)
 }
 
+func (suite *EtcFileConfigSuite) TestHostDNS() {
+	hostDNSConfig := network.NewHostDNSConfig(network.NamespaceName, network.HostDNSConfigID)
+	hostDNSConfig.TypedSpec().Enabled = true
+	hostDNSConfig.TypedSpec().ListenAddresses = []netip.AddrPort{netip.MustParseAddrPort("127.0.0.53:53")}
+
+	hosts := "127.0.0.1   localhost\n33.11.22.44 foo.example.com foo\n::1         localhost ip6-localhost ip6-loopback\nff02::1     ip6-allnodes\nff02::2     ip6-allrouters\n"
+
+	// the upstream nameservers are used until the caching resolver is started
+	suite.testFiles(
+		[]resource.Resource{hostDNSConfig, suite.defaultAddress, suite.hostnameStatus, suite.resolverStatus},
+		"nameserver 1.1.1.1\nnameserver 2.2.2.2\nnameserver 3.3.3.3\n\nsearch example.com\n",
+		hosts,
+	)
+
+	dnsResolveCache := network.NewDNSResolveCache(network.NamespaceName, network.DNSResolveCacheID)
+	dnsResolveCache.TypedSpec().ListenAddresses = hostDNSConfig.TypedSpec().ListenAddresses
+
+	suite.testFiles(
+		[]resource.Resource{dnsResolveCache},
+		"nameserver 127.0.0.53\n\nsearch example.com\n",
+		hosts,
+	)
+
+	// pods still use the upstream nameservers
+	suite.Assert().Eventually(func() bool {
+		contents, err := os.ReadFile(suite.podResolvConfPath)
+
+		return err == nil && string(contents) == "nameserver 1.1.1.1\nnameserver 2.2.2.2\nnameserver 3.3.3.3\n\nsearch example.com\n"
+	}, 5*time.Second, 100*time.Millisecond)
+}
+
 func (suite *EtcFileConfigSuite) TestOnlyHostname() {
 	suite.testFiles(
 		[]resource.Resource{suite.defaultAddress, suite.hostnameStatus},
@@ -0,0 +1,168 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package network
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/cosi-project/runtime/pkg/controller"
+	"github.com/cosi-project/runtime/pkg/resource"
+	"github.com/cosi-project/runtime/pkg/safe"
+	"github.com/cosi-project/runtime/pkg/state"
+	"github.com/siderolabs/go-pointer"
+	"go.uber.org/zap"
+
+	talosconfig "github.com/siderolabs/talos/pkg/machinery/config"
+	"github.com/siderolabs/talos/pkg/machinery/resources/config"
+	"github.com/siderolabs/talos/pkg/machinery/resources/network"
+)
+
+// EthernetConfigController manages network.EthernetSpec based on configuration.
+type EthernetConfigController struct{}
+
+// Name implements controller.Controller interface.
+func (ctrl *EthernetConfigController) Name() string {
+	return "network.EthernetConfigController"
+}
+
+// Inputs implements controller.Controller interface.
+func (ctrl *EthernetConfigController) Inputs() []controller.Input {
+	return []controller.Input{
+		{
+			Namespace: config.NamespaceName,
+			Type:      config.MachineConfigType,
+			ID:        pointer.To(config.V1Alpha1ID),
+			Kind:      controller.InputWeak,
+		},
+		{
+			Namespace: network.NamespaceName,
+			Type:      network.LinkStatusType,
+			Kind:      controller.InputWeak,
+		},
+	}
+}
+
+// Outputs implements controller.Controller interface.
+func (ctrl *EthernetConfigController) Outputs() []controller.Output {
+	return []controller.Output{
+		{
+			Type: network.EthernetSpecType,
+			Kind: controller.OutputExclusive,
+		},
+	}
+}
+
+// Run implements controller.Controller interface.
+func (ctrl *EthernetConfigController) Run(ctx context.Context, r controller.Runtime, logger *zap.Logger) error {
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-r.EventCh():
+		}
+
+		cfg, err := safe.ReaderGet[*config.MachineConfig](ctx, r, resource.NewMetadata(config.NamespaceName, config.MachineConfigType, config.V1Alpha1ID, resource.VersionUndefined))
+		if err != nil && !state.IsNotFoundError(err) {
+			return fmt.Errorf("error getting config: %w", err)
+		}
+
+		links, err := safe.ReaderList[*network.LinkStatus](ctx, r, resource.NewMetadata(network.NamespaceName, network.LinkStatusType, "", resource.VersionUndefined))
+		if err != nil {
+			return fmt.Errorf("error listing link statuses: %w", err)
+		}
+
+		specs := map[string]network.EthernetSpecSpec{}
+
+		if cfg != nil {
+			for _, ethernet := range cfg.Config().Machine().Network().Ethernet() {
+				var linkNames []string
+
+				if ethernet.Selector() != nil {
+					for _, link := range SelectLinks(ethernet.Selector(), links) {
+						linkNames = append(linkNames, link.Metadata().ID())
+					}
+
+					if len(linkNames) == 0 {
+						logger.Debug("no links matched the ethernet config selector", zap.Any("selector", ethernet.Selector()))
+					}
+				} else {
+					linkNames = append(linkNames, ethernet.Interface())
+				}
+
+				for _, linkName := range linkNames {
+					specs[linkName] = mergeEthernetSpec(specs[linkName], ethernet)
+				}
+			}
+		}
+
+		for linkName, spec := range specs {
+			spec := spec
+
+			if err = safe.WriterModify(ctx, r, network.NewEthernetSpec(network.NamespaceName, linkName), func(res *network.EthernetSpec) error {
+				*res.TypedSpec() = spec
+
+				return nil
+			}); err != nil {
+				return fmt.Errorf("error updating ethernet spec: %w", err)
+			}
+		}
+
+		list, err := safe.ReaderList[*network.EthernetSpec](ctx, r, resource.NewMetadata(network.NamespaceName, network.EthernetSpecType, "", resource.VersionUndefined))
+		if err != nil {
+			return fmt.Errorf("error listing ethernet specs: %w", err)
+		}
+
+		for iter := safe.IteratorFromList(list); iter.Next(); {
+			if _, ok := specs[iter.Value().Metadata().ID()]; ok {
+				continue
+			}
+
+			if err = r.Destroy(ctx, iter.Value().Metadata()); err != nil {
+				return fmt.Errorf("error cleaning up ethernet specs: %w", err)
+			}
+		}
+
+		r.ResetRestartBackoff()
+	}
+}
+
+// mergeEthernetSpec merges the settings from the config into the spec.
+//
+// The settings which are set in the config override the settings in the spec,
+// so that a config with the interface name can override a config with a selector.
+func mergeEthernetSpec(spec network.EthernetSpecSpec, ethernet talosconfig.EthernetConfig) network.EthernetSpecSpec {
+	spec = spec.DeepCopy()
+
+	mergeValue(&spec.Rings.RX, ethernet.Rings().RX())
+	mergeValue(&spec.Rings.RXMini, ethernet.Rings().RXMini())
+	mergeValue(&spec.Rings.RXJumbo, ethernet.Rings().RXJumbo())
+	mergeValue(&spec.Rings.TX, ethernet.Rings().TX())
+
+	mergeValue(&spec.Channels.RX, ethernet.Channels().RX())
+	mergeValue(&spec.Channels.TX, ethernet.Channels().TX())
+	mergeValue(&spec.Channels.Other, ethernet.Channels().Other())
+	mergeValue(&spec.Channels.Combined, ethernet.Channels().Combined())
+
+	mergeValue(&spec.Pause.Autonegotiate, ethernet.Pause().Autonegotiate())
+	mergeValue(&spec.Pause.RX, ethernet.Pause().RX())
+	mergeValue(&spec.Pause.TX, ethernet.Pause().TX())
+
+	for name, value := range ethernet.Features() {
+		if spec.Features == nil {
+			spec.Features = map[string]bool{}
+		}
+
+		spec.Features[name] = value
+	}
+
+	return spec
+}
+
+func mergeValue[T any](dst **T, src *T) {
+	if src != nil {
+		*dst = pointer.To(*src)
+	}
+}
@@ -0,0 +1,127 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package network_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/cosi-project/runtime/pkg/resource/rtestutils"
+	"github.com/siderolabs/go-pointer"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/siderolabs/talos/internal/app/machined/pkg/controllers/ctest"
+	netctrl "github.com/siderolabs/talos/internal/app/machined/pkg/controllers/network"
+	"github.com/siderolabs/talos/pkg/machinery/config/types/v1alpha1"
+	"github.com/siderolabs/talos/pkg/machinery/resources/config"
+	"github.com/siderolabs/talos/pkg/machinery/resources/network"
+)
+
+type EthernetConfigSuite struct {
+	ctest.DefaultSuite
+}
+
+func (suite *EthernetConfigSuite) TestConfig() {
+	cfg := config.NewMachineConfig(&v1alpha1.Config{
+		ConfigVersion: "v1alpha1",
+		MachineConfig: &v1alpha1.MachineConfig{
+			MachineNetwork: &v1alpha1.NetworkConfig{
+				NetworkEthernet: []*v1alpha1.EthernetConfig{
+					{
+						EthernetDeviceSelector: &v1alpha1.NetworkDeviceSelector{
+							NetworkDeviceKernelDriver: "r8125",
+						},
+						EthernetRings: &v1alpha1.EthernetRingsConfig{
+							RingsRX: pointer.To[uint32](1024),
+							RingsTX: pointer.To[uint32](1024),
+						},
+						EthernetFeatures: map[string]bool{
+							"tx-tcp-segmentation": false,
+						},
+					},
+					{
+						EthernetInterface: "eth1",
+						EthernetRings: &v1alpha1.EthernetRingsConfig{
+							RingsRX: pointer.To[uint32](512),
+						},
+						EthernetPause: &v1alpha1.EthernetPauseConfig{
+							PauseAutonegotiate: pointer.To(false),
+						},
+					},
+				},
+			},
+		},
+	})
+
+	suite.Require().NoError(suite.State().Create(suite.Ctx(), cfg))
+
+	for _, link := range []string{"eth0", "eth1", "eth2"} {
+		status := network.NewLinkStatus(network.NamespaceName, link)
+
+		if link != "eth2" {
+			status.TypedSpec().Driver = "r8125"
+		}
+
+		suite.Require().NoError(suite.State().Create(suite.Ctx(), status))
+	}
+
+	rtestutils.AssertResources(suite.Ctx(), suite.T(), suite.State(), []string{"eth0"},
+		func(r *network.EthernetSpec, asrt *assert.Assertions) {
+			asrt.Equal(network.EthernetSpecSpec{
+				Rings: network.EthernetRingsSpec{
+					RX: pointer.To[uint32](1024),
+					TX: pointer.To[uint32](1024),
+				},
+				Features: map[string]bool{
+					"tx-tcp-segmentation": false,
+				},
+			}, *r.TypedSpec())
+		},
+	)
+
+	// the config for the interface name overrides the config for the selector
+	rtestutils.AssertResources(suite.Ctx(), suite.T(), suite.State(), []string{"eth1"},
+		func(r *network.EthernetSpec, asrt *assert.Assertions) {
+			asrt.Equal(network.EthernetSpecSpec{
+				Rings: network.EthernetRingsSpec{
+					RX: pointer.To[uint32](512),
+					TX: pointer.To[uint32](1024),
+				},
+				Features: map[string]bool{
+					"tx-tcp-segmentation": false,
+				},
+				Pause: network.EthernetPauseSpec{
+					Autonegotiate: pointer.To(false),
+				},
+			}, *r.TypedSpec())
+		},
+	)
+
+	rtestutils.AssertNoResource[*network.EthernetSpec](suite.Ctx(), suite.T(), suite.State(), "eth2")
+
+	cfg.Config().(*v1alpha1.Config).MachineConfig.MachineNetwork.NetworkEthernet = cfg.Config().(*v1alpha1.Config).MachineConfig.MachineNetwork.NetworkEthernet[1:]
+	suite.Require().NoError(suite.State().Update(suite.Ctx(), cfg))
+
+	rtestutils.AssertNoResource[*network.EthernetSpec](suite.Ctx(), suite.T(), suite.State(), "eth0")
+	rtestutils.AssertResources(suite.Ctx(), suite.T(), suite.State(), []string{"eth1"},
+		func(r *network.EthernetSpec, asrt *assert.Assertions) {
+			asrt.Equal(pointer.To[uint32](512), r.TypedSpec().Rings.RX)
+			asrt.Nil(r.TypedSpec().Rings.TX)
+			asrt.Empty(r.TypedSpec().Features)
+		},
+	)
+}
+
+func TestEthernetConfigSuite(t *testing.T) {
+	suite.Run(t, &EthernetConfigSuite{
+		DefaultSuite: ctest.DefaultSuite{
+			Timeout: 5 * time.Second,
+			AfterSetup: func(suite *ctest.DefaultSuite) {
+				suite.Require().NoError(suite.Runtime().RegisterController(&netctrl.EthernetConfigController{}))
+			},
+		},
+	})
+}
@@ -0,0 +1,184 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package network
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/cosi-project/runtime/pkg/controller"
+	"github.com/cosi-project/runtime/pkg/resource"
+	"github.com/cosi-project/runtime/pkg/safe"
+	"go.uber.org/zap"
+	"golang.org/x/sys/unix"
+
+	"github.com/siderolabs/talos/internal/app/machined/pkg/controllers/network/internal/ethtool"
+	"github.com/siderolabs/talos/pkg/machinery/resources/network"
+)
+
+// EthernetSpecController applies network.EthernetSpec to the links via the ethtool netlink interface.
+type EthernetSpecController struct{}
+
+// Name implements controller.Controller interface.
+func (ctrl *EthernetSpecController) Name() string {
+	return "network.EthernetSpecController"
+}
+
+// Inputs implements controller.Controller interface.
+func (ctrl *EthernetSpecController) Inputs() []controller.Input {
+	return []controller.Input{
+		{
+			Namespace: network.NamespaceName,
+			Type:      network.EthernetSpecType,
+			Kind:      controller.InputWeak,
+		},
+		{
+			// the settings are applied again when the link is (re)created
+			Namespace: network.NamespaceName,
+			Type:      network.LinkStatusType,
+			Kind:      controller.InputWeak,
+		},
+	}
+}
+
+// Outputs implements controller.Controller interface.
+func (ctrl *EthernetSpecController) Outputs() []controller.Output {
+	return nil
+}
+
+// Run implements controller.Controller interface.
+func (ctrl *EthernetSpecController) Run(ctx context.Context, r controller.Runtime, logger *zap.Logger) error {
+	ethClient, err := ethtool.New()
+	if err != nil {
+		logger.Warn("error dialing ethtool socket, ethernet settings are not applied", zap.Error(err))
+
+		return nil
+	}
+
+	defer ethClient.Close() //nolint:errcheck
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-r.EventCh():
+		}
+
+		list, err := safe.ReaderList[*network.EthernetSpec](ctx, r, resource.NewMetadata(network.NamespaceName, network.EthernetSpecType, "", resource.VersionUndefined))
+		if err != nil {
+			return fmt.Errorf("error listing ethernet specs: %w", err)
+		}
+
+		for iter := safe.IteratorFromList(list); iter.Next(); {
+			linkName := iter.Value().Metadata().ID()
+
+			if err = ctrl.apply(ethClient, linkName, iter.Value().TypedSpec()); err != nil {
+				if errors.Is(err, unix.ENODEV) {
+					// the link doesn't exist (yet)
+					continue
+				}
+
+				logger.Warn("error applying ethernet settings", zap.String("link", linkName), zap.Error(err))
+			}
+		}
+
+		r.ResetRestartBackoff()
+	}
+}
+
+// apply changes the settings which are different from the current ones.
+//
+//nolint:gocyclo
+func (ctrl *EthernetSpecController) apply(ethClient *ethtool.Client, linkName string, spec *network.EthernetSpecSpec) error {
+	if spec.Rings != (network.EthernetRingsSpec{}) {
+		current, err := ethClient.Rings(linkName)
+		if err != nil {
+			return fmt.Errorf("error getting rings: %w", err)
+		}
+
+		if changed(current.RX, spec.Rings.RX) || changed(current.RXMini, spec.Rings.RXMini) ||
+			changed(current.RXJumbo, spec.Rings.RXJumbo) || changed(current.TX, spec.Rings.TX) {
+			if err = ethClient.SetRings(linkName, ethtool.Rings{
+				RX:      spec.Rings.RX,
+				RXMini:  spec.Rings.RXMini,
+				RXJumbo: spec.Rings.RXJumbo,
+				TX:      spec.Rings.TX,
+			}); err != nil {
+				return fmt.Errorf("error setting rings: %w", err)
+			}
+		}
+	}
+
+	if spec.Channels != (network.EthernetChannelsSpec{}) {
+		current, err := ethClient.Channels(linkName)
+		if err != nil {
+			return fmt.Errorf("error getting channels: %w", err)
+		}
+
+		if changed(current.RX, spec.Channels.RX) || changed(current.TX, spec.Channels.TX) ||
+			changed(current.Other, spec.Channels.Other) || changed(current.Combined, spec.Channels.Combined) {
+			if err = ethClient.SetChannels(linkName, ethtool.Channels{
+				RX:       spec.Channels.RX,
+				TX:       spec.Channels.TX,
+				Other:    spec.Channels.Other,
+				Combined: spec.Channels.Combined,
+			}); err != nil {
+				return fmt.Errorf("error setting channels: %w", err)
+			}
+		}
+	}
+
+	if len(spec.Features) > 0 {
+		current, err := ethClient.Features(linkName)
+		if err != nil {
+			return fmt.Errorf("error getting features: %w", err)
+		}
+
+		active := make(map[string]bool, len(current))
+
+		for _, feature := range current {
+			active[feature.Name] = feature.Active
+		}
+
+		for name, value := range spec.Features {
+			if active[name] != value {
+				if err = ethClient.SetFeatures(linkName, spec.Features); err != nil {
+					return fmt.Errorf("error setting features: %w", err)
+				}
+
+				break
+			}
+		}
+	}
+
+	if spec.Pause != (network.EthernetPauseSpec{}) {
+		current, err := ethClient.Pause(linkName)
+		if err != nil {
+			return fmt.Errorf("error getting pause settings: %w", err)
+		}
+
+		if changed(current.Autonegotiate, spec.Pause.Autonegotiate) || changed(current.RX, spec.Pause.RX) || changed(current.TX, spec.Pause.TX) {
+			if err = ethClient.SetPause(linkName, ethtool.Pause{
+				Autonegotiate: spec.Pause.Autonegotiate,
+				RX:            spec.Pause.RX,
+				TX:            spec.Pause.TX,
+			}); err != nil {
+				return fmt.Errorf("error setting pause settings: %w", err)
+			}
+		}
+	}
+
+	return nil
+}
+
+// changed returns true if the desired value is set and it's different from the current one.
+func changed[T comparable](current, desired *T) bool {
+	if desired == nil {
+		return false
+	}
+
+	return current == nil || *current != *desired
+}
@@ -0,0 +1,106 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package network_test
+
+import (
+	"fmt"
+	"math/rand"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/jsimonetti/rtnetlink"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+	"golang.org/x/sys/unix"
+
+	"github.com/siderolabs/talos/internal/app/machined/pkg/controllers/ctest"
+	netctrl "github.com/siderolabs/talos/internal/app/machined/pkg/controllers/network"
+	"github.com/siderolabs/talos/internal/app/machined/pkg/controllers/network/internal/ethtool"
+	"github.com/siderolabs/talos/pkg/machinery/resources/network"
+)
+
+type EthernetSpecSuite struct {
+	ctest.DefaultSuite
+}
+
+// createDummyInterface creates a dummy link which is removed when the test finishes.
+func createDummyInterface(t *testing.T) string {
+	dummyInterface := fmt.Sprintf("dummy%02x%02x%02x", rand.Int31()&0xff, rand.Int31()&0xff, rand.Int31()&0xff)
+
+	conn, err := rtnetlink.Dial(nil)
+	require.NoError(t, err)
+
+	defer conn.Close() //nolint:errcheck
+
+	require.NoError(t,
+		conn.Link.New(
+			&rtnetlink.LinkMessage{
+				Type: unix.ARPHRD_ETHER,
+				Attributes: &rtnetlink.LinkAttributes{
+					Name: dummyInterface,
+					Info: &rtnetlink.LinkInfo{
+						Kind: "dummy",
+					},
+				},
+			},
+		),
+	)
+
+	iface, err := net.InterfaceByName(dummyInterface)
+	require.NoError(t, err)
+
+	t.Cleanup(func() {
+		conn, err := rtnetlink.Dial(nil)
+		if err != nil {
+			return
+		}
+
+		defer conn.Close() //nolint:errcheck
+
+		conn.Link.Delete(uint32(iface.Index)) //nolint:errcheck
+	})
+
+	return dummyInterface
+}
+
+func (suite *EthernetSpecSuite) TestFeatures() {
+	dummyInterface := createDummyInterface(suite.T())
+
+	spec := network.NewEthernetSpec(network.NamespaceName, dummyInterface)
+	spec.TypedSpec().Features = map[string]bool{
+		"tx-checksum-ip-generic": false,
+	}
+
+	suite.Require().NoError(suite.State().Create(suite.Ctx(), spec))
+
+	suite.AssertWithin(3*time.Second, 100*time.Millisecond, ctest.WrapRetry(func(assert *assert.Assertions, _ *require.Assertions) {
+		ethClient, err := ethtool.New()
+		if !assert.NoError(err) {
+			return
+		}
+
+		defer ethClient.Close() //nolint:errcheck
+
+		features, err := ethClient.Features(dummyInterface)
+		if !assert.NoError(err) {
+			return
+		}
+
+		assert.Contains(features, ethtool.Feature{Name: "tx-checksum-ip-generic", Active: false})
+	}))
+}
+
+func TestEthernetSpecSuite(t *testing.T) {
+	suite.Run(t, &EthernetSpecSuite{
+		DefaultSuite: ctest.DefaultSuite{
+			Timeout: 5 * time.Second,
+			AfterSetup: func(suite *ctest.DefaultSuite) {
+				suite.Require().NoError(suite.Runtime().RegisterController(&netctrl.EthernetSpecController{}))
+			},
+		},
+	})
+}
@@ -0,0 +1,221 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package network
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/cosi-project/runtime/pkg/controller"
+	"github.com/cosi-project/runtime/pkg/resource"
+	"github.com/cosi-project/runtime/pkg/safe"
+	"go.uber.org/zap"
+	"golang.org/x/sys/unix"
+
+	"github.com/siderolabs/talos/internal/app/machined/pkg/controllers/network/internal/ethtool"
+	"github.com/siderolabs/talos/internal/app/machined/pkg/controllers/network/watch"
+	"github.com/siderolabs/talos/pkg/machinery/resources/network"
+)
+
+// EthernetStatusController reports the ethtool settings of the physical links as network.EthernetStatus.
+type EthernetStatusController struct{}
+
+// Name implements controller.Controller interface.
+func (ctrl *EthernetStatusController) Name() string {
+	return "network.EthernetStatusController"
+}
+
+// Inputs implements controller.Controller interface.
+func (ctrl *EthernetStatusController) Inputs() []controller.Input {
+	return []controller.Input{
+		{
+			Namespace: network.NamespaceName,
+			Type:      network.LinkStatusType,
+			Kind:      controller.InputWeak,
+		},
+	}
+}
+
+// Outputs implements controller.Controller interface.
+func (ctrl *EthernetStatusController) Outputs() []controller.Output {
+	return []controller.Output{
+		{
+			Type: network.EthernetStatusType,
+			Kind: controller.OutputExclusive,
+		},
+	}
+}
+
+// Run implements controller.Controller interface.
+//
+//nolint:gocyclo
+func (ctrl *EthernetStatusController) Run(ctx context.Context, r controller.Runtime, logger *zap.Logger) error {
+	// the settings changed by the ethtool CLI are delivered as ethtool notifications
+	ethtoolWatcher, err := watch.NewEthtool(r)
+	if err != nil {
+		logger.Warn("ethtool watcher failed to start", zap.Error(err))
+	} else {
+		defer ethtoolWatcher.Done()
+	}
+
+	ethClient, err := ethtool.New()
+	if err != nil {
+		logger.Warn("error dialing ethtool socket, ethernet status is not available", zap.Error(err))
+
+		return nil
+	}
+
+	defer ethClient.Close() //nolint:errcheck
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-r.EventCh():
+		}
+
+		links, err := safe.ReaderList[*network.LinkStatus](ctx, r, resource.NewMetadata(network.NamespaceName, network.LinkStatusType, "", resource.VersionUndefined))
+		if err != nil {
+			return fmt.Errorf("error listing link statuses: %w", err)
+		}
+
+		touchedIDs := map[resource.ID]struct{}{}
+
+		for iter := safe.IteratorFromList(links); iter.Next(); {
+			link := iter.Value()
+
+			if !link.TypedSpec().Physical() {
+				continue
+			}
+
+			status, err := ctrl.query(ethClient, link.Metadata().ID())
+			if err != nil {
+				if errors.Is(err, unix.ENODEV) {
+					// the link is gone, the status will be cleaned up
+					continue
+				}
+
+				logger.Warn("error querying ethernet status", zap.String("link", link.Metadata().ID()), zap.Error(err))
+
+				continue
+			}
+
+			if err = safe.WriterModify(ctx, r, network.NewEthernetStatus(network.NamespaceName, link.Metadata().ID()), func(res *network.EthernetStatus) error {
+				*res.TypedSpec() = status
+
+				return nil
+			}); err != nil {
+				return fmt.Errorf("error updating ethernet status: %w", err)
+			}
+
+			touchedIDs[link.Metadata().ID()] = struct{}{}
+		}
+
+		list, err := safe.ReaderList[*network.EthernetStatus](ctx, r, resource.NewMetadata(network.NamespaceName, network.EthernetStatusType, "", resource.VersionUndefined))
+		if err != nil {
+			return fmt.Errorf("error listing ethernet statuses: %w", err)
+		}
+
+		for iter := safe.IteratorFromList(list); iter.Next(); {
+			if _, ok := touchedIDs[iter.Value().Metadata().ID()]; ok {
+				continue
+			}
+
+			if err = r.Destroy(ctx, iter.Value().Metadata()); err != nil {
+				return fmt.Errorf("error cleaning up ethernet statuses: %w", err)
+			}
+		}
+
+		r.ResetRestartBackoff()
+	}
+}
+
+// query builds the status of the link, the settings not supported by the driver are left empty.
+func (ctrl *EthernetStatusController) query(ethClient *ethtool.Client, linkName string) (network.EthernetStatusSpec, error) {
+	var status network.EthernetStatusSpec
+
+	rings, err := ethClient.Rings(linkName)
+
+	switch {
+	case err == nil:
+		status.Rings = &network.EthernetRingsStatus{
+			RXMax:      rings.RXMax,
+			RXMiniMax:  rings.RXMiniMax,
+			RXJumboMax: rings.RXJumboMax,
+			TXMax:      rings.TXMax,
+			RX:         rings.RX,
+			RXMini:     rings.RXMini,
+			RXJumbo:    rings.RXJumbo,
+			TX:         rings.TX,
+		}
+	case !ethtool.IsNotSupported(err):
+		return status, fmt.Errorf("error getting rings: %w", err)
+	}
+
+	channels, err := ethClient.Channels(linkName)
+
+	switch {
+	case err == nil:
+		status.Channels = &network.EthernetChannelsStatus{
+			RXMax:       channels.RXMax,
+			TXMax:       channels.TXMax,
+			OtherMax:    channels.OtherMax,
+			CombinedMax: channels.CombinedMax,
+			RX:          channels.RX,
+			TX:          channels.TX,
+			Other:       channels.Other,
+			Combined:    channels.Combined,
+		}
+	case !ethtool.IsNotSupported(err):
+		return status, fmt.Errorf("error getting channels: %w", err)
+	}
+
+	features, err := ethClient.Features(linkName)
+
+	switch {
+	case err == nil:
+		status.Features = make([]network.EthernetFeatureStatus, 0, len(features))
+
+		for _, feature := range features {
+			status.Features = append(status.Features, network.EthernetFeatureStatus{
+				Name:   feature.Name,
+				Status: featureStatus(feature),
+			})
+		}
+	case !ethtool.IsNotSupported(err):
+		return status, fmt.Errorf("error getting features: %w", err)
+	}
+
+	pause, err := ethClient.Pause(linkName)
+
+	switch {
+	case err == nil:
+		status.Pause = &network.EthernetPauseStatus{
+			Autonegotiate: pause.Autonegotiate != nil && *pause.Autonegotiate,
+			RX:            pause.RX != nil && *pause.RX,
+			TX:            pause.TX != nil && *pause.TX,
+		}
+	case !ethtool.IsNotSupported(err):
+		return status, fmt.Errorf("error getting pause settings: %w", err)
+	}
+
+	return status, nil
+}
+
+// featureStatus formats the feature state the same way as the ethtool CLI.
+func featureStatus(feature ethtool.Feature) string {
+	status := "off"
+
+	if feature.Active {
+		status = "on"
+	}
+
+	if feature.Fixed {
+		status += " [fixed]"
+	}
+
+	return status
+}
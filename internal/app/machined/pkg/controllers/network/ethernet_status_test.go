@@ -0,0 +1,57 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package network_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/cosi-project/runtime/pkg/resource/rtestutils"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/siderolabs/talos/internal/app/machined/pkg/controllers/ctest"
+	netctrl "github.com/siderolabs/talos/internal/app/machined/pkg/controllers/network"
+	"github.com/siderolabs/talos/pkg/machinery/nethelpers"
+	"github.com/siderolabs/talos/pkg/machinery/resources/network"
+)
+
+type EthernetStatusSuite struct {
+	ctest.DefaultSuite
+}
+
+func (suite *EthernetStatusSuite) TestStatus() {
+	dummyInterface := createDummyInterface(suite.T())
+
+	// pretend that the dummy interface is a physical link
+	status := network.NewLinkStatus(network.NamespaceName, dummyInterface)
+	status.TypedSpec().Type = nethelpers.LinkEther
+	suite.Require().NoError(suite.State().Create(suite.Ctx(), status))
+
+	rtestutils.AssertResources(suite.Ctx(), suite.T(), suite.State(), []string{dummyInterface},
+		func(r *network.EthernetStatus, asrt *assert.Assertions) {
+			// dummy driver doesn't support rings, channels and pause settings
+			asrt.Nil(r.TypedSpec().Rings)
+			asrt.Nil(r.TypedSpec().Channels)
+			asrt.Nil(r.TypedSpec().Pause)
+			asrt.Contains(r.TypedSpec().Features, network.EthernetFeatureStatus{Name: "tx-checksum-ip-generic", Status: "on"})
+		},
+	)
+
+	suite.Require().NoError(suite.State().Destroy(suite.Ctx(), status.Metadata()))
+
+	rtestutils.AssertNoResource[*network.EthernetStatus](suite.Ctx(), suite.T(), suite.State(), dummyInterface)
+}
+
+func TestEthernetStatusSuite(t *testing.T) {
+	suite.Run(t, &EthernetStatusSuite{
+		DefaultSuite: ctest.DefaultSuite{
+			Timeout: 5 * time.Second,
+			AfterSetup: func(suite *ctest.DefaultSuite) {
+				suite.Require().NoError(suite.Runtime().RegisterController(&netctrl.EthernetStatusController{}))
+			},
+		},
+	})
+}
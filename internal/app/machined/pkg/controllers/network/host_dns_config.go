@@ -0,0 +1,92 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package network
+
+import (
+	"context"
+	"fmt"
+	"net/netip"
+
+	"github.com/cosi-project/runtime/pkg/controller"
+	"github.com/cosi-project/runtime/pkg/resource"
+	"github.com/cosi-project/runtime/pkg/state"
+	"github.com/siderolabs/go-pointer"
+	"go.uber.org/zap"
+
+	"github.com/siderolabs/talos/pkg/machinery/constants"
+	"github.com/siderolabs/talos/pkg/machinery/resources/config"
+	"github.com/siderolabs/talos/pkg/machinery/resources/network"
+)
+
+// HostDNSConfigController manages network.HostDNSConfig based on machine configuration.
+type HostDNSConfigController struct{}
+
+// Name implements controller.Controller interface.
+func (ctrl *HostDNSConfigController) Name() string {
+	return "network.HostDNSConfigController"
+}
+
+// Inputs implements controller.Controller interface.
+func (ctrl *HostDNSConfigController) Inputs() []controller.Input {
+	return []controller.Input{
+		{
+			Namespace: config.NamespaceName,
+			Type:      config.MachineConfigType,
+			ID:        pointer.To(config.V1Alpha1ID),
+			Kind:      controller.InputWeak,
+		},
+	}
+}
+
+// Outputs implements controller.Controller interface.
+func (ctrl *HostDNSConfigController) Outputs() []controller.Output {
+	return []controller.Output{
+		{
+			Type: network.HostDNSConfigType,
+			Kind: controller.OutputExclusive,
+		},
+	}
+}
+
+// Run implements controller.Controller interface.
+func (ctrl *HostDNSConfigController) Run(ctx context.Context, r controller.Runtime, logger *zap.Logger) error {
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-r.EventCh():
+		}
+
+		cfg, err := r.Get(ctx, resource.NewMetadata(config.NamespaceName, config.MachineConfigType, config.V1Alpha1ID, resource.VersionUndefined))
+		if err != nil {
+			if !state.IsNotFoundError(err) {
+				return fmt.Errorf("error getting config: %w", err)
+			}
+		}
+
+		if cfg != nil {
+			c := cfg.(*config.MachineConfig).Config()
+
+			if err = r.Modify(ctx, network.NewHostDNSConfig(network.NamespaceName, network.HostDNSConfigID), func(res resource.Resource) error {
+				spec := res.(*network.HostDNSConfig).TypedSpec()
+
+				spec.Enabled = c.Machine().Features().HostDNS().Enabled()
+				spec.ListenAddresses = []netip.AddrPort{
+					netip.AddrPortFrom(netip.MustParseAddr(constants.HostDNSAddress), 53),
+				}
+
+				return nil
+			}); err != nil {
+				return fmt.Errorf("error modifying host DNS config: %w", err)
+			}
+		} else {
+			if err = r.Destroy(ctx, resource.NewMetadata(network.NamespaceName, network.HostDNSConfigType, network.HostDNSConfigID, resource.VersionUndefined)); err != nil && !state.IsNotFoundError(err) {
+				return fmt.Errorf("error destroying host DNS config: %w", err)
+			}
+		}
+
+		r.ResetRestartBackoff()
+	}
+}
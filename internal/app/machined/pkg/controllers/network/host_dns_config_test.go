@@ -0,0 +1,73 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package network_test
+
+import (
+	"net/netip"
+	"testing"
+	"time"
+
+	"github.com/cosi-project/runtime/pkg/resource/rtestutils"
+	"github.com/siderolabs/go-pointer"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/siderolabs/talos/internal/app/machined/pkg/controllers/ctest"
+	netctrl "github.com/siderolabs/talos/internal/app/machined/pkg/controllers/network"
+	"github.com/siderolabs/talos/pkg/machinery/config/types/v1alpha1"
+	"github.com/siderolabs/talos/pkg/machinery/resources/config"
+	"github.com/siderolabs/talos/pkg/machinery/resources/network"
+)
+
+type HostDNSConfigSuite struct {
+	ctest.DefaultSuite
+}
+
+func (suite *HostDNSConfigSuite) TestConfig() {
+	cfg := config.NewMachineConfig(&v1alpha1.Config{
+		ConfigVersion: "v1alpha1",
+		MachineConfig: &v1alpha1.MachineConfig{},
+	})
+
+	suite.Require().NoError(suite.State().Create(suite.Ctx(), cfg))
+
+	rtestutils.AssertResources(suite.Ctx(), suite.T(), suite.State(), []string{network.HostDNSConfigID},
+		func(r *network.HostDNSConfig, asrt *assert.Assertions) {
+			asrt.False(r.TypedSpec().Enabled)
+			asrt.Equal([]netip.AddrPort{netip.MustParseAddrPort("127.0.0.53:53")}, r.TypedSpec().ListenAddresses)
+		},
+	)
+
+	ctest.UpdateWithConflicts(suite, cfg, func(cfg *config.MachineConfig) error {
+		cfg.Config().Raw().(*v1alpha1.Config).MachineConfig.MachineFeatures = &v1alpha1.FeaturesConfig{
+			HostDNSConfig: &v1alpha1.HostDNSConfig{
+				HostDNSEnabled: pointer.To(true),
+			},
+		}
+
+		return nil
+	})
+
+	rtestutils.AssertResources(suite.Ctx(), suite.T(), suite.State(), []string{network.HostDNSConfigID},
+		func(r *network.HostDNSConfig, asrt *assert.Assertions) {
+			asrt.True(r.TypedSpec().Enabled)
+		},
+	)
+
+	suite.Require().NoError(suite.State().Destroy(suite.Ctx(), cfg.Metadata()))
+
+	rtestutils.AssertNoResource[*network.HostDNSConfig](suite.Ctx(), suite.T(), suite.State(), network.HostDNSConfigID)
+}
+
+func TestHostDNSConfigSuite(t *testing.T) {
+	suite.Run(t, &HostDNSConfigSuite{
+		DefaultSuite: ctest.DefaultSuite{
+			Timeout: 10 * time.Second,
+			AfterSetup: func(suite *ctest.DefaultSuite) {
+				suite.Require().NoError(suite.Runtime().RegisterController(&netctrl.HostDNSConfigController{}))
+			},
+		},
+	})
+}
@@ -0,0 +1,439 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+// Package ethtool implements the ethtool netlink requests to query and change
+// the ring sizes, channels, features and pause frames of the links.
+//
+// The link modes and link state are queried with github.com/mdlayher/ethtool.
+package ethtool
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+
+	"github.com/mdlayher/genetlink"
+	"github.com/mdlayher/netlink"
+	"github.com/siderolabs/go-pointer"
+	"golang.org/x/sys/unix"
+)
+
+// Client is an ethtool netlink client.
+type Client struct {
+	conn   *genetlink.Conn
+	family genetlink.Family
+}
+
+// New dials the ethtool netlink family.
+func New() (*Client, error) {
+	conn, err := genetlink.Dial(nil)
+	if err != nil {
+		return nil, fmt.Errorf("error dialing genetlink: %w", err)
+	}
+
+	family, err := conn.GetFamily(unix.ETHTOOL_GENL_NAME)
+	if err != nil {
+		conn.Close() //nolint:errcheck
+
+		return nil, fmt.Errorf("error getting family information for ethtool: %w", err)
+	}
+
+	return &Client{
+		conn:   conn,
+		family: family,
+	}, nil
+}
+
+// Close the client.
+func (c *Client) Close() error {
+	return c.conn.Close()
+}
+
+// IsNotSupported returns true if the request is not supported by the driver.
+func IsNotSupported(err error) bool {
+	return errors.Is(err, unix.EOPNOTSUPP)
+}
+
+// Rings describes the ring buffer sizes.
+//
+// The values which are not supported by the driver are nil.
+type Rings struct {
+	RXMax      *uint32
+	RXMiniMax  *uint32
+	RXJumboMax *uint32
+	TXMax      *uint32
+
+	RX      *uint32
+	RXMini  *uint32
+	RXJumbo *uint32
+	TX      *uint32
+}
+
+// Channels describes the number of channels.
+//
+// The values which are not supported by the driver are nil.
+type Channels struct {
+	RXMax       *uint32
+	TXMax       *uint32
+	OtherMax    *uint32
+	CombinedMax *uint32
+
+	RX       *uint32
+	TX       *uint32
+	Other    *uint32
+	Combined *uint32
+}
+
+// Pause describes the pause frames settings.
+type Pause struct {
+	Autonegotiate *bool
+	RX            *bool
+	TX            *bool
+}
+
+// Feature describes a feature (offload) of the link.
+type Feature struct {
+	Name   string
+	Active bool
+	Fixed  bool
+}
+
+// Rings returns the ring buffer sizes of the link.
+func (c *Client) Rings(link string) (Rings, error) {
+	var rings Rings
+
+	err := c.get(link, unix.ETHTOOL_MSG_RINGS_GET, unix.ETHTOOL_A_RINGS_HEADER, func(ad *netlink.AttributeDecoder) {
+		switch ad.Type() {
+		case unix.ETHTOOL_A_RINGS_RX_MAX:
+			rings.RXMax = pointer.To(ad.Uint32())
+		case unix.ETHTOOL_A_RINGS_RX_MINI_MAX:
+			rings.RXMiniMax = pointer.To(ad.Uint32())
+		case unix.ETHTOOL_A_RINGS_RX_JUMBO_MAX:
+			rings.RXJumboMax = pointer.To(ad.Uint32())
+		case unix.ETHTOOL_A_RINGS_TX_MAX:
+			rings.TXMax = pointer.To(ad.Uint32())
+		case unix.ETHTOOL_A_RINGS_RX:
+			rings.RX = pointer.To(ad.Uint32())
+		case unix.ETHTOOL_A_RINGS_RX_MINI:
+			rings.RXMini = pointer.To(ad.Uint32())
+		case unix.ETHTOOL_A_RINGS_RX_JUMBO:
+			rings.RXJumbo = pointer.To(ad.Uint32())
+		case unix.ETHTOOL_A_RINGS_TX:
+			rings.TX = pointer.To(ad.Uint32())
+		}
+	})
+
+	return rings, err
+}
+
+// SetRings changes the ring buffer sizes of the link.
+//
+// Only the current values (not the maximum values) which are not nil are changed.
+func (c *Client) SetRings(link string, rings Rings) error {
+	return c.set(link, unix.ETHTOOL_MSG_RINGS_SET, unix.ETHTOOL_A_RINGS_HEADER, func(ae *netlink.AttributeEncoder) {
+		encodeUint32(ae, unix.ETHTOOL_A_RINGS_RX, rings.RX)
+		encodeUint32(ae, unix.ETHTOOL_A_RINGS_RX_MINI, rings.RXMini)
+		encodeUint32(ae, unix.ETHTOOL_A_RINGS_RX_JUMBO, rings.RXJumbo)
+		encodeUint32(ae, unix.ETHTOOL_A_RINGS_TX, rings.TX)
+	})
+}
+
+// Channels returns the number of channels of the link.
+func (c *Client) Channels(link string) (Channels, error) {
+	var channels Channels
+
+	err := c.get(link, unix.ETHTOOL_MSG_CHANNELS_GET, unix.ETHTOOL_A_CHANNELS_HEADER, func(ad *netlink.AttributeDecoder) {
+		switch ad.Type() {
+		case unix.ETHTOOL_A_CHANNELS_RX_MAX:
+			channels.RXMax = pointer.To(ad.Uint32())
+		case unix.ETHTOOL_A_CHANNELS_TX_MAX:
+			channels.TXMax = pointer.To(ad.Uint32())
+		case unix.ETHTOOL_A_CHANNELS_OTHER_MAX:
+			channels.OtherMax = pointer.To(ad.Uint32())
+		case unix.ETHTOOL_A_CHANNELS_COMBINED_MAX:
+			channels.CombinedMax = pointer.To(ad.Uint32())
+		case unix.ETHTOOL_A_CHANNELS_RX_COUNT:
+			channels.RX = pointer.To(ad.Uint32())
+		case unix.ETHTOOL_A_CHANNELS_TX_COUNT:
+			channels.TX = pointer.To(ad.Uint32())
+		case unix.ETHTOOL_A_CHANNELS_OTHER_COUNT:
+			channels.Other = pointer.To(ad.Uint32())
+		case unix.ETHTOOL_A_CHANNELS_COMBINED_COUNT:
+			channels.Combined = pointer.To(ad.Uint32())
+		}
+	})
+
+	return channels, err
+}
+
+// SetChannels changes the number of channels of the link.
+//
+// Only the current values (not the maximum values) which are not nil are changed.
+func (c *Client) SetChannels(link string, channels Channels) error {
+	return c.set(link, unix.ETHTOOL_MSG_CHANNELS_SET, unix.ETHTOOL_A_CHANNELS_HEADER, func(ae *netlink.AttributeEncoder) {
+		encodeUint32(ae, unix.ETHTOOL_A_CHANNELS_RX_COUNT, channels.RX)
+		encodeUint32(ae, unix.ETHTOOL_A_CHANNELS_TX_COUNT, channels.TX)
+		encodeUint32(ae, unix.ETHTOOL_A_CHANNELS_OTHER_COUNT, channels.Other)
+		encodeUint32(ae, unix.ETHTOOL_A_CHANNELS_COMBINED_COUNT, channels.Combined)
+	})
+}
+
+// Pause returns the pause frames settings of the link.
+func (c *Client) Pause(link string) (Pause, error) {
+	var pause Pause
+
+	err := c.get(link, unix.ETHTOOL_MSG_PAUSE_GET, unix.ETHTOOL_A_PAUSE_HEADER, func(ad *netlink.AttributeDecoder) {
+		switch ad.Type() {
+		case unix.ETHTOOL_A_PAUSE_AUTONEG:
+			pause.Autonegotiate = pointer.To(ad.Uint8() != 0)
+		case unix.ETHTOOL_A_PAUSE_RX:
+			pause.RX = pointer.To(ad.Uint8() != 0)
+		case unix.ETHTOOL_A_PAUSE_TX:
+			pause.TX = pointer.To(ad.Uint8() != 0)
+		}
+	})
+
+	return pause, err
+}
+
+// SetPause changes the pause frames settings of the link.
+//
+// Only the values which are not nil are changed.
+func (c *Client) SetPause(link string, pause Pause) error {
+	return c.set(link, unix.ETHTOOL_MSG_PAUSE_SET, unix.ETHTOOL_A_PAUSE_HEADER, func(ae *netlink.AttributeEncoder) {
+		encodeBool(ae, unix.ETHTOOL_A_PAUSE_AUTONEG, pause.Autonegotiate)
+		encodeBool(ae, unix.ETHTOOL_A_PAUSE_RX, pause.RX)
+		encodeBool(ae, unix.ETHTOOL_A_PAUSE_TX, pause.TX)
+	})
+}
+
+// Features returns the features of the link sorted by name.
+//
+// Only the features which are either active or can be changed are returned.
+func (c *Client) Features(link string) ([]Feature, error) {
+	var hw, active, noChange map[string]bool
+
+	err := c.get(link, unix.ETHTOOL_MSG_FEATURES_GET, unix.ETHTOOL_A_FEATURES_HEADER, func(ad *netlink.AttributeDecoder) {
+		switch ad.Type() {
+		case unix.ETHTOOL_A_FEATURES_HW:
+			ad.Nested(decodeBitset(&hw))
+		case unix.ETHTOOL_A_FEATURES_ACTIVE:
+			ad.Nested(decodeBitset(&active))
+		case unix.ETHTOOL_A_FEATURES_NOCHANGE:
+			ad.Nested(decodeBitset(&noChange))
+		}
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	names := map[string]struct{}{}
+
+	for name, value := range hw {
+		if value {
+			names[name] = struct{}{}
+		}
+	}
+
+	for name, value := range active {
+		if value {
+			names[name] = struct{}{}
+		}
+	}
+
+	features := make([]Feature, 0, len(names))
+
+	for name := range names {
+		features = append(features, Feature{
+			Name:   name,
+			Active: active[name],
+			Fixed:  !hw[name] || noChange[name],
+		})
+	}
+
+	sort.Slice(features, func(i, j int) bool { return features[i].Name < features[j].Name })
+
+	return features, nil
+}
+
+// SetFeatures enables or disables the features of the link.
+//
+// The features which are not listed are not changed.
+func (c *Client) SetFeatures(link string, features map[string]bool) error {
+	names := make([]string, 0, len(features))
+
+	for name := range features {
+		names = append(names, name)
+	}
+
+	sort.Strings(names)
+
+	return c.request(link, unix.ETHTOOL_MSG_FEATURES_SET, unix.ETHTOOL_A_FEATURES_HEADER, unix.ETHTOOL_FLAG_OMIT_REPLY,
+		netlink.Request|netlink.Acknowledge,
+		func(ae *netlink.AttributeEncoder) {
+			ae.Nested(unix.ETHTOOL_A_FEATURES_WANTED, func(nae *netlink.AttributeEncoder) error {
+				nae.Nested(unix.ETHTOOL_A_BITSET_BITS, func(nae *netlink.AttributeEncoder) error {
+					for _, name := range names {
+						name := name
+
+						nae.Nested(unix.ETHTOOL_A_BITSET_BITS_BIT, func(nae *netlink.AttributeEncoder) error {
+							nae.String(unix.ETHTOOL_A_BITSET_BIT_NAME, name)
+							nae.Flag(unix.ETHTOOL_A_BITSET_BIT_VALUE, features[name])
+
+							return nil
+						})
+					}
+
+					return nil
+				})
+
+				return nil
+			})
+		},
+		nil,
+	)
+}
+
+func (c *Client) get(link string, cmd uint8, headerType uint16, decode func(ad *netlink.AttributeDecoder)) error {
+	return c.request(link, cmd, headerType, 0, netlink.Request, nil, decode)
+}
+
+func (c *Client) set(link string, cmd uint8, headerType uint16, encode func(ae *netlink.AttributeEncoder)) error {
+	return c.request(link, cmd, headerType, 0, netlink.Request|netlink.Acknowledge, encode, nil)
+}
+
+func (c *Client) request(
+	link string, cmd uint8, headerType uint16, headerFlags uint32, flags netlink.HeaderFlags,
+	encode func(ae *netlink.AttributeEncoder), decode func(ad *netlink.AttributeDecoder),
+) error {
+	ae := netlink.NewAttributeEncoder()
+
+	ae.Nested(headerType, func(nae *netlink.AttributeEncoder) error {
+		nae.String(unix.ETHTOOL_A_HEADER_DEV_NAME, link)
+
+		if headerFlags != 0 {
+			nae.Uint32(unix.ETHTOOL_A_HEADER_FLAGS, headerFlags)
+		}
+
+		return nil
+	})
+
+	if encode != nil {
+		encode(ae)
+	}
+
+	b, err := ae.Encode()
+	if err != nil {
+		return err
+	}
+
+	msgs, err := c.conn.Execute(
+		genetlink.Message{
+			Header: genetlink.Header{
+				Command: cmd,
+				Version: unix.ETHTOOL_GENL_VERSION,
+			},
+			Data: b,
+		},
+		c.family.ID,
+		flags,
+	)
+	if err != nil {
+		return err
+	}
+
+	if decode == nil {
+		return nil
+	}
+
+	for _, msg := range msgs {
+		ad, err := netlink.NewAttributeDecoder(msg.Data)
+		if err != nil {
+			return err
+		}
+
+		for ad.Next() {
+			decode(ad)
+		}
+
+		if err = ad.Err(); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// decodeBitset decodes the verbose bitset into a map of bit names to values.
+func decodeBitset(result *map[string]bool) func(ad *netlink.AttributeDecoder) error {
+	return func(ad *netlink.AttributeDecoder) error {
+		*result = map[string]bool{}
+
+		// without the mask, the bitset is a list of the bits which are set
+		noMask := false
+
+		for ad.Next() {
+			switch ad.Type() {
+			case unix.ETHTOOL_A_BITSET_NOMASK:
+				noMask = ad.Flag()
+			case unix.ETHTOOL_A_BITSET_BITS:
+				ad.Nested(func(nad *netlink.AttributeDecoder) error {
+					for nad.Next() {
+						if nad.Type() != unix.ETHTOOL_A_BITSET_BITS_BIT {
+							continue
+						}
+
+						nad.Nested(func(bad *netlink.AttributeDecoder) error {
+							var (
+								name  string
+								value bool
+							)
+
+							for bad.Next() {
+								switch bad.Type() {
+								case unix.ETHTOOL_A_BITSET_BIT_NAME:
+									name = bad.String()
+								case unix.ETHTOOL_A_BITSET_BIT_VALUE:
+									value = bad.Flag()
+								}
+							}
+
+							(*result)[name] = value
+
+							return nil
+						})
+					}
+
+					return nil
+				})
+			}
+		}
+
+		if noMask {
+			for name := range *result {
+				(*result)[name] = true
+			}
+		}
+
+		return nil
+	}
+}
+
+func encodeUint32(ae *netlink.AttributeEncoder, typ uint16, v *uint32) {
+	if v != nil {
+		ae.Uint32(typ, *v)
+	}
+}
+
+func encodeBool(ae *netlink.AttributeEncoder, typ uint16, v *bool) {
+	if v == nil {
+		return
+	}
+
+	if *v {
+		ae.Uint8(typ, 1)
+	} else {
+		ae.Uint8(typ, 0)
+	}
+}
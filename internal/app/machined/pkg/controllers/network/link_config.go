@@ -357,6 +357,30 @@ func (ctrl *LinkConfigController) processDevicesConfiguration(logger *zap.Logger
 			dummyLink(linkMap[device.Interface()])
 		}
 
+		if device.VXLAN() != nil {
+			if err := vxlanLink(linkMap[device.Interface()], device.VXLAN()); err != nil {
+				logger.Error("error parsing vxlan config", zap.Error(err))
+			}
+		}
+
+		if device.MACVLAN() != nil {
+			if err := macvlanLink(linkMap[device.Interface()], network.LinkKindMACVLAN, device.MACVLAN()); err != nil {
+				logger.Error("error parsing macvlan config", zap.Error(err))
+			}
+		}
+
+		if device.MACVTAP() != nil {
+			if err := macvlanLink(linkMap[device.Interface()], network.LinkKindMACVTAP, device.MACVTAP()); err != nil {
+				logger.Error("error parsing macvtap config", zap.Error(err))
+			}
+		}
+
+		if device.IPVLAN() != nil {
+			if err := ipvlanLink(linkMap[device.Interface()], device.IPVLAN()); err != nil {
+				logger.Error("error parsing ipvlan config", zap.Error(err))
+			}
+		}
+
 		for _, vlan := range device.Vlans() {
 			vlanName := fmt.Sprintf("%s.%d", device.Interface(), vlan.ID())
 
@@ -454,3 +478,70 @@ func dummyLink(link *network.LinkSpecSpec) {
 	link.Kind = "dummy"
 	link.Type = nethelpers.LinkEther
 }
+
+func vxlanLink(link *network.LinkSpecSpec, config talosconfig.VXLAN) error {
+	link.Logical = true
+	link.Kind = network.LinkKindVXLAN
+	link.Type = nethelpers.LinkEther
+	link.ParentName = config.Parent()
+	link.VXLAN = network.VXLANSpec{
+		VNI:  config.VNI(),
+		Port: config.Port(),
+	}
+
+	for _, addr := range []struct {
+		value  string
+		target *netip.Addr
+	}{
+		{config.Remote(), &link.VXLAN.Remote},
+		{config.Group(), &link.VXLAN.Group},
+		{config.Local(), &link.VXLAN.Local},
+	} {
+		if addr.value == "" {
+			continue
+		}
+
+		ip, err := netip.ParseAddr(addr.value)
+		if err != nil {
+			return err
+		}
+
+		*addr.target = ip
+	}
+
+	return nil
+}
+
+func macvlanLink(link *network.LinkSpecSpec, kind string, config talosconfig.MACVLAN) error {
+	mode, err := nethelpers.MACVLANModeString(config.Mode())
+	if err != nil {
+		return err
+	}
+
+	link.Logical = true
+	link.Kind = kind
+	link.Type = nethelpers.LinkEther
+	link.ParentName = config.Parent()
+	link.MACVLAN = network.MACVLANSpec{
+		Mode: mode,
+	}
+
+	return nil
+}
+
+func ipvlanLink(link *network.LinkSpecSpec, config talosconfig.IPVLAN) error {
+	mode, err := nethelpers.IPVLANModeString(config.Mode())
+	if err != nil {
+		return err
+	}
+
+	link.Logical = true
+	link.Kind = network.LinkKindIPVLAN
+	link.Type = nethelpers.LinkEther
+	link.ParentName = config.Parent()
+	link.IPVLAN = network.IPVLANSpec{
+		Mode: mode,
+	}
+
+	return nil
+}
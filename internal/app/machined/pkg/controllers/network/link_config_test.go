@@ -250,6 +250,35 @@ func (suite *LinkConfigSuite) TestMachineConfiguration() {
 								},
 							},
 						},
+						{
+							DeviceInterface: "vxlan42",
+							DeviceVXLAN: &v1alpha1.VXLAN{
+								VXLANVNI:    42,
+								VXLANRemote: "10.10.0.2",
+								VXLANLocal:  "10.10.0.1",
+								VXLANParent: "eth1",
+							},
+						},
+						{
+							DeviceInterface: "macvlan0",
+							DeviceMACVLAN: &v1alpha1.MACVLAN{
+								MACVLANParent: "eth0",
+							},
+						},
+						{
+							DeviceInterface: "macvtap0",
+							DeviceMACVTAP: &v1alpha1.MACVLAN{
+								MACVLANParent: "eth0",
+								MACVLANMode:   "passthru",
+							},
+						},
+						{
+							DeviceInterface: "ipvlan0",
+							DeviceIPVLAN: &v1alpha1.IPVLAN{
+								IPVLANParent: "eth1",
+								IPVLANMode:   "l3",
+							},
+						},
 					},
 				},
 			},
@@ -287,6 +316,10 @@ func (suite *LinkConfigSuite) TestMachineConfiguration() {
 			"configuration/br0",
 			"configuration/dummy0",
 			"configuration/wireguard0",
+			"configuration/vxlan42",
+			"configuration/macvlan0",
+			"configuration/macvtap0",
+			"configuration/ipvlan0",
 		}, func(r *network.LinkSpec, asrt *assert.Assertions) {
 			asrt.Equal(network.ConfigMachineConfiguration, r.TypedSpec().ConfigLayer)
 
@@ -367,6 +400,37 @@ func (suite *LinkConfigSuite) TestMachineConfiguration() {
 						},
 					}, r.TypedSpec().Wireguard,
 				)
+			case "vxlan42":
+				asrt.True(r.TypedSpec().Up)
+				asrt.True(r.TypedSpec().Logical)
+				asrt.Equal(nethelpers.LinkEther, r.TypedSpec().Type)
+				asrt.Equal(network.LinkKindVXLAN, r.TypedSpec().Kind)
+				asrt.Equal("eth1", r.TypedSpec().ParentName)
+				asrt.Equal(
+					network.VXLANSpec{
+						VNI:    42,
+						Remote: netip.MustParseAddr("10.10.0.2"),
+						Local:  netip.MustParseAddr("10.10.0.1"),
+					}, r.TypedSpec().VXLAN,
+				)
+			case "macvlan0":
+				asrt.True(r.TypedSpec().Up)
+				asrt.True(r.TypedSpec().Logical)
+				asrt.Equal(network.LinkKindMACVLAN, r.TypedSpec().Kind)
+				asrt.Equal("eth0", r.TypedSpec().ParentName)
+				asrt.Equal(nethelpers.MACVLANModeBridge, r.TypedSpec().MACVLAN.Mode)
+			case "macvtap0":
+				asrt.True(r.TypedSpec().Up)
+				asrt.True(r.TypedSpec().Logical)
+				asrt.Equal(network.LinkKindMACVTAP, r.TypedSpec().Kind)
+				asrt.Equal("eth0", r.TypedSpec().ParentName)
+				asrt.Equal(nethelpers.MACVLANModePassthru, r.TypedSpec().MACVLAN.Mode)
+			case "ipvlan0":
+				asrt.True(r.TypedSpec().Up)
+				asrt.True(r.TypedSpec().Logical)
+				asrt.Equal(network.LinkKindIPVLAN, r.TypedSpec().Kind)
+				asrt.Equal("eth1", r.TypedSpec().ParentName)
+				asrt.Equal(nethelpers.IPVLANModeL3, r.TypedSpec().IPVLAN.Mode)
 			}
 		},
 	)
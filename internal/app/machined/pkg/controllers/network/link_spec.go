@@ -162,6 +162,44 @@ func findLink(links []rtnetlink.LinkMessage, name string) *rtnetlink.LinkMessage
 	return nil
 }
 
+// logicalLinkChanged checks whether the settings of the VXLAN, macvlan/macvtap or ipvlan link differ from the spec.
+func logicalLinkChanged(existing *rtnetlink.LinkMessage, spec *network.LinkSpecSpec) (bool, error) {
+	switch spec.Kind {
+	case network.LinkKindVXLAN:
+		var existingVXLAN network.VXLANSpec
+
+		if err := networkadapter.VXLANSpec(&existingVXLAN).Decode(existing.Attributes.Info.Data); err != nil {
+			return false, err
+		}
+
+		desired := spec.VXLAN
+		networkadapter.VXLANSpec(&desired).FillDefaults()
+
+		return existingVXLAN != desired, nil
+	case network.LinkKindMACVLAN, network.LinkKindMACVTAP:
+		var existingMACVLAN network.MACVLANSpec
+
+		if err := networkadapter.MACVLANSpec(&existingMACVLAN).Decode(existing.Attributes.Info.Data); err != nil {
+			return false, err
+		}
+
+		desired := spec.MACVLAN
+		networkadapter.MACVLANSpec(&desired).FillDefaults()
+
+		return existingMACVLAN != desired, nil
+	case network.LinkKindIPVLAN:
+		var existingIPVLAN network.IPVLANSpec
+
+		if err := networkadapter.IPVLANSpec(&existingIPVLAN).Decode(existing.Attributes.Info.Data); err != nil {
+			return false, err
+		}
+
+		return existingIPVLAN != spec.IPVLAN, nil
+	default:
+		return false, nil
+	}
+}
+
 // syncLink syncs kernel state with the LinkSpec link.
 //
 // This method is really long, but it's hard to break it down in multiple pieces, are those pieces and steps are inter-dependent, so, instead,
@@ -176,6 +214,7 @@ func findLink(links []rtnetlink.LinkMessage, name string) *rtnetlink.LinkMessage
 // If the logical link kind or type got changed (for example, "link0" was a bond, and now it's wireguard interface), the link
 // is dropped and replaced with the new one.
 // Same replace flow is used for VLAN links, as VLAN settings can't be changed on the fly.
+// VXLAN, macvlan/macvtap and ipvlan links follow the same flow.
 //
 // For bonded links, there are two sync steps applied:
 //
@@ -267,6 +306,20 @@ func (ctrl *LinkSpecController) syncLink(ctx context.Context, r controller.Runti
 				}
 			}
 
+			// sync VXLAN, macvlan and ipvlan specs, they are replaced the same way as VLANs
+			if !replace {
+				changed, err := logicalLinkChanged(existing, link.TypedSpec())
+				if err != nil {
+					return fmt.Errorf("error decoding %s properties on %q: %w", link.TypedSpec().Kind, link.TypedSpec().Name, err)
+				}
+
+				if changed {
+					logger.Info("replacing link with changed settings", zap.String("kind", link.TypedSpec().Kind))
+
+					replace = true
+				}
+			}
+
 			if replace {
 				if err := conn.Link.Delete(existing.Index); err != nil {
 					return fmt.Errorf("error deleting link %q: %w", link.TypedSpec().Name, err)
@@ -302,11 +355,25 @@ func (ctrl *LinkSpecController) syncLink(ctx context.Context, r controller.Runti
 				parentIndex = parent.Index
 			}
 
-			if link.TypedSpec().Kind == network.LinkKindVLAN {
+			switch link.TypedSpec().Kind {
+			case network.LinkKindVLAN:
 				data, err = networkadapter.VLANSpec(&link.TypedSpec().VLAN).Encode()
-				if err != nil {
-					return fmt.Errorf("error encoding VLAN attributes for link %q: %w", link.TypedSpec().Name, err)
-				}
+			case network.LinkKindVXLAN:
+				vxlan := link.TypedSpec().VXLAN
+				networkadapter.VXLANSpec(&vxlan).FillDefaults()
+
+				data, err = networkadapter.VXLANSpec(&vxlan).Encode(parentIndex)
+			case network.LinkKindMACVLAN, network.LinkKindMACVTAP:
+				macvlan := link.TypedSpec().MACVLAN
+				networkadapter.MACVLANSpec(&macvlan).FillDefaults()
+
+				data, err = networkadapter.MACVLANSpec(&macvlan).Encode()
+			case network.LinkKindIPVLAN:
+				data, err = networkadapter.IPVLANSpec(&link.TypedSpec().IPVLAN).Encode()
+			}
+
+			if err != nil {
+				return fmt.Errorf("error encoding %s attributes for link %q: %w", link.TypedSpec().Kind, link.TypedSpec().Name, err)
 			}
 
 			if err = conn.Link.New(&rtnetlink.LinkMessage{
@@ -0,0 +1,470 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package network
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/cosi-project/runtime/pkg/controller"
+	"github.com/cosi-project/runtime/pkg/resource"
+	"github.com/cosi-project/runtime/pkg/safe"
+	"github.com/google/nftables"
+	"github.com/google/nftables/binaryutil"
+	"github.com/google/nftables/expr"
+	"go.uber.org/zap"
+	"go4.org/netipx"
+	"golang.org/x/sys/unix"
+
+	"github.com/siderolabs/talos/pkg/machinery/nethelpers"
+	"github.com/siderolabs/talos/pkg/machinery/resources/network"
+)
+
+// NfTablesChainController renders network.NfTablesChain into the nftables table.
+//
+// The table is fully replaced on every change in a single nftables transaction.
+type NfTablesChainController struct {
+	// TableName is the name of the nftables table, it defaults to "talos".
+	TableName string
+
+	lastVersions map[resource.ID]string
+}
+
+// Name implements controller.Controller interface.
+func (ctrl *NfTablesChainController) Name() string {
+	return "network.NfTablesChainController"
+}
+
+// Inputs implements controller.Controller interface.
+func (ctrl *NfTablesChainController) Inputs() []controller.Input {
+	return []controller.Input{
+		{
+			Namespace: network.NamespaceName,
+			Type:      network.NfTablesChainType,
+			Kind:      controller.InputWeak,
+		},
+	}
+}
+
+// Outputs implements controller.Controller interface.
+func (ctrl *NfTablesChainController) Outputs() []controller.Output {
+	return nil
+}
+
+// Run implements controller.Controller interface.
+func (ctrl *NfTablesChainController) Run(ctx context.Context, r controller.Runtime, logger *zap.Logger) error {
+	if ctrl.TableName == "" {
+		ctrl.TableName = "talos"
+	}
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-r.EventCh():
+		}
+
+		list, err := safe.ReaderList[*network.NfTablesChain](ctx, r, resource.NewMetadata(network.NamespaceName, network.NfTablesChainType, "", resource.VersionUndefined))
+		if err != nil {
+			return fmt.Errorf("error listing nftables chains: %w", err)
+		}
+
+		versions := make(map[resource.ID]string, list.Len())
+		chains := make([]*network.NfTablesChain, 0, list.Len())
+
+		for iter := safe.IteratorFromList(list); iter.Next(); {
+			versions[iter.Value().Metadata().ID()] = iter.Value().Metadata().Version().String()
+			chains = append(chains, iter.Value())
+		}
+
+		if ctrl.lastVersions != nil && equalVersions(ctrl.lastVersions, versions) {
+			continue
+		}
+
+		sort.Slice(chains, func(i, j int) bool { return chains[i].Metadata().ID() < chains[j].Metadata().ID() })
+
+		if err = ctrl.render(chains); err != nil {
+			return err
+		}
+
+		logger.Info("nftables chains updated", zap.Int("chains", len(chains)))
+
+		ctrl.lastVersions = versions
+
+		r.ResetRestartBackoff()
+	}
+}
+
+func equalVersions(a, b map[resource.ID]string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+
+	for id, version := range a {
+		if b[id] != version {
+			return false
+		}
+	}
+
+	return true
+}
+
+func (ctrl *NfTablesChainController) render(chains []*network.NfTablesChain) error {
+	c := &nftables.Conn{}
+
+	table := &nftables.Table{
+		Family: nftables.TableFamilyINet,
+		Name:   ctrl.TableName,
+	}
+
+	tables, err := c.ListTables()
+	if err != nil {
+		return fmt.Errorf("error listing nftables tables: %w", err)
+	}
+
+	for _, existing := range tables {
+		if existing.Name == table.Name && existing.Family == table.Family {
+			c.DelTable(table)
+
+			break
+		}
+	}
+
+	if len(chains) > 0 {
+		c.AddTable(table)
+
+		for _, chain := range chains {
+			spec := chain.TypedSpec()
+
+			nfChain := c.AddChain(&nftables.Chain{
+				Name:     chain.Metadata().ID(),
+				Table:    table,
+				Type:     nftables.ChainTypeFilter,
+				Hooknum:  nftables.ChainHookRef(nftables.ChainHook(spec.Hook)),
+				Priority: nftables.ChainPriorityRef(nftables.ChainPriority(spec.Priority)),
+				Policy:   policyRef(spec.Policy),
+			})
+
+			for i, rule := range spec.Rules {
+				compiled, err := compileRule(rule)
+				if err != nil {
+					return fmt.Errorf("error compiling rule %d of chain %q: %w", i, chain.Metadata().ID(), err)
+				}
+
+				for _, exprs := range compiled {
+					if exprs, err = addSets(c, table, exprs); err != nil {
+						return fmt.Errorf("error adding sets for rule %d of chain %q: %w", i, chain.Metadata().ID(), err)
+					}
+
+					c.AddRule(&nftables.Rule{
+						Table: table,
+						Chain: nfChain,
+						Exprs: exprs,
+					})
+				}
+			}
+		}
+	}
+
+	if err = c.Flush(); err != nil {
+		return fmt.Errorf("error updating nftables: %w", err)
+	}
+
+	return nil
+}
+
+func policyRef(verdict nethelpers.NfTablesVerdict) *nftables.ChainPolicy {
+	policy := nftables.ChainPolicyDrop
+
+	if verdict == nethelpers.VerdictAccept {
+		policy = nftables.ChainPolicyAccept
+	}
+
+	return &policy
+}
+
+// setLookup is a placeholder for the lookup into the anonymous set which is created when the rule is added.
+type setLookup struct {
+	expr.Lookup
+
+	set      *nftables.Set
+	elements []nftables.SetElement
+}
+
+// addSets creates the anonymous sets used by the rule and replaces placeholders with the lookups.
+func addSets(c *nftables.Conn, table *nftables.Table, exprs []expr.Any) ([]expr.Any, error) {
+	result := make([]expr.Any, 0, len(exprs))
+
+	for _, e := range exprs {
+		lookup, ok := e.(*setLookup)
+		if !ok {
+			result = append(result, e)
+
+			continue
+		}
+
+		set := *lookup.set
+		set.Table = table
+
+		if err := c.AddSet(&set, lookup.elements); err != nil {
+			return nil, err
+		}
+
+		result = append(result, &expr.Lookup{
+			SourceRegister: lookup.SourceRegister,
+			SetName:        set.Name,
+			SetID:          set.ID,
+		})
+	}
+
+	return result, nil
+}
+
+// compileRule converts the rule into one or more nftables rules.
+//
+// The source address match can't be expressed with a single rule if it has subnets of both address families,
+// so the rule is split into a rule per address family. The rule matching no addresses produces no rules.
+func compileRule(rule network.NfTablesRule) ([][]expr.Any, error) {
+	var common []expr.Any
+
+	if rule.MatchIIfName != "" {
+		common = append(common,
+			// [ meta load iifname => reg 1 ]
+			&expr.Meta{Key: expr.MetaKeyIIFNAME, Register: 1},
+			// [ cmp eq reg 1 name ]
+			&expr.Cmp{
+				Op:       expr.CmpOpEq,
+				Register: 1,
+				Data:     ifname(rule.MatchIIfName),
+			},
+		)
+	}
+
+	if rule.MatchConntrackState != nil {
+		var states uint32
+
+		for _, state := range rule.MatchConntrackState.States {
+			states |= uint32(state)
+		}
+
+		common = append(common,
+			// [ ct load state => reg 1 ]
+			&expr.Ct{Key: expr.CtKeySTATE, Register: 1},
+			// [ bitwise reg 1 = ( reg 1 & states ) ^ 0x00000000 ]
+			&expr.Bitwise{
+				SourceRegister: 1,
+				DestRegister:   1,
+				Len:            4,
+				Mask:           binaryutil.NativeEndian.PutUint32(states),
+				Xor:            binaryutil.NativeEndian.PutUint32(0),
+			},
+			// [ cmp neq reg 1 0x00000000 ]
+			&expr.Cmp{
+				Op:       expr.CmpOpNeq,
+				Register: 1,
+				Data:     binaryutil.NativeEndian.PutUint32(0),
+			},
+		)
+	}
+
+	var tail []expr.Any
+
+	if rule.MatchLayer4 != nil {
+		tail = append(tail,
+			// [ meta load l4proto => reg 1 ]
+			&expr.Meta{Key: expr.MetaKeyL4PROTO, Register: 1},
+			// [ cmp eq reg 1 protocol ]
+			&expr.Cmp{
+				Op:       expr.CmpOpEq,
+				Register: 1,
+				Data:     []byte{byte(rule.MatchLayer4.Protocol)},
+			},
+		)
+
+		if rule.MatchLayer4.MatchDestinationPort != nil && len(rule.MatchLayer4.MatchDestinationPort.Ranges) > 0 {
+			tail = append(tail,
+				// [ payload load 2b @ transport header + 2 => reg 1 ]
+				&expr.Payload{
+					DestRegister: 1,
+					Base:         expr.PayloadBaseTransportHeader,
+					Offset:       2,
+					Len:          2,
+				},
+				// [ lookup reg 1 set __set%d ]
+				&setLookup{
+					Lookup: expr.Lookup{SourceRegister: 1},
+					set: &nftables.Set{
+						Anonymous: true,
+						Constant:  true,
+						Interval:  true,
+						KeyType:   nftables.TypeInetService,
+					},
+					elements: portSetElements(rule.MatchLayer4.MatchDestinationPort.Ranges),
+				},
+			)
+		}
+	}
+
+	if rule.Verdict != nil {
+		verdict := expr.VerdictDrop
+
+		if *rule.Verdict == nethelpers.VerdictAccept {
+			verdict = expr.VerdictAccept
+		}
+
+		tail = append(tail, &expr.Verdict{Kind: verdict})
+	}
+
+	if rule.MatchSourceAddress == nil {
+		return [][]expr.Any{append(common, tail...)}, nil
+	}
+
+	var builder netipx.IPSetBuilder
+
+	for _, subnet := range rule.MatchSourceAddress.IncludeSubnets {
+		builder.AddPrefix(subnet)
+	}
+
+	for _, subnet := range rule.MatchSourceAddress.ExcludeSubnets {
+		builder.RemovePrefix(subnet)
+	}
+
+	ipSet, err := builder.IPSet()
+	if err != nil {
+		return nil, fmt.Errorf("error building source address set: %w", err)
+	}
+
+	elements4, elements6 := addressSetElements(ipSet)
+
+	var result [][]expr.Any
+
+	for _, family := range []struct {
+		nfproto  byte
+		offset   uint32
+		length   uint32
+		keyType  nftables.SetDatatype
+		elements []nftables.SetElement
+	}{
+		{unix.NFPROTO_IPV4, 12, 4, nftables.TypeIPAddr, elements4},
+		{unix.NFPROTO_IPV6, 8, 16, nftables.TypeIP6Addr, elements6},
+	} {
+		if len(family.elements) == 0 {
+			continue
+		}
+
+		exprs := append([]expr.Any(nil), common...)
+		exprs = append(exprs,
+			// [ meta load nfproto => reg 1 ]
+			&expr.Meta{Key: expr.MetaKeyNFPROTO, Register: 1},
+			// [ cmp eq reg 1 family ]
+			&expr.Cmp{
+				Op:       expr.CmpOpEq,
+				Register: 1,
+				Data:     []byte{family.nfproto},
+			},
+			// [ payload load saddr => reg 1 ]
+			&expr.Payload{
+				DestRegister: 1,
+				Base:         expr.PayloadBaseNetworkHeader,
+				Offset:       family.offset,
+				Len:          family.length,
+			},
+			// [ lookup reg 1 set __set%d ]
+			&setLookup{
+				Lookup: expr.Lookup{SourceRegister: 1},
+				set: &nftables.Set{
+					Anonymous: true,
+					Constant:  true,
+					Interval:  true,
+					KeyType:   family.keyType,
+				},
+				elements: family.elements,
+			},
+		)
+		exprs = append(exprs, tail...)
+
+		result = append(result, exprs)
+	}
+
+	return result, nil
+}
+
+// addressSetElements converts the set into the interval set elements.
+//
+// The end of the interval is exclusive, so it's omitted for the ranges which end at the last address.
+func addressSetElements(ipSet *netipx.IPSet) (elements4, elements6 []nftables.SetElement) {
+	for _, r := range ipSet.Ranges() {
+		fromBin, _ := r.From().MarshalBinary() //nolint:errcheck // doesn't fail
+
+		se := []nftables.SetElement{
+			{
+				Key: fromBin,
+			},
+		}
+
+		if next := r.To().Next(); next.IsValid() {
+			toBin, _ := next.MarshalBinary() //nolint:errcheck // doesn't fail
+
+			se = append(se, nftables.SetElement{
+				Key:         toBin,
+				IntervalEnd: true,
+			})
+		}
+
+		if r.From().Is6() {
+			elements6 = append(elements6, se...)
+		} else {
+			elements4 = append(elements4, se...)
+		}
+	}
+
+	return elements4, elements6
+}
+
+// portSetElements converts the port ranges into the interval set elements merging the overlapping ranges.
+func portSetElements(ranges []network.PortRange) []nftables.SetElement {
+	ranges = append([]network.PortRange(nil), ranges...)
+
+	sort.Slice(ranges, func(i, j int) bool { return ranges[i].Lo < ranges[j].Lo })
+
+	merged := make([]network.PortRange, 0, len(ranges))
+
+	for _, portRange := range ranges {
+		if len(merged) > 0 && uint32(portRange.Lo) <= uint32(merged[len(merged)-1].Hi)+1 {
+			if portRange.Hi > merged[len(merged)-1].Hi {
+				merged[len(merged)-1].Hi = portRange.Hi
+			}
+
+			continue
+		}
+
+		merged = append(merged, portRange)
+	}
+
+	elements := make([]nftables.SetElement, 0, 2*len(merged))
+
+	for _, portRange := range merged {
+		elements = append(elements, nftables.SetElement{
+			Key: binaryutil.BigEndian.PutUint16(portRange.Lo),
+		})
+
+		if portRange.Hi < 0xffff {
+			elements = append(elements, nftables.SetElement{
+				Key:         binaryutil.BigEndian.PutUint16(portRange.Hi + 1),
+				IntervalEnd: true,
+			})
+		}
+	}
+
+	return elements
+}
+
+func ifname(name string) []byte {
+	b := make([]byte, 16)
+	copy(b, []byte(name))
+
+	return b
+}
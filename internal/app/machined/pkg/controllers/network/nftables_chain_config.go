@@ -0,0 +1,217 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package network
+
+import (
+	"context"
+	"fmt"
+	"net/netip"
+	"sort"
+
+	"github.com/cosi-project/runtime/pkg/controller"
+	"github.com/cosi-project/runtime/pkg/resource"
+	"github.com/cosi-project/runtime/pkg/safe"
+	"github.com/cosi-project/runtime/pkg/state"
+	"github.com/siderolabs/go-pointer"
+	"go.uber.org/zap"
+
+	"github.com/siderolabs/talos/pkg/machinery/nethelpers"
+	"github.com/siderolabs/talos/pkg/machinery/resources/config"
+	"github.com/siderolabs/talos/pkg/machinery/resources/network"
+)
+
+// IngressChainName is the name of the nftables chain which filters the ingress traffic.
+const IngressChainName = "ingress"
+
+// NfTablesChainConfigController renders network.RuleConfig into the ingress network.NfTablesChain.
+type NfTablesChainConfigController struct{}
+
+// Name implements controller.Controller interface.
+func (ctrl *NfTablesChainConfigController) Name() string {
+	return "network.NfTablesChainConfigController"
+}
+
+// Inputs implements controller.Controller interface.
+func (ctrl *NfTablesChainConfigController) Inputs() []controller.Input {
+	return []controller.Input{
+		{
+			Namespace: config.NamespaceName,
+			Type:      config.MachineConfigType,
+			ID:        pointer.To(config.V1Alpha1ID),
+			Kind:      controller.InputWeak,
+		},
+		{
+			Namespace: network.NamespaceName,
+			Type:      network.RuleConfigType,
+			Kind:      controller.InputWeak,
+		},
+	}
+}
+
+// Outputs implements controller.Controller interface.
+func (ctrl *NfTablesChainConfigController) Outputs() []controller.Output {
+	return []controller.Output{
+		{
+			Type: network.NfTablesChainType,
+			Kind: controller.OutputExclusive,
+		},
+	}
+}
+
+// Run implements controller.Controller interface.
+func (ctrl *NfTablesChainConfigController) Run(ctx context.Context, r controller.Runtime, logger *zap.Logger) error {
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-r.EventCh():
+		}
+
+		cfg, err := safe.ReaderGet[*config.MachineConfig](ctx, r, resource.NewMetadata(config.NamespaceName, config.MachineConfigType, config.V1Alpha1ID, resource.VersionUndefined))
+		if err != nil && !state.IsNotFoundError(err) {
+			return fmt.Errorf("error getting config: %w", err)
+		}
+
+		touchedIDs := map[resource.ID]struct{}{}
+
+		if cfg != nil && cfg.Config().Machine().Network().Firewall().Enabled() {
+			rules, err := safe.ReaderList[*network.RuleConfig](ctx, r, resource.NewMetadata(network.NamespaceName, network.RuleConfigType, "", resource.VersionUndefined))
+			if err != nil {
+				return fmt.Errorf("error listing rule configs: %w", err)
+			}
+
+			policy := nethelpers.VerdictAccept
+
+			if cfg.Config().Machine().Network().Firewall().DefaultAction() == nethelpers.DefaultActionBlock {
+				policy = nethelpers.VerdictDrop
+			}
+
+			if err = safe.WriterModify(ctx, r, network.NewNfTablesChain(network.NamespaceName, IngressChainName), func(chain *network.NfTablesChain) error {
+				*chain.TypedSpec() = IngressChainSpec(policy, rules)
+
+				return nil
+			}); err != nil {
+				return fmt.Errorf("error updating nftables chain: %w", err)
+			}
+
+			touchedIDs[IngressChainName] = struct{}{}
+		}
+
+		list, err := safe.ReaderList[*network.NfTablesChain](ctx, r, resource.NewMetadata(network.NamespaceName, network.NfTablesChainType, "", resource.VersionUndefined))
+		if err != nil {
+			return fmt.Errorf("error listing nftables chains: %w", err)
+		}
+
+		for iter := safe.IteratorFromList(list); iter.Next(); {
+			if _, ok := touchedIDs[iter.Value().Metadata().ID()]; ok {
+				continue
+			}
+
+			if err = r.Destroy(ctx, iter.Value().Metadata()); err != nil {
+				return fmt.Errorf("error cleaning up nftables chains: %w", err)
+			}
+		}
+
+		r.ResetRestartBackoff()
+	}
+}
+
+// IngressChainSpec builds the ingress chain from the firewall rules.
+//
+// The loopback traffic, established connections and ICMP are always accepted.
+// With the accept policy, the traffic to the ports selected by the rules is dropped unless it is accepted by any of the rules.
+func IngressChainSpec(policy nethelpers.NfTablesVerdict, rules safe.List[*network.RuleConfig]) network.NfTablesChainSpec {
+	spec := network.NfTablesChainSpec{
+		Hook:     nethelpers.ChainHookInput,
+		Priority: 0,
+		Policy:   policy,
+		Rules: []network.NfTablesRule{
+			{
+				MatchIIfName: "lo",
+				Verdict:      pointer.To(nethelpers.VerdictAccept),
+			},
+			{
+				MatchConntrackState: &network.NfTablesConntrackStateMatch{
+					States: []nethelpers.ConntrackState{
+						nethelpers.ConntrackStateEstablished,
+						nethelpers.ConntrackStateRelated,
+					},
+				},
+				Verdict: pointer.To(nethelpers.VerdictAccept),
+			},
+			{
+				MatchLayer4: &network.NfTablesLayer4Match{
+					Protocol: nethelpers.ProtocolICMP,
+				},
+				Verdict: pointer.To(nethelpers.VerdictAccept),
+			},
+			{
+				MatchLayer4: &network.NfTablesLayer4Match{
+					Protocol: nethelpers.ProtocolICMPv6,
+				},
+				Verdict: pointer.To(nethelpers.VerdictAccept),
+			},
+		},
+	}
+
+	sorted := make([]*network.RuleConfig, 0, rules.Len())
+
+	for iter := safe.IteratorFromList(rules); iter.Next(); {
+		sorted = append(sorted, iter.Value())
+	}
+
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i].Metadata().ID() < sorted[j].Metadata().ID() })
+
+	// the drop rules go after all accept rules, as several rules might select the same port
+	var drops []network.NfTablesRule
+
+	for _, rule := range sorted {
+		layer4 := &network.NfTablesLayer4Match{
+			Protocol: rule.TypedSpec().PortSelector.Protocol,
+		}
+
+		if len(rule.TypedSpec().PortSelector.Ports) > 0 {
+			layer4.MatchDestinationPort = &network.NfTablesPortMatch{
+				Ranges: rule.TypedSpec().PortSelector.Ports,
+			}
+		}
+
+		if len(rule.TypedSpec().Ingress) == 0 {
+			spec.Rules = append(spec.Rules, network.NfTablesRule{
+				MatchLayer4: layer4,
+				Verdict:     pointer.To(nethelpers.VerdictAccept),
+			})
+
+			continue
+		}
+
+		for _, ingress := range rule.TypedSpec().Ingress {
+			match := &network.NfTablesAddressMatch{
+				IncludeSubnets: []netip.Prefix{ingress.Subnet},
+			}
+
+			if ingress.Except.IsValid() {
+				match.ExcludeSubnets = []netip.Prefix{ingress.Except}
+			}
+
+			spec.Rules = append(spec.Rules, network.NfTablesRule{
+				MatchSourceAddress: match,
+				MatchLayer4:        layer4,
+				Verdict:            pointer.To(nethelpers.VerdictAccept),
+			})
+		}
+
+		if policy == nethelpers.VerdictAccept {
+			drops = append(drops, network.NfTablesRule{
+				MatchLayer4: layer4,
+				Verdict:     pointer.To(nethelpers.VerdictDrop),
+			})
+		}
+	}
+
+	spec.Rules = append(spec.Rules, drops...)
+
+	return spec
+}
@@ -0,0 +1,205 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package network_test
+
+import (
+	"net/netip"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/cosi-project/runtime/pkg/resource/rtestutils"
+	"github.com/siderolabs/go-pointer"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/siderolabs/talos/internal/app/machined/pkg/controllers/ctest"
+	netctrl "github.com/siderolabs/talos/internal/app/machined/pkg/controllers/network"
+	"github.com/siderolabs/talos/pkg/machinery/config/types/v1alpha1"
+	"github.com/siderolabs/talos/pkg/machinery/nethelpers"
+	"github.com/siderolabs/talos/pkg/machinery/resources/config"
+	"github.com/siderolabs/talos/pkg/machinery/resources/network"
+)
+
+type NfTablesChainConfigSuite struct {
+	ctest.DefaultSuite
+}
+
+func (suite *NfTablesChainConfigSuite) TestIngressChain() {
+	u, err := url.Parse("https://foo:6443")
+	suite.Require().NoError(err)
+
+	cfg := config.NewMachineConfig(&v1alpha1.Config{
+		ConfigVersion: "v1alpha1",
+		MachineConfig: &v1alpha1.MachineConfig{
+			MachineType: "worker",
+			MachineNetwork: &v1alpha1.NetworkConfig{
+				NetworkFirewall: &v1alpha1.NetworkFirewallConfig{
+					FirewallDefaultAction: "block",
+				},
+			},
+		},
+		ClusterConfig: &v1alpha1.ClusterConfig{
+			ControlPlane: &v1alpha1.ControlPlaneConfig{
+				Endpoint: &v1alpha1.Endpoint{
+					URL: u,
+				},
+			},
+		},
+	})
+
+	suite.Require().NoError(suite.State().Create(suite.Ctx(), cfg))
+
+	kubelet := network.NewRuleConfig(network.NamespaceName, "kubelet")
+	kubelet.TypedSpec().PortSelector = network.RulePortSelector{
+		Protocol: nethelpers.ProtocolTCP,
+		Ports:    []network.PortRange{{Lo: 10250, Hi: 10250}},
+	}
+	kubelet.TypedSpec().Ingress = []network.IngressRule{
+		{
+			Subnet: netip.MustParsePrefix("10.0.0.0/8"),
+			Except: netip.MustParsePrefix("10.10.0.0/16"),
+		},
+	}
+
+	apid := network.NewRuleConfig(network.NamespaceName, "apid")
+	apid.TypedSpec().PortSelector = network.RulePortSelector{
+		Protocol: nethelpers.ProtocolTCP,
+		Ports:    []network.PortRange{{Lo: 50000, Hi: 50000}},
+	}
+
+	suite.Require().NoError(suite.State().Create(suite.Ctx(), kubelet))
+	suite.Require().NoError(suite.State().Create(suite.Ctx(), apid))
+
+	rtestutils.AssertResources(suite.Ctx(), suite.T(), suite.State(), []string{netctrl.IngressChainName},
+		func(chain *network.NfTablesChain, asrt *assert.Assertions) {
+			spec := chain.TypedSpec()
+
+			asrt.Equal(nethelpers.ChainHookInput, spec.Hook)
+			asrt.Equal(nethelpers.VerdictDrop, spec.Policy)
+
+			// lo, conntrack, icmp, icmpv6, apid, kubelet
+			if !asrt.Len(spec.Rules, 6) {
+				return
+			}
+
+			asrt.Equal("lo", spec.Rules[0].MatchIIfName)
+			asrt.Nil(spec.Rules[4].MatchSourceAddress)
+			asrt.Equal([]network.PortRange{{Lo: 50000, Hi: 50000}}, spec.Rules[4].MatchLayer4.MatchDestinationPort.Ranges)
+			asrt.Equal(&network.NfTablesAddressMatch{
+				IncludeSubnets: []netip.Prefix{netip.MustParsePrefix("10.0.0.0/8")},
+				ExcludeSubnets: []netip.Prefix{netip.MustParsePrefix("10.10.0.0/16")},
+			}, spec.Rules[5].MatchSourceAddress)
+			asrt.Equal(pointer.To(nethelpers.VerdictAccept), spec.Rules[5].Verdict)
+		})
+
+	// with the accept policy, the traffic not matching the rule is dropped
+	cfg.Config().(*v1alpha1.Config).MachineConfig.MachineNetwork.NetworkFirewall.FirewallDefaultAction = "accept"
+	suite.Require().NoError(suite.State().Update(suite.Ctx(), cfg))
+
+	rtestutils.AssertResources(suite.Ctx(), suite.T(), suite.State(), []string{netctrl.IngressChainName},
+		func(chain *network.NfTablesChain, asrt *assert.Assertions) {
+			spec := chain.TypedSpec()
+
+			asrt.Equal(nethelpers.VerdictAccept, spec.Policy)
+
+			// apid accepts from any address, so only kubelet needs a drop rule
+			if !asrt.Len(spec.Rules, 7) {
+				return
+			}
+
+			asrt.Nil(spec.Rules[6].MatchSourceAddress)
+			asrt.Equal([]network.PortRange{{Lo: 10250, Hi: 10250}}, spec.Rules[6].MatchLayer4.MatchDestinationPort.Ranges)
+			asrt.Equal(pointer.To(nethelpers.VerdictDrop), spec.Rules[6].Verdict)
+		})
+
+	cfg.Config().(*v1alpha1.Config).MachineConfig.MachineNetwork.NetworkFirewall = nil
+	suite.Require().NoError(suite.State().Update(suite.Ctx(), cfg))
+
+	rtestutils.AssertNoResource[*network.NfTablesChain](suite.Ctx(), suite.T(), suite.State(), netctrl.IngressChainName)
+}
+
+func (suite *NfTablesChainConfigSuite) TestIngressChainSamePort() {
+	u, err := url.Parse("https://foo:6443")
+	suite.Require().NoError(err)
+
+	cfg := config.NewMachineConfig(&v1alpha1.Config{
+		ConfigVersion: "v1alpha1",
+		MachineConfig: &v1alpha1.MachineConfig{
+			MachineType: "worker",
+			MachineNetwork: &v1alpha1.NetworkConfig{
+				NetworkFirewall: &v1alpha1.NetworkFirewallConfig{
+					FirewallDefaultAction: "accept",
+				},
+			},
+		},
+		ClusterConfig: &v1alpha1.ClusterConfig{
+			ControlPlane: &v1alpha1.ControlPlaneConfig{
+				Endpoint: &v1alpha1.Endpoint{
+					URL: u,
+				},
+			},
+		},
+	})
+
+	suite.Require().NoError(suite.State().Create(suite.Ctx(), cfg))
+
+	portSelector := network.RulePortSelector{
+		Protocol: nethelpers.ProtocolTCP,
+		Ports:    []network.PortRange{{Lo: 9100, Hi: 9100}},
+	}
+
+	monitoring := network.NewRuleConfig(network.NamespaceName, "monitoring")
+	monitoring.TypedSpec().PortSelector = portSelector
+	monitoring.TypedSpec().Ingress = []network.IngressRule{
+		{
+			Subnet: netip.MustParsePrefix("10.0.0.0/8"),
+		},
+	}
+
+	prometheus := network.NewRuleConfig(network.NamespaceName, "prometheus")
+	prometheus.TypedSpec().PortSelector = portSelector
+	prometheus.TypedSpec().Ingress = []network.IngressRule{
+		{
+			Subnet: netip.MustParsePrefix("192.168.0.0/16"),
+		},
+	}
+
+	suite.Require().NoError(suite.State().Create(suite.Ctx(), monitoring))
+	suite.Require().NoError(suite.State().Create(suite.Ctx(), prometheus))
+
+	rtestutils.AssertResources(suite.Ctx(), suite.T(), suite.State(), []string{netctrl.IngressChainName},
+		func(chain *network.NfTablesChain, asrt *assert.Assertions) {
+			spec := chain.TypedSpec()
+
+			// lo, conntrack, icmp, icmpv6, monitoring accept, prometheus accept, monitoring drop, prometheus drop
+			if !asrt.Len(spec.Rules, 8) {
+				return
+			}
+
+			// both subnets are accepted before the port is dropped for other addresses
+			for i, subnet := range []string{"10.0.0.0/8", "192.168.0.0/16"} {
+				asrt.Equal([]netip.Prefix{netip.MustParsePrefix(subnet)}, spec.Rules[4+i].MatchSourceAddress.IncludeSubnets)
+				asrt.Equal(pointer.To(nethelpers.VerdictAccept), spec.Rules[4+i].Verdict)
+			}
+
+			for _, rule := range spec.Rules[6:] {
+				asrt.Nil(rule.MatchSourceAddress)
+				asrt.Equal([]network.PortRange{{Lo: 9100, Hi: 9100}}, rule.MatchLayer4.MatchDestinationPort.Ranges)
+				asrt.Equal(pointer.To(nethelpers.VerdictDrop), rule.Verdict)
+			}
+		})
+}
+
+func TestNfTablesChainConfigSuite(t *testing.T) {
+	suite.Run(t, &NfTablesChainConfigSuite{
+		DefaultSuite: ctest.DefaultSuite{
+			Timeout: 5 * time.Second,
+			AfterSetup: func(suite *ctest.DefaultSuite) {
+				suite.Require().NoError(suite.Runtime().RegisterController(&netctrl.NfTablesChainConfigController{}))
+			},
+		},
+	})
+}
@@ -0,0 +1,156 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package network_test
+
+import (
+	"fmt"
+	"net/netip"
+	"testing"
+	"time"
+
+	"github.com/google/nftables"
+	"github.com/siderolabs/go-pointer"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/siderolabs/talos/internal/app/machined/pkg/controllers/ctest"
+	netctrl "github.com/siderolabs/talos/internal/app/machined/pkg/controllers/network"
+	"github.com/siderolabs/talos/pkg/machinery/nethelpers"
+	"github.com/siderolabs/talos/pkg/machinery/resources/network"
+)
+
+// use a different table name to avoid conflicts with the running firewall.
+const testTableName = "talos_test"
+
+type NfTablesChainSuite struct {
+	ctest.DefaultSuite
+}
+
+func (suite *NfTablesChainSuite) assertRules(expected int) {
+	suite.AssertWithin(3*time.Second, 100*time.Millisecond, ctest.WrapRetry(func(assert *assert.Assertions, _ *require.Assertions) {
+		c := &nftables.Conn{}
+
+		tables, err := c.ListTables()
+		if !assert.NoError(err) {
+			return
+		}
+
+		var table *nftables.Table
+
+		for _, t := range tables {
+			if t.Name == testTableName && t.Family == nftables.TableFamilyINet {
+				table = t
+			}
+		}
+
+		if expected == 0 {
+			assert.Nil(table)
+
+			return
+		}
+
+		if !assert.NotNil(table) {
+			return
+		}
+
+		rules, err := c.GetRules(table, &nftables.Chain{Name: "test1", Table: table})
+		if !assert.NoError(err) {
+			return
+		}
+
+		assert.Len(rules, expected)
+	}))
+}
+
+func (suite *NfTablesChainSuite) TestRender() {
+	// the chain doesn't filter anything, as it's attached to the prerouting hook with the accept policy
+	chain := network.NewNfTablesChain(network.NamespaceName, "test1")
+	chain.TypedSpec().Hook = nethelpers.ChainHookPrerouting
+	chain.TypedSpec().Priority = 0
+	chain.TypedSpec().Policy = nethelpers.VerdictAccept
+	chain.TypedSpec().Rules = []network.NfTablesRule{
+		{
+			MatchIIfName: "lo",
+			Verdict:      pointer.To(nethelpers.VerdictAccept),
+		},
+		{
+			MatchConntrackState: &network.NfTablesConntrackStateMatch{
+				States: []nethelpers.ConntrackState{nethelpers.ConntrackStateEstablished, nethelpers.ConntrackStateRelated},
+			},
+			Verdict: pointer.To(nethelpers.VerdictAccept),
+		},
+		{
+			// split into IPv4 and IPv6 rules
+			MatchSourceAddress: &network.NfTablesAddressMatch{
+				IncludeSubnets: []netip.Prefix{
+					netip.MustParsePrefix("10.0.0.0/8"),
+					netip.MustParsePrefix("0.0.0.0/0"),
+					netip.MustParsePrefix("fd00::/8"),
+				},
+				ExcludeSubnets: []netip.Prefix{netip.MustParsePrefix("10.10.0.0/16")},
+			},
+			MatchLayer4: &network.NfTablesLayer4Match{
+				Protocol: nethelpers.ProtocolTCP,
+				MatchDestinationPort: &network.NfTablesPortMatch{
+					Ranges: []network.PortRange{{Lo: 80, Hi: 80}, {Lo: 8080, Hi: 8090}, {Lo: 8085, Hi: 65535}},
+				},
+			},
+			Verdict: pointer.To(nethelpers.VerdictAccept),
+		},
+		{
+			MatchLayer4: &network.NfTablesLayer4Match{
+				Protocol: nethelpers.ProtocolUDP,
+				MatchDestinationPort: &network.NfTablesPortMatch{
+					Ranges: []network.PortRange{{Lo: 4789, Hi: 4789}},
+				},
+			},
+			Verdict: pointer.To(nethelpers.VerdictDrop),
+		},
+	}
+
+	suite.Require().NoError(suite.State().Create(suite.Ctx(), chain))
+
+	suite.assertRules(5)
+
+	chain.TypedSpec().Rules = chain.TypedSpec().Rules[:2]
+	suite.Require().NoError(suite.State().Update(suite.Ctx(), chain))
+
+	suite.assertRules(2)
+
+	suite.Require().NoError(suite.State().Destroy(suite.Ctx(), chain.Metadata()))
+
+	suite.assertRules(0)
+}
+
+func (suite *NfTablesChainSuite) TearDownTest() {
+	suite.DefaultSuite.TearDownTest()
+
+	c := &nftables.Conn{}
+
+	tables, err := c.ListTables()
+	suite.Require().NoError(err)
+
+	for _, table := range tables {
+		if table.Name == testTableName {
+			c.DelTable(table)
+
+			suite.Require().NoError(c.Flush(), fmt.Sprintf("error cleaning up table %q", testTableName))
+		}
+	}
+}
+
+func TestNfTablesChainSuite(t *testing.T) {
+	suite.Run(t, &NfTablesChainSuite{
+		DefaultSuite: ctest.DefaultSuite{
+			Timeout: 10 * time.Second,
+			AfterSetup: func(suite *ctest.DefaultSuite) {
+				suite.Require().NoError(suite.Runtime().RegisterController(&netctrl.NfTablesChainController{
+					TableName: testTableName,
+				}))
+			},
+		},
+	})
+}
@@ -276,7 +276,7 @@ func (ctrl *RouteConfigController) processDevicesConfiguration(logger *zap.Logge
 			route.Family = nethelpers.FamilyInet4
 		}
 
-		route.Table = nethelpers.TableMain
+		route.Table = in.Table()
 		route.Protocol = nethelpers.ProtocolStatic
 		route.OutLinkName = linkName
 		route.ConfigLayer = network.ConfigMachineConfiguration
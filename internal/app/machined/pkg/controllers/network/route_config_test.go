@@ -209,6 +209,11 @@ func (suite *RouteConfigSuite) TestMachineConfiguration() {
 									RouteGateway: "192.244.0.1",
 									RouteSource:  "192.244.0.10",
 								},
+								{
+									RouteNetwork: "0.0.0.0/0",
+									RouteGateway: "192.244.0.1",
+									RouteTable:   100,
+								},
 							},
 						},
 					},
@@ -233,6 +238,7 @@ func (suite *RouteConfigSuite) TestMachineConfiguration() {
 			"configuration/inet4/192.168.0.25/192.168.0.0/18/25",
 			"configuration/inet4/192.244.0.1/192.244.0.0/24/1024",
 			"configuration/inet4//169.254.254.254/32/1024",
+			"configuration/RoutingTable(100)/inet4/192.244.0.1//1024",
 		}, func(r *network.RouteSpec, asrt *assert.Assertions) {
 			switch r.Metadata().ID() {
 			case "configuration/inet6/2001:470:6d:30e:8ed2:b60c:9d2f:803b//1024":
@@ -258,6 +264,9 @@ func (suite *RouteConfigSuite) TestMachineConfiguration() {
 				asrt.EqualValues(netctrl.DefaultRouteMetric, r.TypedSpec().Priority)
 				asrt.Equal(nethelpers.ScopeLink, r.TypedSpec().Scope)
 				asrt.Equal("169.254.254.254/32", r.TypedSpec().Destination.String())
+			case "configuration/RoutingTable(100)/inet4/192.244.0.1//1024":
+				asrt.Equal("eth1", r.TypedSpec().OutLinkName)
+				asrt.EqualValues(100, r.TypedSpec().Table)
 			}
 
 			asrt.Equal(network.ConfigMachineConfiguration, r.TypedSpec().ConfigLayer)
@@ -0,0 +1,136 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package network
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/cosi-project/runtime/pkg/controller"
+	"github.com/cosi-project/runtime/pkg/resource"
+	"github.com/cosi-project/runtime/pkg/safe"
+	"github.com/cosi-project/runtime/pkg/state"
+	"github.com/siderolabs/go-pointer"
+	"go.uber.org/zap"
+
+	talosconfig "github.com/siderolabs/talos/pkg/machinery/config"
+	"github.com/siderolabs/talos/pkg/machinery/nethelpers"
+	"github.com/siderolabs/talos/pkg/machinery/resources/config"
+	"github.com/siderolabs/talos/pkg/machinery/resources/network"
+)
+
+// RoutingRuleConfigController manages network.RoutingRuleSpec based on machine configuration.
+type RoutingRuleConfigController struct{}
+
+// Name implements controller.Controller interface.
+func (ctrl *RoutingRuleConfigController) Name() string {
+	return "network.RoutingRuleConfigController"
+}
+
+// Inputs implements controller.Controller interface.
+func (ctrl *RoutingRuleConfigController) Inputs() []controller.Input {
+	return []controller.Input{
+		{
+			Namespace: config.NamespaceName,
+			Type:      config.MachineConfigType,
+			ID:        pointer.To(config.V1Alpha1ID),
+			Kind:      controller.InputWeak,
+		},
+	}
+}
+
+// Outputs implements controller.Controller interface.
+func (ctrl *RoutingRuleConfigController) Outputs() []controller.Output {
+	return []controller.Output{
+		{
+			Type: network.RoutingRuleSpecType,
+			Kind: controller.OutputShared,
+		},
+	}
+}
+
+// Run implements controller.Controller interface.
+func (ctrl *RoutingRuleConfigController) Run(ctx context.Context, r controller.Runtime, logger *zap.Logger) error {
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-r.EventCh():
+		}
+
+		cfg, err := safe.ReaderGet[*config.MachineConfig](ctx, r, resource.NewMetadata(config.NamespaceName, config.MachineConfigType, config.V1Alpha1ID, resource.VersionUndefined))
+		if err != nil && !state.IsNotFoundError(err) {
+			return fmt.Errorf("error getting config: %w", err)
+		}
+
+		touchedIDs := map[resource.ID]struct{}{}
+
+		if cfg != nil {
+			for _, rule := range ctrl.processRulesConfiguration(cfg.Config().Machine().Network().RoutingRules()) {
+				rule := rule
+				id := network.LayeredID(rule.ConfigLayer, network.RoutingRuleID(rule.Family, rule.Priority))
+
+				if err = safe.WriterModify(ctx, r, network.NewRoutingRuleSpec(network.ConfigNamespaceName, id), func(res *network.RoutingRuleSpec) error {
+					*res.TypedSpec() = rule
+
+					return nil
+				}); err != nil {
+					return fmt.Errorf("error updating routing rule spec: %w", err)
+				}
+
+				touchedIDs[id] = struct{}{}
+			}
+		}
+
+		// list routing rules for cleanup
+		list, err := safe.ReaderList[*network.RoutingRuleSpec](ctx, r, resource.NewMetadata(network.ConfigNamespaceName, network.RoutingRuleSpecType, "", resource.VersionUndefined))
+		if err != nil {
+			return fmt.Errorf("error listing routing rule specs: %w", err)
+		}
+
+		for iter := safe.IteratorFromList(list); iter.Next(); {
+			if iter.Value().Metadata().Owner() != ctrl.Name() {
+				// skip specs created by other controllers
+				continue
+			}
+
+			if _, ok := touchedIDs[iter.Value().Metadata().ID()]; !ok {
+				if err = r.Destroy(ctx, iter.Value().Metadata()); err != nil {
+					return fmt.Errorf("error cleaning up routing rule specs: %w", err)
+				}
+			}
+		}
+
+		r.ResetRestartBackoff()
+	}
+}
+
+// processRulesConfiguration converts the machine configuration rules to the specs, one per address family.
+func (ctrl *RoutingRuleConfigController) processRulesConfiguration(rules []talosconfig.RoutingRule) []network.RoutingRuleSpecSpec {
+	var specs []network.RoutingRuleSpecSpec
+
+	for _, rule := range rules {
+		spec := network.RoutingRuleSpecSpec{
+			Source:      rule.Source(),
+			Destination: rule.Destination(),
+			IIFName:     rule.IIF(),
+			OIFName:     rule.OIF(),
+			FwMark:      rule.FwMark(),
+			FwMask:      rule.FwMask(),
+			Table:       rule.Table(),
+			Priority:    rule.Priority(),
+			Protocol:    nethelpers.ProtocolStatic,
+			ConfigLayer: network.ConfigMachineConfiguration,
+		}
+
+		for _, family := range rule.Families() {
+			spec.Family = family
+
+			specs = append(specs, spec)
+		}
+	}
+
+	return specs
+}
@@ -0,0 +1,102 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package network_test
+
+import (
+	"math"
+	"net/netip"
+	"testing"
+	"time"
+
+	"github.com/cosi-project/runtime/pkg/resource/rtestutils"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/siderolabs/talos/internal/app/machined/pkg/controllers/ctest"
+	netctrl "github.com/siderolabs/talos/internal/app/machined/pkg/controllers/network"
+	"github.com/siderolabs/talos/pkg/machinery/config/types/v1alpha1"
+	"github.com/siderolabs/talos/pkg/machinery/nethelpers"
+	"github.com/siderolabs/talos/pkg/machinery/resources/config"
+	"github.com/siderolabs/talos/pkg/machinery/resources/network"
+)
+
+type RoutingRuleConfigSuite struct {
+	ctest.DefaultSuite
+}
+
+func (suite *RoutingRuleConfigSuite) TestConfig() {
+	cfg := config.NewMachineConfig(&v1alpha1.Config{
+		ConfigVersion: "v1alpha1",
+		MachineConfig: &v1alpha1.MachineConfig{
+			MachineNetwork: &v1alpha1.NetworkConfig{
+				NetworkRoutingRules: []*v1alpha1.RoutingRuleConfig{
+					{
+						RoutingRuleSource:   "10.10.0.5/24",
+						RoutingRuleTable:    100,
+						RoutingRulePriority: 1000,
+					},
+					{
+						RoutingRuleIIF:      "eth1",
+						RoutingRuleFwMark:   0x10,
+						RoutingRuleTable:    200,
+						RoutingRulePriority: 1100,
+					},
+				},
+			},
+		},
+	})
+
+	suite.Require().NoError(suite.State().Create(suite.Ctx(), cfg))
+
+	rtestutils.AssertResources(suite.Ctx(), suite.T(), suite.State(), []string{"configuration/inet4/01000"},
+		func(r *network.RoutingRuleSpec, asrt *assert.Assertions) {
+			asrt.Equal(network.RoutingRuleSpecSpec{
+				Family:      nethelpers.FamilyInet4,
+				Source:      netip.MustParsePrefix("10.10.0.0/24"),
+				Table:       100,
+				Priority:    1000,
+				Protocol:    nethelpers.ProtocolStatic,
+				ConfigLayer: network.ConfigMachineConfiguration,
+			}, *r.TypedSpec())
+		},
+		rtestutils.WithNamespace(network.ConfigNamespaceName),
+	)
+
+	// the rule without addresses is created for both families
+	rtestutils.AssertResources(suite.Ctx(), suite.T(), suite.State(), []string{"configuration/inet4/01100", "configuration/inet6/01100"},
+		func(r *network.RoutingRuleSpec, asrt *assert.Assertions) {
+			asrt.Equal("eth1", r.TypedSpec().IIFName)
+			asrt.EqualValues(0x10, r.TypedSpec().FwMark)
+			asrt.EqualValues(math.MaxUint32, r.TypedSpec().FwMask)
+			asrt.EqualValues(200, r.TypedSpec().Table)
+		},
+		rtestutils.WithNamespace(network.ConfigNamespaceName),
+	)
+
+	cfg.Config().(*v1alpha1.Config).MachineConfig.MachineNetwork.NetworkRoutingRules = cfg.Config().(*v1alpha1.Config).MachineConfig.MachineNetwork.NetworkRoutingRules[:1]
+	suite.Require().NoError(suite.State().Update(suite.Ctx(), cfg))
+
+	rtestutils.AssertNoResource[*network.RoutingRuleSpec](suite.Ctx(), suite.T(), suite.State(), "configuration/inet4/01100",
+		rtestutils.WithNamespace(network.ConfigNamespaceName),
+	)
+	rtestutils.AssertNoResource[*network.RoutingRuleSpec](suite.Ctx(), suite.T(), suite.State(), "configuration/inet6/01100",
+		rtestutils.WithNamespace(network.ConfigNamespaceName),
+	)
+	rtestutils.AssertResources(suite.Ctx(), suite.T(), suite.State(), []string{"configuration/inet4/01000"},
+		func(r *network.RoutingRuleSpec, asrt *assert.Assertions) {},
+		rtestutils.WithNamespace(network.ConfigNamespaceName),
+	)
+}
+
+func TestRoutingRuleConfigSuite(t *testing.T) {
+	suite.Run(t, &RoutingRuleConfigSuite{
+		DefaultSuite: ctest.DefaultSuite{
+			Timeout: 5 * time.Second,
+			AfterSetup: func(suite *ctest.DefaultSuite) {
+				suite.Require().NoError(suite.Runtime().RegisterController(&netctrl.RoutingRuleConfigController{}))
+			},
+		},
+	})
+}
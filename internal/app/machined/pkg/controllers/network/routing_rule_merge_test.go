@@ -0,0 +1,94 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package network_test
+
+import (
+	"net/netip"
+	"testing"
+	"time"
+
+	"github.com/cosi-project/runtime/pkg/resource/rtestutils"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/siderolabs/talos/internal/app/machined/pkg/controllers/ctest"
+	netctrl "github.com/siderolabs/talos/internal/app/machined/pkg/controllers/network"
+	"github.com/siderolabs/talos/pkg/machinery/nethelpers"
+	"github.com/siderolabs/talos/pkg/machinery/resources/network"
+)
+
+type RoutingRuleMergeSuite struct {
+	ctest.DefaultSuite
+}
+
+func (suite *RoutingRuleMergeSuite) TestMerge() {
+	platform := network.NewRoutingRuleSpec(network.ConfigNamespaceName, "platform/inet4/01000")
+	*platform.TypedSpec() = network.RoutingRuleSpecSpec{
+		Family:      nethelpers.FamilyInet4,
+		Source:      netip.MustParsePrefix("10.10.0.0/24"),
+		Table:       nethelpers.TableMain,
+		Priority:    1000,
+		Protocol:    nethelpers.ProtocolStatic,
+		ConfigLayer: network.ConfigPlatform,
+	}
+
+	machineConfig := network.NewRoutingRuleSpec(network.ConfigNamespaceName, "configuration/inet4/01000")
+	*machineConfig.TypedSpec() = network.RoutingRuleSpecSpec{
+		Family:      nethelpers.FamilyInet4,
+		Source:      netip.MustParsePrefix("10.20.0.0/24"),
+		Table:       nethelpers.TableDefault,
+		Priority:    1000,
+		Protocol:    nethelpers.ProtocolStatic,
+		ConfigLayer: network.ConfigMachineConfiguration,
+	}
+
+	other := network.NewRoutingRuleSpec(network.ConfigNamespaceName, "platform/inet6/01000")
+	*other.TypedSpec() = network.RoutingRuleSpecSpec{
+		Family:      nethelpers.FamilyInet6,
+		Table:       nethelpers.TableMain,
+		Priority:    1000,
+		Protocol:    nethelpers.ProtocolStatic,
+		ConfigLayer: network.ConfigPlatform,
+	}
+
+	for _, res := range []*network.RoutingRuleSpec{platform, machineConfig, other} {
+		suite.Require().NoError(suite.State().Create(suite.Ctx(), res))
+	}
+
+	// machine configuration takes precedence over the platform for the same priority
+	rtestutils.AssertResources(suite.Ctx(), suite.T(), suite.State(), []string{"inet4/01000"},
+		func(r *network.RoutingRuleSpec, asrt *assert.Assertions) {
+			asrt.Equal(*machineConfig.TypedSpec(), *r.TypedSpec())
+		},
+	)
+	rtestutils.AssertResources(suite.Ctx(), suite.T(), suite.State(), []string{"inet6/01000"},
+		func(r *network.RoutingRuleSpec, asrt *assert.Assertions) {
+			asrt.Equal(*other.TypedSpec(), *r.TypedSpec())
+		},
+	)
+
+	suite.Require().NoError(suite.State().Destroy(suite.Ctx(), machineConfig.Metadata()))
+
+	rtestutils.AssertResources(suite.Ctx(), suite.T(), suite.State(), []string{"inet4/01000"},
+		func(r *network.RoutingRuleSpec, asrt *assert.Assertions) {
+			asrt.Equal(*platform.TypedSpec(), *r.TypedSpec())
+		},
+	)
+
+	suite.Require().NoError(suite.State().Destroy(suite.Ctx(), other.Metadata()))
+
+	rtestutils.AssertNoResource[*network.RoutingRuleSpec](suite.Ctx(), suite.T(), suite.State(), "inet6/01000")
+}
+
+func TestRoutingRuleMergeSuite(t *testing.T) {
+	suite.Run(t, &RoutingRuleMergeSuite{
+		DefaultSuite: ctest.DefaultSuite{
+			Timeout: 5 * time.Second,
+			AfterSetup: func(suite *ctest.DefaultSuite) {
+				suite.Require().NoError(suite.Runtime().RegisterController(&netctrl.RoutingRuleMergeController{}))
+			},
+		},
+	})
+}
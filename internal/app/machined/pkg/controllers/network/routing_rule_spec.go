@@ -0,0 +1,254 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package network
+
+import (
+	"context"
+	"fmt"
+	"net"
+
+	"github.com/cosi-project/runtime/pkg/controller"
+	"github.com/cosi-project/runtime/pkg/resource"
+	"github.com/cosi-project/runtime/pkg/safe"
+	"github.com/hashicorp/go-multierror"
+	"github.com/jsimonetti/rtnetlink"
+	"go.uber.org/zap"
+	"golang.org/x/sys/unix"
+
+	"github.com/siderolabs/talos/internal/app/machined/pkg/controllers/network/watch"
+	"github.com/siderolabs/talos/pkg/machinery/nethelpers"
+	"github.com/siderolabs/talos/pkg/machinery/resources/network"
+)
+
+// RoutingRuleSpecController applies network.RoutingRuleSpec to the kernel.
+type RoutingRuleSpecController struct{}
+
+// Name implements controller.Controller interface.
+func (ctrl *RoutingRuleSpecController) Name() string {
+	return "network.RoutingRuleSpecController"
+}
+
+// Inputs implements controller.Controller interface.
+func (ctrl *RoutingRuleSpecController) Inputs() []controller.Input {
+	return []controller.Input{
+		{
+			Namespace: network.NamespaceName,
+			Type:      network.RoutingRuleSpecType,
+			Kind:      controller.InputStrong,
+		},
+	}
+}
+
+// Outputs implements controller.Controller interface.
+func (ctrl *RoutingRuleSpecController) Outputs() []controller.Output {
+	return nil
+}
+
+// Run implements controller.Controller interface.
+func (ctrl *RoutingRuleSpecController) Run(ctx context.Context, r controller.Runtime, logger *zap.Logger) error {
+	// watch rule changes to restore the rules removed outside of the controller
+	watcher, err := watch.NewRtNetlink(r, unix.RTMGRP_IPV4_RULE|1<<(unix.RTNLGRP_IPV6_RULE-1))
+	if err != nil {
+		return err
+	}
+
+	defer watcher.Done()
+
+	conn, err := rtnetlink.Dial(nil)
+	if err != nil {
+		return fmt.Errorf("error dialing rtnetlink socket: %w", err)
+	}
+
+	defer conn.Close() //nolint:errcheck
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-r.EventCh():
+		}
+
+		list, err := safe.ReaderList[*network.RoutingRuleSpec](ctx, r, resource.NewMetadata(network.NamespaceName, network.RoutingRuleSpecType, "", resource.VersionUndefined))
+		if err != nil {
+			return fmt.Errorf("error listing routing rule specs: %w", err)
+		}
+
+		// add finalizers for all live resources
+		for iter := safe.IteratorFromList(list); iter.Next(); {
+			if iter.Value().Metadata().Phase() != resource.PhaseRunning {
+				continue
+			}
+
+			if err = r.AddFinalizer(ctx, iter.Value().Metadata(), ctrl.Name()); err != nil {
+				return fmt.Errorf("error adding finalizer: %w", err)
+			}
+		}
+
+		rules, err := conn.Rule.List()
+		if err != nil {
+			return fmt.Errorf("error listing routing rules: %w", err)
+		}
+
+		var multiErr *multierror.Error
+
+		for iter := safe.IteratorFromList(list); iter.Next(); {
+			if err = ctrl.syncRule(ctx, r, logger, conn, rules, iter.Value()); err != nil {
+				multiErr = multierror.Append(multiErr, err)
+			}
+		}
+
+		if err = multiErr.ErrorOrNil(); err != nil {
+			return err
+		}
+
+		r.ResetRestartBackoff()
+	}
+}
+
+// findRules returns the rules installed by the spec controller with the same family and priority.
+//
+// The rules with other protocols (e.g. KubeSpan rules) are never touched.
+func findRules(rules []rtnetlink.RuleMessage, family nethelpers.Family, priority uint32, protocol nethelpers.RouteProtocol) []*rtnetlink.RuleMessage {
+	var result []*rtnetlink.RuleMessage //nolint:prealloc
+
+	for i := range rules {
+		status := routingRuleStatus(&rules[i])
+
+		if status.Family != family || status.Priority != priority || status.Protocol != protocol {
+			continue
+		}
+
+		result = append(result, &rules[i])
+	}
+
+	return result
+}
+
+func (ctrl *RoutingRuleSpecController) syncRule(ctx context.Context, r controller.Runtime, logger *zap.Logger, conn *rtnetlink.Conn,
+	rules []rtnetlink.RuleMessage, rule *network.RoutingRuleSpec,
+) error {
+	spec := rule.TypedSpec()
+
+	switch rule.Metadata().Phase() {
+	case resource.PhaseTearingDown:
+		for _, existing := range findRules(rules, spec.Family, spec.Priority, spec.Protocol) {
+			if err := conn.Rule.Delete(existing); err != nil {
+				return fmt.Errorf("error removing routing rule: %w", err)
+			}
+
+			logger.Info("deleted routing rule",
+				zap.Stringer("family", spec.Family),
+				zap.Uint32("priority", spec.Priority),
+				zap.Stringer("table", spec.Table),
+			)
+		}
+
+		// now remove finalizer as rule was deleted
+		if err := r.RemoveFinalizer(ctx, rule.Metadata(), ctrl.Name()); err != nil {
+			return fmt.Errorf("error removing finalizer: %w", err)
+		}
+	case resource.PhaseRunning:
+		matchFound := false
+
+		for _, existing := range findRules(rules, spec.Family, spec.Priority, spec.Protocol) {
+			status := routingRuleStatus(existing)
+
+			// check if existing rule matches the spec: if it does, skip update
+			if status.Source == spec.Source && status.Destination == spec.Destination &&
+				status.IIFName == spec.IIFName && status.OIFName == spec.OIFName &&
+				status.FwMark == spec.FwMark && status.FwMask == spec.FwMask &&
+				status.Table == spec.Table {
+				matchFound = true
+
+				continue
+			}
+
+			// delete the rule, it doesn't match the spec
+			if err := conn.Rule.Delete(existing); err != nil {
+				return fmt.Errorf("error removing routing rule: %w", err)
+			}
+
+			logger.Debug("removed routing rule due to mismatch",
+				zap.Stringer("family", spec.Family),
+				zap.Uint32("priority", spec.Priority),
+				zap.Stringer("old_table", status.Table),
+				zap.Stringer("new_table", spec.Table),
+			)
+		}
+
+		if matchFound {
+			return nil
+		}
+
+		if err := conn.Rule.Add(routingRuleMessage(spec)); err != nil {
+			return fmt.Errorf("error adding routing rule with priority %d: %w", spec.Priority, err)
+		}
+
+		logger.Info("created routing rule",
+			zap.Stringer("family", spec.Family),
+			zap.Uint32("priority", spec.Priority),
+			zap.Stringer("source", spec.Source),
+			zap.Stringer("destination", spec.Destination),
+			zap.Stringer("table", spec.Table),
+		)
+	}
+
+	return nil
+}
+
+// routingRuleMessage builds the rtnetlink message for the rule looking up the table.
+func routingRuleMessage(spec *network.RoutingRuleSpecSpec) *rtnetlink.RuleMessage {
+	table := uint32(spec.Table)
+	priority := spec.Priority
+	protocol := uint8(spec.Protocol)
+
+	msg := &rtnetlink.RuleMessage{
+		Family: uint8(spec.Family),
+		Action: unix.FR_ACT_TO_TBL,
+		Attributes: &rtnetlink.RuleAttributes{
+			Table:    &table,
+			Priority: &priority,
+			Protocol: &protocol,
+		},
+	}
+
+	// tables above 255 are passed only as the attribute
+	if table <= unix.RT_TABLE_LOCAL {
+		msg.Table = uint8(table)
+	}
+
+	if spec.Source.IsValid() {
+		src := net.IP(spec.Source.Addr().AsSlice())
+
+		msg.SrcLength = uint8(spec.Source.Bits())
+		msg.Attributes.Src = &src
+	}
+
+	if spec.Destination.IsValid() {
+		dst := net.IP(spec.Destination.Addr().AsSlice())
+
+		msg.DstLength = uint8(spec.Destination.Bits())
+		msg.Attributes.Dst = &dst
+	}
+
+	if spec.IIFName != "" {
+		iifName := spec.IIFName
+		msg.Attributes.IIFName = &iifName
+	}
+
+	if spec.OIFName != "" {
+		oifName := spec.OIFName
+		msg.Attributes.OIFName = &oifName
+	}
+
+	if spec.FwMark != 0 || spec.FwMask != 0 {
+		fwMark, fwMask := spec.FwMark, spec.FwMask
+
+		msg.Attributes.FwMark = &fwMark
+		msg.Attributes.FwMask = &fwMask
+	}
+
+	return msg
+}
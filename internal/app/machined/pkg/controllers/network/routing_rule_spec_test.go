@@ -0,0 +1,131 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package network_test
+
+import (
+	"net"
+	"net/netip"
+	"testing"
+	"time"
+
+	"github.com/jsimonetti/rtnetlink"
+	"github.com/siderolabs/go-pointer"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/siderolabs/talos/internal/app/machined/pkg/controllers/ctest"
+	netctrl "github.com/siderolabs/talos/internal/app/machined/pkg/controllers/network"
+	"github.com/siderolabs/talos/pkg/machinery/nethelpers"
+	"github.com/siderolabs/talos/pkg/machinery/resources/network"
+)
+
+type RoutingRuleSpecSuite struct {
+	ctest.DefaultSuite
+}
+
+// findKernelRules returns the IPv4 rules with the given priority.
+func findKernelRules(asrt *assert.Assertions, priority uint32) []rtnetlink.RuleMessage {
+	conn, err := rtnetlink.Dial(nil)
+	if !asrt.NoError(err) {
+		return nil
+	}
+
+	defer conn.Close() //nolint:errcheck
+
+	rules, err := conn.Rule.List()
+	if !asrt.NoError(err) {
+		return nil
+	}
+
+	var result []rtnetlink.RuleMessage
+
+	for _, rule := range rules {
+		if rule.Family == uint8(nethelpers.FamilyInet4) && rule.Attributes != nil && rule.Attributes.Priority != nil && *rule.Attributes.Priority == priority {
+			result = append(result, rule)
+		}
+	}
+
+	return result
+}
+
+func (suite *RoutingRuleSpecSuite) TestRule() {
+	// use high priority to avoid interfering with the host rules
+	const priority = 31000
+
+	rule := network.NewRoutingRuleSpec(network.NamespaceName, network.RoutingRuleID(nethelpers.FamilyInet4, priority))
+	*rule.TypedSpec() = network.RoutingRuleSpecSpec{
+		Family:      nethelpers.FamilyInet4,
+		Source:      netip.MustParsePrefix("10.199.0.0/24"),
+		Table:       nethelpers.TableDefault,
+		Priority:    priority,
+		Protocol:    nethelpers.ProtocolStatic,
+		ConfigLayer: network.ConfigMachineConfiguration,
+	}
+
+	suite.Require().NoError(suite.State().Create(suite.Ctx(), rule))
+
+	suite.AssertWithin(3*time.Second, 100*time.Millisecond, ctest.WrapRetry(func(asrt *assert.Assertions, _ *require.Assertions) {
+		rules := findKernelRules(asrt, priority)
+		if !asrt.Len(rules, 1) {
+			return
+		}
+
+		asrt.EqualValues(24, rules[0].SrcLength)
+		asrt.Equal(pointer.To(net.ParseIP("10.199.0.0").To4()), rules[0].Attributes.Src)
+		asrt.Equal(pointer.To(uint32(nethelpers.TableDefault)), rules[0].Attributes.Table)
+	}))
+
+	// update the rule, it should be replaced
+	ctest.UpdateWithConflicts(suite, rule, func(r *network.RoutingRuleSpec) error {
+		r.TypedSpec().Source = netip.Prefix{}
+		r.TypedSpec().IIFName = "lo"
+		r.TypedSpec().FwMark = 0x20
+		r.TypedSpec().FwMask = 0xff
+
+		return nil
+	})
+
+	suite.AssertWithin(3*time.Second, 100*time.Millisecond, ctest.WrapRetry(func(asrt *assert.Assertions, _ *require.Assertions) {
+		rules := findKernelRules(asrt, priority)
+		if !asrt.Len(rules, 1) {
+			return
+		}
+
+		asrt.Nil(rules[0].Attributes.Src)
+		asrt.Equal(pointer.To("lo"), rules[0].Attributes.IIFName)
+		asrt.Equal(pointer.To[uint32](0x20), rules[0].Attributes.FwMark)
+		asrt.Equal(pointer.To[uint32](0xff), rules[0].Attributes.FwMask)
+	}))
+
+	// teardown the rule
+	for {
+		ready, err := suite.State().Teardown(suite.Ctx(), rule.Metadata())
+		suite.Require().NoError(err)
+
+		if ready {
+			break
+		}
+
+		time.Sleep(100 * time.Millisecond)
+	}
+
+	suite.AssertWithin(3*time.Second, 100*time.Millisecond, ctest.WrapRetry(func(asrt *assert.Assertions, _ *require.Assertions) {
+		asrt.Empty(findKernelRules(asrt, priority))
+	}))
+
+	suite.Require().NoError(suite.State().Destroy(suite.Ctx(), rule.Metadata()))
+}
+
+func TestRoutingRuleSpecSuite(t *testing.T) {
+	suite.Run(t, &RoutingRuleSpecSuite{
+		DefaultSuite: ctest.DefaultSuite{
+			Timeout: 10 * time.Second,
+			AfterSetup: func(suite *ctest.DefaultSuite) {
+				suite.Require().NoError(suite.Runtime().RegisterController(&netctrl.RoutingRuleSpecController{}))
+			},
+		},
+	})
+}
@@ -0,0 +1,169 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package network
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"net/netip"
+
+	"github.com/cosi-project/runtime/pkg/controller"
+	"github.com/cosi-project/runtime/pkg/resource"
+	"github.com/cosi-project/runtime/pkg/safe"
+	"github.com/jsimonetti/rtnetlink"
+	"go.uber.org/zap"
+	"golang.org/x/sys/unix"
+
+	"github.com/siderolabs/talos/internal/app/machined/pkg/controllers/network/watch"
+	"github.com/siderolabs/talos/pkg/machinery/nethelpers"
+	"github.com/siderolabs/talos/pkg/machinery/resources/network"
+)
+
+// RoutingRuleStatusController reports policy routing rules installed in the kernel.
+type RoutingRuleStatusController struct{}
+
+// Name implements controller.Controller interface.
+func (ctrl *RoutingRuleStatusController) Name() string {
+	return "network.RoutingRuleStatusController"
+}
+
+// Inputs implements controller.Controller interface.
+func (ctrl *RoutingRuleStatusController) Inputs() []controller.Input {
+	return nil
+}
+
+// Outputs implements controller.Controller interface.
+func (ctrl *RoutingRuleStatusController) Outputs() []controller.Output {
+	return []controller.Output{
+		{
+			Type: network.RoutingRuleStatusType,
+			Kind: controller.OutputExclusive,
+		},
+	}
+}
+
+// Run implements controller.Controller interface.
+func (ctrl *RoutingRuleStatusController) Run(ctx context.Context, r controller.Runtime, logger *zap.Logger) error {
+	watcher, err := watch.NewRtNetlink(r, unix.RTMGRP_IPV4_RULE|1<<(unix.RTNLGRP_IPV6_RULE-1))
+	if err != nil {
+		return err
+	}
+
+	defer watcher.Done()
+
+	conn, err := rtnetlink.Dial(nil)
+	if err != nil {
+		return fmt.Errorf("error dialing rtnetlink socket: %w", err)
+	}
+
+	defer conn.Close() //nolint:errcheck
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-r.EventCh():
+		}
+
+		// list resources for cleanup
+		list, err := safe.ReaderList[*network.RoutingRuleStatus](ctx, r, resource.NewMetadata(network.NamespaceName, network.RoutingRuleStatusType, "", resource.VersionUndefined))
+		if err != nil {
+			return fmt.Errorf("error listing resources: %w", err)
+		}
+
+		itemsToDelete := map[resource.ID]struct{}{}
+
+		for iter := safe.IteratorFromList(list); iter.Next(); {
+			itemsToDelete[iter.Value().Metadata().ID()] = struct{}{}
+		}
+
+		rules, err := conn.Rule.List()
+		if err != nil {
+			return fmt.Errorf("error listing routing rules: %w", err)
+		}
+
+		for i := range rules {
+			rule := routingRuleStatus(&rules[i])
+			id := network.RoutingRuleID(rule.Family, rule.Priority)
+
+			if err = safe.WriterModify(ctx, r, network.NewRoutingRuleStatus(network.NamespaceName, id), func(res *network.RoutingRuleStatus) error {
+				*res.TypedSpec() = rule
+
+				return nil
+			}); err != nil {
+				return fmt.Errorf("error modifying resource: %w", err)
+			}
+
+			delete(itemsToDelete, id)
+		}
+
+		for id := range itemsToDelete {
+			if err = r.Destroy(ctx, resource.NewMetadata(network.NamespaceName, network.RoutingRuleStatusType, id, resource.VersionUndefined)); err != nil {
+				return fmt.Errorf("error deleting routing rule status %q: %w", id, err)
+			}
+		}
+
+		r.ResetRestartBackoff()
+	}
+}
+
+// routingRuleStatus decodes the kernel routing rule.
+func routingRuleStatus(msg *rtnetlink.RuleMessage) network.RoutingRuleStatusSpec {
+	status := network.RoutingRuleStatusSpec{
+		Family: nethelpers.Family(msg.Family),
+		Table:  nethelpers.RoutingTable(msg.Table),
+	}
+
+	attrs := msg.Attributes
+	if attrs == nil {
+		return status
+	}
+
+	decodePrefix := func(ip net.IP, bits uint8) netip.Prefix {
+		addr, _ := netip.AddrFromSlice(ip)
+
+		return netip.PrefixFrom(addr.Unmap(), int(bits))
+	}
+
+	if attrs.Src != nil {
+		status.Source = decodePrefix(*attrs.Src, msg.SrcLength)
+	}
+
+	if attrs.Dst != nil {
+		status.Destination = decodePrefix(*attrs.Dst, msg.DstLength)
+	}
+
+	if attrs.IIFName != nil {
+		status.IIFName = *attrs.IIFName
+	}
+
+	if attrs.OIFName != nil {
+		status.OIFName = *attrs.OIFName
+	}
+
+	if attrs.FwMark != nil {
+		status.FwMark = *attrs.FwMark
+	}
+
+	if attrs.FwMask != nil {
+		status.FwMask = *attrs.FwMask
+	}
+
+	// table attribute carries the full table ID, header only fits up to 255
+	if attrs.Table != nil {
+		status.Table = nethelpers.RoutingTable(*attrs.Table)
+	}
+
+	if attrs.Priority != nil {
+		status.Priority = *attrs.Priority
+	}
+
+	if attrs.Protocol != nil {
+		status.Protocol = nethelpers.RouteProtocol(*attrs.Protocol)
+	}
+
+	return status
+}
@@ -0,0 +1,89 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package network_test
+
+import (
+	"net"
+	"net/netip"
+	"testing"
+	"time"
+
+	"github.com/cosi-project/runtime/pkg/resource/rtestutils"
+	"github.com/jsimonetti/rtnetlink"
+	"github.com/siderolabs/go-pointer"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+	"golang.org/x/sys/unix"
+
+	"github.com/siderolabs/talos/internal/app/machined/pkg/controllers/ctest"
+	netctrl "github.com/siderolabs/talos/internal/app/machined/pkg/controllers/network"
+	"github.com/siderolabs/talos/pkg/machinery/nethelpers"
+	"github.com/siderolabs/talos/pkg/machinery/resources/network"
+)
+
+type RoutingRuleStatusSuite struct {
+	ctest.DefaultSuite
+}
+
+func (suite *RoutingRuleStatusSuite) TestRules() {
+	// the main table rule is always present
+	rtestutils.AssertResources(suite.Ctx(), suite.T(), suite.State(), []string{"inet4/32766"},
+		func(r *network.RoutingRuleStatus, asrt *assert.Assertions) {
+			asrt.Equal(nethelpers.TableMain, r.TypedSpec().Table)
+		},
+	)
+
+	// use high priority to avoid interfering with the host rules
+	const priority = 31001
+
+	conn, err := rtnetlink.Dial(nil)
+	suite.Require().NoError(err)
+
+	defer conn.Close() //nolint:errcheck
+
+	src := net.ParseIP("10.198.0.0").To4()
+	rule := &rtnetlink.RuleMessage{
+		Family:    unix.AF_INET,
+		SrcLength: 16,
+		Table:     uint8(nethelpers.TableDefault),
+		Action:    unix.FR_ACT_TO_TBL,
+		Attributes: &rtnetlink.RuleAttributes{
+			Src:      &src,
+			Priority: pointer.To[uint32](priority),
+			Table:    pointer.To(uint32(nethelpers.TableDefault)),
+			IIFName:  pointer.To("lo"),
+		},
+	}
+
+	suite.Require().NoError(conn.Rule.Add(rule))
+
+	rtestutils.AssertResources(suite.Ctx(), suite.T(), suite.State(), []string{"inet4/31001"},
+		func(r *network.RoutingRuleStatus, asrt *assert.Assertions) {
+			asrt.Equal(network.RoutingRuleStatusSpec{
+				Family:   nethelpers.FamilyInet4,
+				Source:   netip.MustParsePrefix("10.198.0.0/16"),
+				IIFName:  "lo",
+				Table:    nethelpers.TableDefault,
+				Priority: priority,
+				Protocol: nethelpers.ProtocolUnspec,
+			}, *r.TypedSpec())
+		},
+	)
+
+	suite.Require().NoError(conn.Rule.Delete(rule))
+
+	rtestutils.AssertNoResource[*network.RoutingRuleStatus](suite.Ctx(), suite.T(), suite.State(), "inet4/31001")
+}
+
+func TestRoutingRuleStatusSuite(t *testing.T) {
+	suite.Run(t, &RoutingRuleStatusSuite{
+		DefaultSuite: ctest.DefaultSuite{
+			Timeout: 10 * time.Second,
+			AfterSetup: func(suite *ctest.DefaultSuite) {
+				suite.Require().NoError(suite.Runtime().RegisterController(&netctrl.RoutingRuleStatusController{}))
+			},
+		},
+	})
+}
@@ -0,0 +1,284 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package network
+
+import (
+	"context"
+	"fmt"
+	"net/netip"
+	"sort"
+
+	"github.com/cosi-project/runtime/pkg/controller"
+	"github.com/cosi-project/runtime/pkg/resource"
+	"github.com/cosi-project/runtime/pkg/safe"
+	"github.com/cosi-project/runtime/pkg/state"
+	"github.com/siderolabs/go-pointer"
+	"go.uber.org/zap"
+
+	talosconfig "github.com/siderolabs/talos/pkg/machinery/config"
+	"github.com/siderolabs/talos/pkg/machinery/constants"
+	"github.com/siderolabs/talos/pkg/machinery/nethelpers"
+	"github.com/siderolabs/talos/pkg/machinery/resources/cluster"
+	"github.com/siderolabs/talos/pkg/machinery/resources/config"
+	"github.com/siderolabs/talos/pkg/machinery/resources/network"
+)
+
+// Names of the default firewall rules.
+const (
+	FirewallRuleApid          = "apid"
+	FirewallRuleTrustd        = "trustd"
+	FirewallRuleKubeAPIServer = "kube-apiserver"
+	FirewallRuleEtcd          = "etcd"
+	FirewallRuleKubelet       = "kubelet"
+	FirewallRuleKubeSpan      = "kubespan"
+	FirewallRuleCNIVXLAN      = "cni-vxlan"
+)
+
+// flannelVXLANPort is the VXLAN port of the Flannel CNI deployed by Talos.
+const flannelVXLANPort = 4789
+
+// RuleConfigController manages network.RuleConfig based on the firewall configuration.
+//
+// The default rules are derived from the machine type and the cluster members, rules
+// configured in the machine configuration override the default rules with the same name.
+type RuleConfigController struct{}
+
+// Name implements controller.Controller interface.
+func (ctrl *RuleConfigController) Name() string {
+	return "network.RuleConfigController"
+}
+
+// Inputs implements controller.Controller interface.
+func (ctrl *RuleConfigController) Inputs() []controller.Input {
+	return []controller.Input{
+		{
+			Namespace: config.NamespaceName,
+			Type:      config.MachineConfigType,
+			ID:        pointer.To(config.V1Alpha1ID),
+			Kind:      controller.InputWeak,
+		},
+		{
+			Namespace: cluster.NamespaceName,
+			Type:      cluster.MemberType,
+			Kind:      controller.InputWeak,
+		},
+		{
+			Namespace: network.NamespaceName,
+			Type:      network.NodeAddressType,
+			ID:        pointer.To(network.NodeAddressRoutedID),
+			Kind:      controller.InputWeak,
+		},
+	}
+}
+
+// Outputs implements controller.Controller interface.
+func (ctrl *RuleConfigController) Outputs() []controller.Output {
+	return []controller.Output{
+		{
+			Type: network.RuleConfigType,
+			Kind: controller.OutputExclusive,
+		},
+	}
+}
+
+// Run implements controller.Controller interface.
+func (ctrl *RuleConfigController) Run(ctx context.Context, r controller.Runtime, logger *zap.Logger) error {
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-r.EventCh():
+		}
+
+		cfg, err := safe.ReaderGet[*config.MachineConfig](ctx, r, resource.NewMetadata(config.NamespaceName, config.MachineConfigType, config.V1Alpha1ID, resource.VersionUndefined))
+		if err != nil && !state.IsNotFoundError(err) {
+			return fmt.Errorf("error getting config: %w", err)
+		}
+
+		rules := map[string]network.RuleConfigSpec{}
+
+		if cfg != nil && cfg.Config().Machine().Network().Firewall().Enabled() {
+			firewall := cfg.Config().Machine().Network().Firewall()
+
+			if firewall.DefaultRules() {
+				if err = ctrl.defaultRules(ctx, r, cfg.Config(), rules); err != nil {
+					return err
+				}
+			}
+
+			for _, rule := range firewall.Rules() {
+				rules[rule.Name()] = RuleConfigSpecFromConfig(rule)
+			}
+		}
+
+		for name, spec := range rules {
+			spec := spec
+
+			if err = safe.WriterModify(ctx, r, network.NewRuleConfig(network.NamespaceName, name), func(res *network.RuleConfig) error {
+				*res.TypedSpec() = spec
+
+				return nil
+			}); err != nil {
+				return fmt.Errorf("error updating rule config: %w", err)
+			}
+		}
+
+		list, err := safe.ReaderList[*network.RuleConfig](ctx, r, resource.NewMetadata(network.NamespaceName, network.RuleConfigType, "", resource.VersionUndefined))
+		if err != nil {
+			return fmt.Errorf("error listing rule configs: %w", err)
+		}
+
+		for iter := safe.IteratorFromList(list); iter.Next(); {
+			if _, ok := rules[iter.Value().Metadata().ID()]; ok {
+				continue
+			}
+
+			if err = r.Destroy(ctx, iter.Value().Metadata()); err != nil {
+				return fmt.Errorf("error cleaning up rule configs: %w", err)
+			}
+		}
+
+		r.ResetRestartBackoff()
+	}
+}
+
+// defaultRules builds the rules which keep the Talos and Kubernetes services reachable only by the nodes which need them.
+//
+// Cluster members are used as a source of node addresses. If the cluster discovery is disabled (or there are no members yet),
+// the rules for all nodes fall back to the subnets of the local node addresses, while the etcd rule is not created,
+// so that etcd is not exposed to the whole subnet, and the traffic is dropped by the default action.
+//
+//nolint:gocyclo
+func (ctrl *RuleConfigController) defaultRules(ctx context.Context, r controller.Runtime, cfg talosconfig.Provider, rules map[string]network.RuleConfigSpec) error {
+	members, err := safe.ReaderList[*cluster.Member](ctx, r, resource.NewMetadata(cluster.NamespaceName, cluster.MemberType, "", resource.VersionUndefined))
+	if err != nil {
+		return fmt.Errorf("error listing cluster members: %w", err)
+	}
+
+	var allNodes, controlPlaneNodes []netip.Prefix
+
+	for iter := safe.IteratorFromList(members); iter.Next(); {
+		for _, addr := range iter.Value().TypedSpec().Addresses {
+			prefix := netip.PrefixFrom(addr, addr.BitLen())
+
+			allNodes = append(allNodes, prefix)
+
+			if iter.Value().TypedSpec().MachineType.IsControlPlane() {
+				controlPlaneNodes = append(controlPlaneNodes, prefix)
+			}
+		}
+	}
+
+	if len(allNodes) == 0 {
+		nodeAddresses, err := safe.ReaderGet[*network.NodeAddress](ctx, r, resource.NewMetadata(network.NamespaceName, network.NodeAddressType, network.NodeAddressRoutedID, resource.VersionUndefined))
+		if err != nil && !state.IsNotFoundError(err) {
+			return fmt.Errorf("error getting node addresses: %w", err)
+		}
+
+		if nodeAddresses != nil {
+			for _, addr := range nodeAddresses.TypedSpec().Addresses {
+				allNodes = append(allNodes, addr.Masked())
+			}
+		}
+	}
+
+	// if the node addresses are not known yet, the rules below accept the traffic from any address
+	// rather than locking the node out
+	var kubeletSubnets []netip.Prefix
+
+	if len(allNodes) > 0 {
+		kubeletSubnets = append(kubeletSubnets, allNodes...)
+
+		for _, cidr := range cfg.Cluster().Network().PodCIDRs() {
+			if prefix, err := netip.ParsePrefix(cidr); err == nil {
+				kubeletSubnets = append(kubeletSubnets, prefix)
+			}
+		}
+	}
+
+	rules[FirewallRuleApid] = defaultRule(nethelpers.ProtocolTCP, constants.ApidPort, nil)
+	rules[FirewallRuleKubelet] = defaultRule(nethelpers.ProtocolTCP, constants.KubeletPort, kubeletSubnets)
+
+	if cfg.Machine().Network().KubeSpan().Enabled() {
+		rules[FirewallRuleKubeSpan] = defaultRule(nethelpers.ProtocolUDP, constants.KubeSpanDefaultPort, nil)
+	}
+
+	if cfg.Cluster().Network().CNI().Name() == constants.FlannelCNI {
+		rules[FirewallRuleCNIVXLAN] = defaultRule(nethelpers.ProtocolUDP, flannelVXLANPort, allNodes)
+	}
+
+	if cfg.Machine().Type().IsControlPlane() {
+		rules[FirewallRuleTrustd] = defaultRule(nethelpers.ProtocolTCP, constants.TrustdPort, nil)
+		rules[FirewallRuleKubeAPIServer] = defaultRule(nethelpers.ProtocolTCP, cfg.Cluster().LocalAPIServerPort(), nil)
+
+		// fail closed: etcd is never accepted from the unknown addresses
+		if len(controlPlaneNodes) > 0 {
+			etcd := defaultRule(nethelpers.ProtocolTCP, constants.EtcdClientPort, controlPlaneNodes)
+			etcd.PortSelector.Ports[0].Hi = constants.EtcdPeerPort
+
+			rules[FirewallRuleEtcd] = etcd
+		}
+	}
+
+	return nil
+}
+
+// defaultRule builds the rule for a single port accepting the traffic from the subnets.
+func defaultRule(protocol nethelpers.Protocol, port int, subnets []netip.Prefix) network.RuleConfigSpec {
+	spec := network.RuleConfigSpec{
+		PortSelector: network.RulePortSelector{
+			Protocol: protocol,
+			Ports: []network.PortRange{
+				{
+					Lo: uint16(port),
+					Hi: uint16(port),
+				},
+			},
+		},
+	}
+
+	subnets = append([]netip.Prefix(nil), subnets...)
+
+	sort.Slice(subnets, func(i, j int) bool {
+		return subnets[i].Addr().Less(subnets[j].Addr()) || (subnets[i].Addr() == subnets[j].Addr() && subnets[i].Bits() < subnets[j].Bits())
+	})
+
+	for i, subnet := range subnets {
+		if i > 0 && subnet == subnets[i-1] {
+			continue
+		}
+
+		spec.Ingress = append(spec.Ingress, network.IngressRule{
+			Subnet: subnet,
+		})
+	}
+
+	return spec
+}
+
+// RuleConfigSpecFromConfig converts the firewall rule from the machine configuration to the resource spec.
+func RuleConfigSpecFromConfig(rule talosconfig.NetworkRule) network.RuleConfigSpec {
+	spec := network.RuleConfigSpec{
+		PortSelector: network.RulePortSelector{
+			Protocol: rule.Protocol(),
+		},
+	}
+
+	for _, portRange := range rule.Ports() {
+		spec.PortSelector.Ports = append(spec.PortSelector.Ports, network.PortRange{
+			Lo: portRange.Lo,
+			Hi: portRange.Hi,
+		})
+	}
+
+	for _, ingress := range rule.Ingress() {
+		spec.Ingress = append(spec.Ingress, network.IngressRule{
+			Subnet: ingress.Subnet(),
+			Except: ingress.Except(),
+		})
+	}
+
+	return spec
+}
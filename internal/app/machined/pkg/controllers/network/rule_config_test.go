@@ -0,0 +1,218 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package network_test
+
+import (
+	"net/netip"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/cosi-project/runtime/pkg/resource/rtestutils"
+	"github.com/cosi-project/runtime/pkg/state"
+	"github.com/siderolabs/go-pointer"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/siderolabs/talos/internal/app/machined/pkg/controllers/ctest"
+	netctrl "github.com/siderolabs/talos/internal/app/machined/pkg/controllers/network"
+	"github.com/siderolabs/talos/pkg/machinery/config/types/v1alpha1"
+	"github.com/siderolabs/talos/pkg/machinery/config/types/v1alpha1/machine"
+	"github.com/siderolabs/talos/pkg/machinery/nethelpers"
+	"github.com/siderolabs/talos/pkg/machinery/resources/cluster"
+	"github.com/siderolabs/talos/pkg/machinery/resources/config"
+	"github.com/siderolabs/talos/pkg/machinery/resources/network"
+)
+
+type RuleConfigSuite struct {
+	ctest.DefaultSuite
+}
+
+func (suite *RuleConfigSuite) createConfig(machineType string, firewall *v1alpha1.NetworkFirewallConfig) *config.MachineConfig {
+	u, err := url.Parse("https://foo:6443")
+	suite.Require().NoError(err)
+
+	cfg := config.NewMachineConfig(&v1alpha1.Config{
+		ConfigVersion: "v1alpha1",
+		MachineConfig: &v1alpha1.MachineConfig{
+			MachineType: machineType,
+			MachineNetwork: &v1alpha1.NetworkConfig{
+				NetworkFirewall: firewall,
+			},
+		},
+		ClusterConfig: &v1alpha1.ClusterConfig{
+			ControlPlane: &v1alpha1.ControlPlaneConfig{
+				Endpoint: &v1alpha1.Endpoint{
+					URL: u,
+				},
+			},
+		},
+	})
+
+	suite.Require().NoError(suite.State().Create(suite.Ctx(), cfg))
+
+	return cfg
+}
+
+func (suite *RuleConfigSuite) TestDisabled() {
+	suite.createConfig("controlplane", nil)
+
+	suite.Require().NoError(suite.State().Create(suite.Ctx(), network.NewRuleConfig(network.NamespaceName, "stale"),
+		state.WithCreateOwner((&netctrl.RuleConfigController{}).Name())))
+
+	rtestutils.AssertNoResource[*network.RuleConfig](suite.Ctx(), suite.T(), suite.State(), "stale")
+}
+
+func (suite *RuleConfigSuite) TestDefaultRules() {
+	for _, member := range []struct {
+		id          string
+		address     string
+		machineType machine.Type
+	}{
+		{"cp1", "172.20.0.2", machine.TypeControlPlane},
+		{"worker1", "172.20.0.5", machine.TypeWorker},
+	} {
+		res := cluster.NewMember(cluster.NamespaceName, member.id)
+		res.TypedSpec().Addresses = []netip.Addr{netip.MustParseAddr(member.address)}
+		res.TypedSpec().MachineType = member.machineType
+
+		suite.Require().NoError(suite.State().Create(suite.Ctx(), res))
+	}
+
+	cfg := suite.createConfig("controlplane", &v1alpha1.NetworkFirewallConfig{
+		FirewallDefaultAction: "block",
+		FirewallRules: []*v1alpha1.NetworkRule{
+			{
+				RuleName: "node-exporter",
+				RulePortSelector: v1alpha1.NetworkRulePortSelector{
+					PortSelectorPorts:    []string{"9100"},
+					PortSelectorProtocol: "tcp",
+				},
+				RuleIngress: []v1alpha1.NetworkRuleIngress{
+					{
+						IngressSubnet: "10.0.0.0/8",
+						IngressExcept: "10.10.0.0/16",
+					},
+				},
+			},
+			{
+				RuleName: netctrl.FirewallRuleApid,
+				RulePortSelector: v1alpha1.NetworkRulePortSelector{
+					PortSelectorPorts:    []string{"50000"},
+					PortSelectorProtocol: "tcp",
+				},
+				RuleIngress: []v1alpha1.NetworkRuleIngress{
+					{
+						IngressSubnet: "192.168.0.0/16",
+					},
+				},
+			},
+		},
+	})
+
+	rtestutils.AssertResources(suite.Ctx(), suite.T(), suite.State(),
+		[]string{
+			netctrl.FirewallRuleApid,
+			netctrl.FirewallRuleCNIVXLAN,
+			netctrl.FirewallRuleEtcd,
+			netctrl.FirewallRuleKubeAPIServer,
+			netctrl.FirewallRuleKubelet,
+			netctrl.FirewallRuleTrustd,
+			"node-exporter",
+		},
+		func(r *network.RuleConfig, asrt *assert.Assertions) {
+			spec := r.TypedSpec()
+
+			switch r.Metadata().ID() {
+			case netctrl.FirewallRuleApid:
+				asrt.Equal([]network.IngressRule{{Subnet: netip.MustParsePrefix("192.168.0.0/16")}}, spec.Ingress)
+			case netctrl.FirewallRuleEtcd:
+				asrt.Equal(nethelpers.ProtocolTCP, spec.PortSelector.Protocol)
+				asrt.Equal([]network.PortRange{{Lo: 2379, Hi: 2380}}, spec.PortSelector.Ports)
+				asrt.Equal([]network.IngressRule{{Subnet: netip.MustParsePrefix("172.20.0.2/32")}}, spec.Ingress)
+			case netctrl.FirewallRuleKubelet:
+				asrt.Equal([]network.IngressRule{
+					{Subnet: netip.MustParsePrefix("10.244.0.0/16")},
+					{Subnet: netip.MustParsePrefix("172.20.0.2/32")},
+					{Subnet: netip.MustParsePrefix("172.20.0.5/32")},
+				}, spec.Ingress)
+			case netctrl.FirewallRuleKubeAPIServer:
+				asrt.Equal([]network.PortRange{{Lo: 6443, Hi: 6443}}, spec.PortSelector.Ports)
+				asrt.Empty(spec.Ingress)
+			case "node-exporter":
+				asrt.Equal([]network.IngressRule{
+					{
+						Subnet: netip.MustParsePrefix("10.0.0.0/8"),
+						Except: netip.MustParsePrefix("10.10.0.0/16"),
+					},
+				}, spec.Ingress)
+			}
+		})
+
+	// only the configured rules are kept with the default rules disabled
+	cfg.Config().(*v1alpha1.Config).MachineConfig.MachineNetwork.NetworkFirewall.FirewallDisableDefaultRules = pointer.To(true)
+	suite.Require().NoError(suite.State().Update(suite.Ctx(), cfg))
+
+	rtestutils.AssertNoResource[*network.RuleConfig](suite.Ctx(), suite.T(), suite.State(), netctrl.FirewallRuleEtcd)
+	rtestutils.AssertNoResource[*network.RuleConfig](suite.Ctx(), suite.T(), suite.State(), netctrl.FirewallRuleKubelet)
+	rtestutils.AssertResources(suite.Ctx(), suite.T(), suite.State(), []string{netctrl.FirewallRuleApid, "node-exporter"},
+		func(*network.RuleConfig, *assert.Assertions) {})
+}
+
+func (suite *RuleConfigSuite) TestWorkerNoMembers() {
+	addresses := network.NewNodeAddress(network.NamespaceName, network.NodeAddressRoutedID)
+	addresses.TypedSpec().Addresses = []netip.Prefix{netip.MustParsePrefix("192.168.3.10/24")}
+
+	suite.Require().NoError(suite.State().Create(suite.Ctx(), addresses))
+
+	suite.createConfig("worker", &v1alpha1.NetworkFirewallConfig{
+		FirewallDefaultAction: "accept",
+	})
+
+	rtestutils.AssertResources(suite.Ctx(), suite.T(), suite.State(),
+		[]string{netctrl.FirewallRuleApid, netctrl.FirewallRuleCNIVXLAN, netctrl.FirewallRuleKubelet},
+		func(r *network.RuleConfig, asrt *assert.Assertions) {
+			if r.Metadata().ID() == netctrl.FirewallRuleCNIVXLAN {
+				asrt.Equal(nethelpers.ProtocolUDP, r.TypedSpec().PortSelector.Protocol)
+				asrt.Equal([]network.IngressRule{{Subnet: netip.MustParsePrefix("192.168.3.0/24")}}, r.TypedSpec().Ingress)
+			}
+		})
+
+	rtestutils.AssertNoResource[*network.RuleConfig](suite.Ctx(), suite.T(), suite.State(), netctrl.FirewallRuleEtcd)
+	rtestutils.AssertNoResource[*network.RuleConfig](suite.Ctx(), suite.T(), suite.State(), netctrl.FirewallRuleTrustd)
+}
+
+func (suite *RuleConfigSuite) TestControlPlaneNoMembers() {
+	addresses := network.NewNodeAddress(network.NamespaceName, network.NodeAddressRoutedID)
+	addresses.TypedSpec().Addresses = []netip.Prefix{netip.MustParsePrefix("192.168.3.10/24")}
+
+	suite.Require().NoError(suite.State().Create(suite.Ctx(), addresses))
+
+	suite.createConfig("controlplane", &v1alpha1.NetworkFirewallConfig{
+		FirewallDefaultAction: "block",
+	})
+
+	rtestutils.AssertResources(suite.Ctx(), suite.T(), suite.State(),
+		[]string{netctrl.FirewallRuleApid, netctrl.FirewallRuleKubelet, netctrl.FirewallRuleTrustd, netctrl.FirewallRuleKubeAPIServer},
+		func(r *network.RuleConfig, asrt *assert.Assertions) {
+			if r.Metadata().ID() == netctrl.FirewallRuleKubelet {
+				asrt.Contains(r.TypedSpec().Ingress, network.IngressRule{Subnet: netip.MustParsePrefix("192.168.3.0/24")})
+			}
+		})
+
+	// etcd addresses are unknown, the traffic is dropped by the default action
+	rtestutils.AssertNoResource[*network.RuleConfig](suite.Ctx(), suite.T(), suite.State(), netctrl.FirewallRuleEtcd)
+}
+
+func TestRuleConfigSuite(t *testing.T) {
+	suite.Run(t, &RuleConfigSuite{
+		DefaultSuite: ctest.DefaultSuite{
+			Timeout: 5 * time.Second,
+			AfterSetup: func(suite *ctest.DefaultSuite) {
+				suite.Require().NoError(suite.Runtime().RegisterController(&netctrl.RuleConfigController{}))
+			},
+		},
+	})
+}
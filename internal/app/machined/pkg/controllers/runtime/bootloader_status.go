@@ -0,0 +1,85 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package runtime
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/cosi-project/runtime/pkg/controller"
+	"github.com/cosi-project/runtime/pkg/resource"
+	"github.com/cosi-project/runtime/pkg/safe"
+	"github.com/cosi-project/runtime/pkg/state"
+	"github.com/siderolabs/go-pointer"
+	"go.uber.org/zap"
+
+	"github.com/siderolabs/talos/internal/pkg/meta"
+	"github.com/siderolabs/talos/pkg/machinery/resources/runtime"
+)
+
+// BootloaderStatusController publishes the version of U-Boot recorded by the installer in META.
+type BootloaderStatusController struct{}
+
+// Name implements controller.Controller interface.
+func (ctrl *BootloaderStatusController) Name() string {
+	return "runtime.BootloaderStatusController"
+}
+
+// Inputs implements controller.Controller interface.
+func (ctrl *BootloaderStatusController) Inputs() []controller.Input {
+	return []controller.Input{
+		{
+			Namespace: runtime.NamespaceName,
+			Type:      runtime.MetaKeyType,
+			ID:        pointer.To(runtime.MetaKeyTagToID(meta.BootloaderVersion)),
+			Kind:      controller.InputWeak,
+		},
+	}
+}
+
+// Outputs implements controller.Controller interface.
+func (ctrl *BootloaderStatusController) Outputs() []controller.Output {
+	return []controller.Output{
+		{
+			Type: runtime.BootloaderStatusType,
+			Kind: controller.OutputExclusive,
+		},
+	}
+}
+
+// Run implements controller.Controller interface.
+func (ctrl *BootloaderStatusController) Run(ctx context.Context, r controller.Runtime, logger *zap.Logger) error {
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-r.EventCh():
+		}
+
+		version, err := safe.ReaderGet[*runtime.MetaKey](ctx, r, resource.NewMetadata(runtime.NamespaceName, runtime.MetaKeyType, runtime.MetaKeyTagToID(meta.BootloaderVersion), resource.VersionUndefined))
+		if err != nil {
+			if !state.IsNotFoundError(err) {
+				return fmt.Errorf("error getting bootloader version: %w", err)
+			}
+
+			if err = r.Destroy(ctx, runtime.NewBootloaderStatus(runtime.NamespaceName, runtime.BootloaderStatusID).Metadata()); err != nil && !state.IsNotFoundError(err) {
+				return fmt.Errorf("error destroying bootloader status: %w", err)
+			}
+
+			continue
+		}
+
+		if err = safe.WriterModify(ctx, r, runtime.NewBootloaderStatus(runtime.NamespaceName, runtime.BootloaderStatusID), func(res *runtime.BootloaderStatus) error {
+			res.TypedSpec().Name = "u-boot"
+			res.TypedSpec().Version = version.TypedSpec().Value
+
+			return nil
+		}); err != nil {
+			return fmt.Errorf("error updating bootloader status: %w", err)
+		}
+
+		r.ResetRestartBackoff()
+	}
+}
@@ -0,0 +1,68 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package runtime_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/cosi-project/runtime/pkg/resource"
+	"github.com/cosi-project/runtime/pkg/state"
+	"github.com/siderolabs/go-retry/retry"
+	"github.com/stretchr/testify/suite"
+
+	runtimecontrollers "github.com/siderolabs/talos/internal/app/machined/pkg/controllers/runtime"
+	"github.com/siderolabs/talos/internal/pkg/meta"
+	runtimeresource "github.com/siderolabs/talos/pkg/machinery/resources/runtime"
+)
+
+type BootloaderStatusSuite struct {
+	RuntimeSuite
+}
+
+func (suite *BootloaderStatusSuite) TestReconcile() {
+	suite.Require().NoError(suite.runtime.RegisterController(&runtimecontrollers.BootloaderStatusController{}))
+
+	suite.startRuntime()
+
+	key := runtimeresource.NewMetaKey(runtimeresource.NamespaceName, runtimeresource.MetaKeyTagToID(meta.BootloaderVersion))
+	key.TypedSpec().Value = "2023.07-rc3"
+
+	suite.Require().NoError(suite.state.Create(suite.ctx, key))
+
+	statusMD := runtimeresource.NewBootloaderStatus(runtimeresource.NamespaceName, runtimeresource.BootloaderStatusID).Metadata()
+
+	suite.Assert().NoError(retry.Constant(10*time.Second, retry.WithUnits(100*time.Millisecond)).Retry(
+		suite.assertResource(
+			*statusMD,
+			func(res resource.Resource) bool {
+				spec := res.(*runtimeresource.BootloaderStatus).TypedSpec()
+
+				return spec.Name == "u-boot" && spec.Version == "2023.07-rc3"
+			},
+		),
+	))
+
+	suite.Require().NoError(suite.state.Destroy(suite.ctx, key.Metadata()))
+
+	suite.Assert().NoError(retry.Constant(10*time.Second, retry.WithUnits(100*time.Millisecond)).Retry(
+		func() error {
+			_, err := suite.state.Get(suite.ctx, *statusMD)
+			if err == nil {
+				return retry.ExpectedErrorf("bootloader status still exists")
+			}
+
+			if state.IsNotFoundError(err) {
+				return nil
+			}
+
+			return err
+		},
+	))
+}
+
+func TestBootloaderStatusSuite(t *testing.T) {
+	suite.Run(t, new(BootloaderStatusSuite))
+}
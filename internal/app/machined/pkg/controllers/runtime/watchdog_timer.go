@@ -0,0 +1,289 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package runtime
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/cosi-project/runtime/pkg/controller"
+	"github.com/cosi-project/runtime/pkg/resource"
+	"github.com/cosi-project/runtime/pkg/safe"
+	"github.com/cosi-project/runtime/pkg/state"
+	"github.com/siderolabs/go-pointer"
+	"go.uber.org/zap"
+
+	v1alpha1runtime "github.com/siderolabs/talos/internal/app/machined/pkg/runtime"
+	"github.com/siderolabs/talos/internal/pkg/watchdog"
+	"github.com/siderolabs/talos/pkg/machinery/resources/config"
+	"github.com/siderolabs/talos/pkg/machinery/resources/runtime"
+	"github.com/siderolabs/talos/pkg/machinery/resources/v1alpha1"
+)
+
+// watchdogServices are the services which should pass the health checks for the watchdog to be fed.
+var watchdogServices = []string{"apid", "containerd", "machined", "udevd"}
+
+// WatchdogTimer is an armed watchdog timer device.
+type WatchdogTimer interface {
+	SetTimeout(time.Duration) error
+	Feed() error
+	Close() error
+}
+
+// WatchdogTimerController arms the hardware watchdog timer and feeds it while machined is healthy.
+type WatchdogTimerController struct {
+	V1Alpha1Mode v1alpha1runtime.Mode
+
+	// OpenTimer defaults to watchdog.Open.
+	OpenTimer func(device string) (WatchdogTimer, error)
+
+	timer  WatchdogTimer
+	ticker *time.Ticker
+	status runtime.WatchdogTimerStatusSpec
+
+	// unhealthySince records when the watched services started failing the health checks
+	unhealthySince map[string]time.Time
+}
+
+// Name implements controller.Controller interface.
+func (ctrl *WatchdogTimerController) Name() string {
+	return "runtime.WatchdogTimerController"
+}
+
+// Inputs implements controller.Controller interface.
+func (ctrl *WatchdogTimerController) Inputs() []controller.Input {
+	return []controller.Input{
+		{
+			Namespace: config.NamespaceName,
+			Type:      config.MachineConfigType,
+			ID:        pointer.To(config.V1Alpha1ID),
+			Kind:      controller.InputWeak,
+		},
+		{
+			Namespace: runtime.NamespaceName,
+			Type:      runtime.WatchdogTimerDisarmSignalType,
+			ID:        pointer.To(runtime.WatchdogTimerDisarmSignalID),
+			Kind:      controller.InputWeak,
+		},
+		{
+			Namespace: v1alpha1.NamespaceName,
+			Type:      v1alpha1.ServiceType,
+			Kind:      controller.InputWeak,
+		},
+	}
+}
+
+// Outputs implements controller.Controller interface.
+func (ctrl *WatchdogTimerController) Outputs() []controller.Output {
+	return []controller.Output{
+		{
+			Type: runtime.WatchdogTimerStatusType,
+			Kind: controller.OutputExclusive,
+		},
+	}
+}
+
+// Run implements controller.Controller interface.
+//
+//nolint:gocyclo
+func (ctrl *WatchdogTimerController) Run(ctx context.Context, r controller.Runtime, logger *zap.Logger) error {
+	if ctrl.V1Alpha1Mode == v1alpha1runtime.ModeContainer {
+		return nil
+	}
+
+	if ctrl.OpenTimer == nil {
+		ctrl.OpenTimer = func(device string) (WatchdogTimer, error) {
+			return watchdog.Open(device)
+		}
+	}
+
+	defer ctrl.disarm(logger)
+
+	for {
+		var tickerCh <-chan time.Time
+
+		if ctrl.ticker != nil {
+			tickerCh = ctrl.ticker.C
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-r.EventCh():
+		case <-tickerCh:
+			ctrl.feed(ctx, r, logger)
+
+			continue
+		}
+
+		spec, err := ctrl.desiredState(ctx, r)
+		if err != nil {
+			return err
+		}
+
+		if spec == nil {
+			ctrl.disarm(logger)
+
+			if err = r.Destroy(ctx, runtime.NewWatchdogTimerStatus(runtime.NamespaceName, runtime.WatchdogTimerStatusID).Metadata()); err != nil && !state.IsNotFoundError(err) {
+				return fmt.Errorf("error destroying watchdog status: %w", err)
+			}
+
+			continue
+		}
+
+		if ctrl.timer == nil || ctrl.status != *spec {
+			ctrl.disarm(logger)
+
+			if err = ctrl.arm(*spec); err != nil {
+				return err
+			}
+
+			logger.Info("watchdog armed", zap.String("device", spec.Device), zap.Duration("timeout", spec.Timeout))
+		}
+
+		if err = safe.WriterModify(ctx, r, runtime.NewWatchdogTimerStatus(runtime.NamespaceName, runtime.WatchdogTimerStatusID), func(res *runtime.WatchdogTimerStatus) error {
+			*res.TypedSpec() = *spec
+
+			return nil
+		}); err != nil {
+			return fmt.Errorf("error updating watchdog status: %w", err)
+		}
+
+		r.ResetRestartBackoff()
+	}
+}
+
+// desiredState returns the watchdog configuration, or nil if the watchdog should be disarmed.
+//
+// The watchdog is disarmed by the reboot and shutdown sequences via WatchdogTimerDisarmSignal right before the machine
+// goes down, so that the kernel can't be reset by the watchdog while rebooting, and the next kernel doesn't inherit the armed timer.
+func (ctrl *WatchdogTimerController) desiredState(ctx context.Context, r controller.Runtime) (*runtime.WatchdogTimerStatusSpec, error) {
+	cfg, err := safe.ReaderGet[*config.MachineConfig](ctx, r, resource.NewMetadata(config.NamespaceName, config.MachineConfigType, config.V1Alpha1ID, resource.VersionUndefined))
+	if err != nil {
+		if state.IsNotFoundError(err) {
+			return nil, nil
+		}
+
+		return nil, fmt.Errorf("error getting config: %w", err)
+	}
+
+	_, err = safe.ReaderGet[*runtime.WatchdogTimerDisarmSignal](ctx, r,
+		resource.NewMetadata(runtime.NamespaceName, runtime.WatchdogTimerDisarmSignalType, runtime.WatchdogTimerDisarmSignalID, resource.VersionUndefined))
+	if err == nil {
+		return nil, nil
+	}
+
+	if !state.IsNotFoundError(err) {
+		return nil, fmt.Errorf("error getting watchdog disarm signal: %w", err)
+	}
+
+	wd := cfg.Config().Machine().Watchdog()
+	if wd == nil || !wd.Enabled() {
+		return nil, nil
+	}
+
+	return &runtime.WatchdogTimerStatusSpec{
+		Device:       wd.Device(),
+		Timeout:      wd.Timeout(),
+		FeedInterval: wd.Timeout() / 4,
+	}, nil
+}
+
+func (ctrl *WatchdogTimerController) arm(spec runtime.WatchdogTimerStatusSpec) error {
+	timer, err := ctrl.OpenTimer(spec.Device)
+	if err != nil {
+		return err
+	}
+
+	if err = timer.SetTimeout(spec.Timeout); err != nil {
+		timer.Close() //nolint:errcheck
+
+		return err
+	}
+
+	if err = timer.Feed(); err != nil {
+		timer.Close() //nolint:errcheck
+
+		return err
+	}
+
+	ctrl.timer = timer
+	ctrl.ticker = time.NewTicker(spec.FeedInterval)
+	ctrl.status = spec
+	ctrl.unhealthySince = map[string]time.Time{}
+
+	return nil
+}
+
+// feed feeds the watchdog if machined is healthy.
+//
+// machined is healthy if the resource state responds within the feed interval, and the running watchdogServices
+// pass the health checks. A service might stay unhealthy for the watchdog timeout (e.g. while it is restarted),
+// after that the watchdog is not fed, and the machine is reset once the timeout expires.
+func (ctrl *WatchdogTimerController) feed(ctx context.Context, r controller.Runtime, logger *zap.Logger) {
+	if err := ctrl.checkHealth(ctx, r); err != nil {
+		logger.Warn("machined health check failed, not feeding the watchdog", zap.Error(err))
+
+		return
+	}
+
+	if err := ctrl.timer.Feed(); err != nil {
+		logger.Warn("failed to feed the watchdog", zap.Error(err))
+	}
+}
+
+func (ctrl *WatchdogTimerController) checkHealth(ctx context.Context, r controller.Runtime) error {
+	checkCtx, checkCancel := context.WithTimeout(ctx, ctrl.status.FeedInterval)
+	defer checkCancel()
+
+	now := time.Now()
+
+	for _, id := range watchdogServices {
+		service, err := safe.ReaderGet[*v1alpha1.Service](checkCtx, r, v1alpha1.NewService(id).Metadata())
+		if err != nil && !state.IsNotFoundError(err) {
+			return fmt.Errorf("error getting service %q: %w", id, err)
+		}
+
+		// services which are not running (yet) or don't report the health are not checked
+		if service == nil || service.TypedSpec().Healthy || service.TypedSpec().Unknown {
+			delete(ctrl.unhealthySince, id)
+
+			continue
+		}
+
+		since, ok := ctrl.unhealthySince[id]
+		if !ok {
+			ctrl.unhealthySince[id] = now
+
+			continue
+		}
+
+		if now.Sub(since) > ctrl.status.Timeout {
+			return fmt.Errorf("service %q is unhealthy for %s", id, now.Sub(since).Round(time.Second))
+		}
+	}
+
+	return nil
+}
+
+func (ctrl *WatchdogTimerController) disarm(logger *zap.Logger) {
+	if ctrl.timer == nil {
+		return
+	}
+
+	ctrl.ticker.Stop()
+
+	if err := ctrl.timer.Close(); err != nil {
+		logger.Warn("failed to disarm the watchdog", zap.Error(err))
+	} else {
+		logger.Info("watchdog disarmed", zap.String("device", ctrl.status.Device))
+	}
+
+	ctrl.timer = nil
+	ctrl.ticker = nil
+	ctrl.status = runtime.WatchdogTimerStatusSpec{}
+	ctrl.unhealthySince = nil
+}
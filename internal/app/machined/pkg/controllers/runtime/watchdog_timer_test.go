@@ -0,0 +1,206 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package runtime_test
+
+import (
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/cosi-project/runtime/pkg/resource"
+	"github.com/cosi-project/runtime/pkg/state"
+	"github.com/siderolabs/go-retry/retry"
+	"github.com/stretchr/testify/suite"
+
+	runtimecontrollers "github.com/siderolabs/talos/internal/app/machined/pkg/controllers/runtime"
+	"github.com/siderolabs/talos/pkg/machinery/config/types/v1alpha1"
+	"github.com/siderolabs/talos/pkg/machinery/resources/config"
+	runtimeresource "github.com/siderolabs/talos/pkg/machinery/resources/runtime"
+	v1alpha1resource "github.com/siderolabs/talos/pkg/machinery/resources/v1alpha1"
+)
+
+type mockWatchdogTimer struct {
+	mu sync.Mutex
+
+	device  string
+	timeout time.Duration
+	feeds   int
+	closed  bool
+}
+
+func (t *mockWatchdogTimer) SetTimeout(timeout time.Duration) error {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	t.timeout = timeout
+
+	return nil
+}
+
+func (t *mockWatchdogTimer) Feed() error {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	t.feeds++
+
+	return nil
+}
+
+func (t *mockWatchdogTimer) Close() error {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	t.closed = true
+
+	return nil
+}
+
+func (t *mockWatchdogTimer) state() (feeds int, closed bool) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	return t.feeds, t.closed
+}
+
+type WatchdogTimerSuite struct {
+	RuntimeSuite
+
+	mu     sync.Mutex
+	timers []*mockWatchdogTimer
+}
+
+func (suite *WatchdogTimerSuite) openTimer(device string) (runtimecontrollers.WatchdogTimer, error) {
+	suite.mu.Lock()
+	defer suite.mu.Unlock()
+
+	timer := &mockWatchdogTimer{device: device}
+	suite.timers = append(suite.timers, timer)
+
+	return timer, nil
+}
+
+func (suite *WatchdogTimerSuite) lastTimer() *mockWatchdogTimer {
+	suite.mu.Lock()
+	defer suite.mu.Unlock()
+
+	if len(suite.timers) == 0 {
+		return nil
+	}
+
+	return suite.timers[len(suite.timers)-1]
+}
+
+func (suite *WatchdogTimerSuite) TestReconcile() {
+	suite.Require().NoError(suite.runtime.RegisterController(&runtimecontrollers.WatchdogTimerController{
+		OpenTimer: suite.openTimer,
+	}))
+
+	suite.startRuntime()
+
+	// the minimum timeout is enforced by config validation only
+	cfg := config.NewMachineConfig(&v1alpha1.Config{
+		ConfigVersion: "v1alpha1",
+		MachineConfig: &v1alpha1.MachineConfig{
+			MachineWatchdog: &v1alpha1.WatchdogConfig{
+				WatchdogDevice:  "/dev/watchdog1",
+				WatchdogTimeout: 400 * time.Millisecond,
+			},
+		},
+		ClusterConfig: &v1alpha1.ClusterConfig{},
+	})
+
+	suite.Require().NoError(suite.state.Create(suite.ctx, cfg))
+
+	statusMD := runtimeresource.NewWatchdogTimerStatus(runtimeresource.NamespaceName, runtimeresource.WatchdogTimerStatusID).Metadata()
+
+	suite.Assert().NoError(retry.Constant(10*time.Second, retry.WithUnits(100*time.Millisecond)).Retry(
+		suite.assertResource(
+			*statusMD,
+			func(res resource.Resource) bool {
+				spec := res.(*runtimeresource.WatchdogTimerStatus).TypedSpec()
+
+				return spec.Device == "/dev/watchdog1" && spec.Timeout == 400*time.Millisecond && spec.FeedInterval == 100*time.Millisecond
+			},
+		),
+	))
+
+	timer := suite.lastTimer()
+	suite.Require().NotNil(timer)
+	suite.Assert().Equal("/dev/watchdog1", timer.device)
+
+	// the timer is fed periodically
+	suite.Assert().NoError(retry.Constant(10*time.Second, retry.WithUnits(100*time.Millisecond)).Retry(
+		func() error {
+			if feeds, _ := timer.state(); feeds < 3 {
+				return retry.ExpectedErrorf("watchdog fed %d times", feeds)
+			}
+
+			return nil
+		},
+	))
+
+	// the timer is not fed while a watched service fails the health checks longer than the timeout
+	service := v1alpha1resource.NewService("apid")
+	service.TypedSpec().Running = true
+
+	suite.Require().NoError(suite.state.Create(suite.ctx, service))
+
+	suite.Assert().NoError(retry.Constant(10*time.Second, retry.WithUnits(100*time.Millisecond)).Retry(
+		func() error {
+			feeds, _ := timer.state()
+
+			time.Sleep(300 * time.Millisecond)
+
+			if newFeeds, _ := timer.state(); newFeeds != feeds {
+				return retry.ExpectedErrorf("watchdog is still fed")
+			}
+
+			return nil
+		},
+	))
+
+	// once the service is healthy again, the timer is fed
+	service.TypedSpec().Healthy = true
+	suite.Require().NoError(suite.state.Update(suite.ctx, service))
+
+	feeds, _ := timer.state()
+
+	suite.Assert().NoError(retry.Constant(10*time.Second, retry.WithUnits(100*time.Millisecond)).Retry(
+		func() error {
+			if newFeeds, _ := timer.state(); newFeeds <= feeds {
+				return retry.ExpectedErrorf("watchdog is not fed")
+			}
+
+			return nil
+		},
+	))
+
+	// the timer is disarmed by the disarm signal
+	suite.Require().NoError(suite.state.Create(suite.ctx,
+		runtimeresource.NewWatchdogTimerDisarmSignal(runtimeresource.NamespaceName, runtimeresource.WatchdogTimerDisarmSignalID)))
+
+	suite.Assert().NoError(retry.Constant(10*time.Second, retry.WithUnits(100*time.Millisecond)).Retry(
+		func() error {
+			if _, closed := timer.state(); !closed {
+				return retry.ExpectedErrorf("watchdog is still armed")
+			}
+
+			_, err := suite.state.Get(suite.ctx, *statusMD)
+			if err == nil {
+				return retry.ExpectedErrorf("watchdog status still exists")
+			}
+
+			if state.IsNotFoundError(err) {
+				return nil
+			}
+
+			return err
+		},
+	))
+}
+
+func TestWatchdogTimerSuite(t *testing.T) {
+	suite.Run(t, new(WatchdogTimerSuite))
+}
@@ -19,3 +19,77 @@ type Board interface {
 	KernelArgs() procfs.Parameters
 	PartitionOptions() *PartitionOptions
 }
+
+// BoardHardware describes the hardware of a SBC as known to the board
+// implementation.
+type BoardHardware struct {
+	// SOC is the name of the system on chip, e.g. rk3588.
+	SOC string
+	// Features maps an optional peripheral (e.g. npu) to the device tree node
+	// which describes it.
+	//
+	// The feature is reported as present if the node exists and is enabled.
+	Features map[string]string
+	// BootDevices maps the device tree node reported by the bootloader via
+	// /chosen/u-boot,spl-boot-device to the boot medium name (e.g. emmc).
+	BootDevices map[string]string
+}
+
+// BoardHardwareReporter is implemented by boards which can describe their
+// hardware capabilities.
+type BoardHardwareReporter interface {
+	Hardware() *BoardHardware
+}
+
+// BoardDeviceTree is implemented by boards which install a device tree blob
+// to the boot partition.
+type BoardDeviceTree interface {
+	// DeviceTree returns the path to the device tree blob relative to the
+	// installer assets, e.g. /dtb/rockchip/rk3588-rock-5b.dtb.
+	DeviceTree() string
+}
+
+// BoardBootloader is implemented by boards which install U-Boot to the disk.
+type BoardBootloader interface {
+	// BootloaderVersion returns the version of U-Boot in the installer assets.
+	BootloaderVersion() (string, error)
+}
+
+// BoardSPIFlash is implemented by boards which can boot from the onboard SPI
+// flash.
+type BoardSPIFlash interface {
+	// SPIFlashImage returns the path to the bootloader image for the SPI flash.
+	SPIFlashImage() string
+	// InstallSPIFlash installs the bootloader to the MTD block device of the
+	// SPI flash instead of the system disk.
+	InstallSPIFlash(device string) error
+}
+
+// BoardLED describes the default patterns of a board LED indicating the
+// machine stage.
+type BoardLED struct {
+	// Name is the name of the LED in /sys/class/leds.
+	Name string
+	// Stages maps the machine stage (e.g. running) to the LED pattern.
+	Stages map[string]string
+}
+
+// BoardLEDs is implemented by boards which have LEDs to indicate the machine
+// stage.
+type BoardLEDs interface {
+	// LEDs returns the default LED configuration used if the machine config
+	// doesn't configure the LEDs.
+	LEDs() []BoardLED
+}
+
+// BoardBootloaderArtifacts is implemented by boards which can ship the
+// bootloader separately from the system disk, e.g. to boot from NVMe via the
+// SPI flash.
+type BoardBootloaderArtifacts interface {
+	// BootloaderArtifacts returns the paths to the bootloader images in the
+	// installer assets.
+	BootloaderArtifacts() []string
+	// InstallBootAssets installs the board assets to the boot partition
+	// without writing the bootloader to the disk.
+	InstallBootAssets() error
+}
@@ -5,8 +5,10 @@
 package runtime
 
 import (
+	"strings"
 	"time"
 
+	"github.com/siderolabs/talos/internal/pkg/confighistory"
 	"github.com/siderolabs/talos/pkg/machinery/config"
 )
 
@@ -14,8 +16,9 @@ import (
 type Runtime interface { //nolint:interfacebloat
 	Config() config.Provider
 	LoadAndValidateConfig([]byte) (config.Provider, error)
-	RollbackToConfigAfter([]byte, time.Duration) error
+	RollbackToConfigAfter([]byte, time.Duration, TryConditions) error
 	CancelConfigRollbackTimeout()
+	ConfigRollbackPending() bool
 	SetConfig(config.Provider) error
 	CanApplyImmediate(config.Provider) error
 	State() State
@@ -24,3 +27,42 @@ type Runtime interface { //nolint:interfacebloat
 	NodeName() (string, error)
 	IsBootstrapAllowed() bool
 }
+
+// TryConditions are the health conditions evaluated while the config applied in try mode is active.
+//
+// The config is rolled back as soon as any of the conditions fails.
+type TryConditions struct {
+	// Probes are the IDs of the network probes which should succeed.
+	Probes []string
+	// Services are the IDs of the services which should be healthy.
+	Services []string
+	// CommitOnHealthy commits the config once all the conditions stay healthy for HealthyPeriod.
+	CommitOnHealthy bool
+	HealthyPeriod   time.Duration
+	// GracePeriod is the period after the config apply when the failed conditions are ignored.
+	GracePeriod time.Duration
+	// FailureThreshold is the number of consecutive failed checks which roll the config back.
+	FailureThreshold int
+	// Revision describes the config in the config history once it is committed.
+	Revision confighistory.Revision
+}
+
+// Empty returns true if there are no conditions to evaluate.
+func (c TryConditions) Empty() bool {
+	return len(c.Probes) == 0 && len(c.Services) == 0
+}
+
+// String implements fmt.Stringer.
+func (c TryConditions) String() string {
+	var conditions []string
+
+	for _, probe := range c.Probes {
+		conditions = append(conditions, "probe "+probe)
+	}
+
+	for _, service := range c.Services {
+		conditions = append(conditions, "service "+service)
+	}
+
+	return strings.Join(conditions, ", ")
+}
@@ -65,6 +65,7 @@ type V1Alpha2State interface {
 	ResourceRegistry() *registry.ResourceRegistry
 
 	SetConfig(config.Provider) error
+	SetStagedConfig(config.Provider) error
 }
 
 // DBusState defines the D-Bus logind mock.
@@ -2,103 +2,34 @@
 // License, v. 2.0. If a copy of the MPL was not distributed with this
 // file, You can obtain one at http://mozilla.org/MPL/2.0/.
 
-// Package bananapim64 provides the Banana Pi M64 board implementation.
+// Package bananapim64 provides the Banana Pi M64 board descriptor.
 package bananapim64
 
 import (
-	"fmt"
-	"log"
-	"os"
-	"path/filepath"
-
-	"github.com/siderolabs/go-procfs/procfs"
-	"golang.org/x/sys/unix"
-
-	"github.com/siderolabs/talos/internal/app/machined/pkg/runtime"
-	"github.com/siderolabs/talos/pkg/copy"
+	"github.com/siderolabs/talos/internal/app/machined/pkg/runtime/v1alpha1/board/descriptor"
+	"github.com/siderolabs/talos/internal/pkg/uboot"
 	"github.com/siderolabs/talos/pkg/machinery/constants"
 )
 
-var (
-	bin       = fmt.Sprintf("/usr/install/arm64/u-boot/%s/u-boot-sunxi-with-spl.bin", constants.BoardBananaPiM64)
-	off int64 = 1024 * 8
-	dtb       = "/dtb/allwinner/sun50i-a64-bananapi-m64.dtb"
-)
-
-// BananaPiM64 represents the Banana Pi M64.
+// Descriptor describes the Banana Pi M64 board.
 //
 // References:
 //   - http://www.banana-pi.org/m64.html
 //   - http://wiki.banana-pi.org/Banana_Pi_BPI-M64
 //   - https://linux-sunxi.org/Banana_Pi_M64
-type BananaPiM64 struct{}
-
-// Name implements the runtime.Board.
-func (b *BananaPiM64) Name() string {
-	return constants.BoardBananaPiM64
-}
-
-// Install implements the runtime.Board.
-func (b *BananaPiM64) Install(disk string) (err error) {
-	var f *os.File
-
-	if f, err = os.OpenFile(disk, os.O_RDWR|unix.O_CLOEXEC, 0o666); err != nil {
-		return err
-	}
-	//nolint:errcheck
-	defer f.Close()
-
-	var uboot []byte
-
-	uboot, err = os.ReadFile(bin)
-	if err != nil {
-		return err
-	}
-
-	log.Printf("writing %s at offset %d", bin, off)
-
-	var n int
-
-	n, err = f.WriteAt(uboot, off)
-	if err != nil {
-		return err
-	}
-
-	log.Printf("wrote %d bytes", n)
-
-	// NB: In the case that the block device is a loopback device, we sync here
-	// to esure that the file is written before the loopback device is
-	// unmounted.
-	err = f.Sync()
-	if err != nil {
-		return err
-	}
-
-	src := "/usr/install/arm64" + dtb
-	dst := "/boot/EFI" + dtb
-
-	err = os.MkdirAll(filepath.Dir(dst), 0o600)
-	if err != nil {
-		return err
-	}
-
-	err = copy.File(src, dst)
-	if err != nil {
-		return err
-	}
-
-	return nil
-}
-
-// KernelArgs implements the runtime.Board.
-func (b *BananaPiM64) KernelArgs() procfs.Parameters {
-	return []*procfs.Parameter{
-		procfs.NewParameter("console").Append("tty0").Append("ttyS0,115200"),
-		procfs.NewParameter(constants.KernelParamDashboardDisabled).Append("1"),
-	}
-}
-
-// PartitionOptions implements the runtime.Board.
-func (b *BananaPiM64) PartitionOptions() *runtime.PartitionOptions {
-	return &runtime.PartitionOptions{PartitionsOffset: 2048}
+var Descriptor = &descriptor.Descriptor{
+	Name:  constants.BoardBananaPiM64,
+	Model: "Banana Pi M64",
+	Compatible: []string{
+		"sinovoip,bananapi-m64",
+	},
+	Bootloader: []uboot.Image{
+		{
+			Path:   "/usr/install/arm64/u-boot/" + constants.BoardBananaPiM64 + "/u-boot-sunxi-with-spl.bin",
+			Offset: 1024 * 8,
+		},
+	},
+	DeviceTree:       "/dtb/allwinner/sun50i-a64-bananapi-m64.dtb",
+	Console:          []string{"ttyS0,115200"},
+	PartitionsOffset: 2048,
 }
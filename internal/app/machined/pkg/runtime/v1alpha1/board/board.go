@@ -6,17 +6,22 @@
 package board
 
 import (
+	"bytes"
 	"errors"
 	"fmt"
 	"os"
+	"sort"
 
 	"github.com/siderolabs/go-procfs/procfs"
 
 	"github.com/siderolabs/talos/internal/app/machined/pkg/runtime"
 	bananapim64 "github.com/siderolabs/talos/internal/app/machined/pkg/runtime/v1alpha1/board/bananapi_m64"
+	"github.com/siderolabs/talos/internal/app/machined/pkg/runtime/v1alpha1/board/descriptor"
 	jetsonnano "github.com/siderolabs/talos/internal/app/machined/pkg/runtime/v1alpha1/board/jetson_nano"
 	libretechallh3cch5 "github.com/siderolabs/talos/internal/app/machined/pkg/runtime/v1alpha1/board/libretech_all_h3_cc_h5"
+	nanopct6 "github.com/siderolabs/talos/internal/app/machined/pkg/runtime/v1alpha1/board/nanopc_t6"
 	nanopir4s "github.com/siderolabs/talos/internal/app/machined/pkg/runtime/v1alpha1/board/nanopi_r4s"
+	orangepi5 "github.com/siderolabs/talos/internal/app/machined/pkg/runtime/v1alpha1/board/orangepi_5"
 	"github.com/siderolabs/talos/internal/app/machined/pkg/runtime/v1alpha1/board/pine64"
 	"github.com/siderolabs/talos/internal/app/machined/pkg/runtime/v1alpha1/board/rock5a"
 	"github.com/siderolabs/talos/internal/app/machined/pkg/runtime/v1alpha1/board/rock5b"
@@ -29,6 +34,9 @@ import (
 )
 
 // CurrentBoard is a helper func for discovering the current board.
+//
+// The board is taken from the kernel command line or the BOARD environment variable,
+// falling back to matching the device tree compatible strings.
 func CurrentBoard() (b runtime.Board, err error) {
 	var board string
 
@@ -40,6 +48,10 @@ func CurrentBoard() (b runtime.Board, err error) {
 		board = p
 	}
 
+	if board == "" {
+		board = detectBoard(deviceTreeCompatiblePath)
+	}
+
 	if board == "" {
 		return nil, errors.New("failed to determine board")
 	}
@@ -47,41 +59,146 @@ func CurrentBoard() (b runtime.Board, err error) {
 	return newBoard(board)
 }
 
+const deviceTreeCompatiblePath = "/proc/device-tree/compatible"
+
+// Info describes a supported board.
+type Info struct {
+	// Name is the board name as passed in the talos.board kernel argument.
+	Name string
+	// Model is the human-readable board name.
+	Model string
+	// Compatible lists the device tree compatible strings of the board.
+	Compatible []string
+}
+
+type registration struct {
+	Info
+
+	new func() runtime.Board
+}
+
+// registry lists the supported boards.
+//
+// Boards which boot from U-Boot written to the disk are declared with a descriptor,
+// others provide their own runtime.Board implementation.
+var registry = append([]registration{
+	{
+		Info: Info{Name: constants.BoardRPi4, Model: "Raspberry Pi 4 Model B", Compatible: rpi4.Compatible},
+		new:  func() runtime.Board { return &rpi4.RPi4{} },
+	},
+	{
+		Info: Info{Name: constants.BoardRPiGeneric, Model: "Raspberry Pi (generic)", Compatible: rpigeneric.Compatible},
+		new:  func() runtime.Board { return &rpigeneric.RPiGeneric{} },
+	},
+	{
+		Info: Info{Name: constants.BoardJetsonNano, Model: "Nvidia Jetson Nano", Compatible: jetsonnano.Compatible},
+		new:  func() runtime.Board { return &jetsonnano.JetsonNano{} },
+	},
+}, fromDescriptors(
+	bananapim64.Descriptor,
+	libretechallh3cch5.Descriptor,
+	nanopct6.Descriptor,
+	nanopir4s.Descriptor,
+	orangepi5.Descriptor,
+	pine64.Descriptor,
+	rock5a.Descriptor,
+	rock5b.Descriptor,
+	rock64.Descriptor,
+	rockpi4.Descriptor,
+	rockpi4c.Descriptor,
+)...)
+
+func fromDescriptors(descriptors ...*descriptor.Descriptor) []registration {
+	registrations := make([]registration, 0, len(descriptors))
+
+	for _, d := range descriptors {
+		d := d
+
+		registrations = append(registrations, registration{
+			Info: Info{Name: d.Name, Model: d.Model, Compatible: d.Compatible},
+			new:  func() runtime.Board { return descriptor.New(d) },
+		})
+	}
+
+	return registrations
+}
+
+// Boards returns the list of the supported boards sorted by name.
+func Boards() []Info {
+	boards := make([]Info, 0, len(registry))
+
+	for _, r := range registry {
+		boards = append(boards, r.Info)
+	}
+
+	sort.Slice(boards, func(i, j int) bool { return boards[i].Name < boards[j].Name })
+
+	return boards
+}
+
+// detectBoard returns the name of the board matching the device tree compatible strings, if any.
+//
+// The compatible strings are listed from the most specific to the most generic, so the first match wins.
+func detectBoard(path string) string {
+	contents, err := os.ReadFile(path)
+	if err != nil {
+		return ""
+	}
+
+	for _, compatible := range bytes.Split(bytes.TrimRight(contents, "\x00"), []byte{0}) {
+		if board := matchCompatible(string(compatible)); board != "" {
+			return board
+		}
+	}
+
+	return ""
+}
+
+// matchCompatible returns the name of the board which lists the device tree compatible string, if any.
+func matchCompatible(compatible string) string {
+	for _, r := range registry {
+		for _, c := range r.Compatible {
+			if c == compatible {
+				return r.Name
+			}
+		}
+	}
+
+	return ""
+}
+
+// Detectable reports whether the board is detected from the device tree compatible strings.
+//
+// Such boards don't need the talos.board kernel argument, which takes precedence over the detection.
+func Detectable(board string) bool {
+	for _, r := range registry {
+		if r.Name != board {
+			continue
+		}
+
+		for _, c := range r.Compatible {
+			if matchCompatible(c) != board {
+				return false
+			}
+		}
+
+		return len(r.Compatible) > 0
+	}
+
+	return false
+}
+
 // NewBoard initializes and returns a runtime.Board.
 func NewBoard(board string) (b runtime.Board, err error) {
 	return newBoard(board)
 }
 
-//gocyclo:ignore
 func newBoard(board string) (b runtime.Board, err error) {
-	switch board {
-	case constants.BoardLibretechAllH3CCH5:
-		b = &libretechallh3cch5.LibretechAllH3CCH5{}
-	case constants.BoardRPi4:
-		b = &rpi4.RPi4{}
-	case constants.BoardRPiGeneric:
-		b = &rpigeneric.RPiGeneric{}
-	case constants.BoardBananaPiM64:
-		b = &bananapim64.BananaPiM64{}
-	case constants.BoardPine64:
-		b = &pine64.Pine64{}
-	case constants.BoardRock64:
-		b = &rock64.Rock64{}
-	case constants.BoardRockpi4:
-		b = &rockpi4.Rockpi4{}
-	case constants.BoardRockpi4c:
-		b = &rockpi4c.Rockpi4c{}
-	case constants.BoardRock5a:
-		b = &rock5a.Rock5a{}
-	case constants.BoardRock5b:
-		b = &rock5b.Rock5b{}
-	case constants.BoardJetsonNano:
-		b = &jetsonnano.JetsonNano{}
-	case constants.BoardNanoPiR4S:
-		b = &nanopir4s.NanoPiR4S{}
-	default:
-		return nil, fmt.Errorf("unsupported board: %q", board)
+	for _, r := range registry {
+		if r.Name == board {
+			return r.new(), nil
+		}
 	}
 
-	return b, nil
+	return nil, fmt.Errorf("unsupported board: %q", board)
 }
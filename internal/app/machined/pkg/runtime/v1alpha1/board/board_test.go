@@ -0,0 +1,139 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package board //nolint:testpackage
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/siderolabs/talos/internal/app/machined/pkg/runtime"
+	"github.com/siderolabs/talos/internal/app/machined/pkg/runtime/v1alpha1/board/descriptor"
+	"github.com/siderolabs/talos/pkg/machinery/constants"
+)
+
+func TestDetectBoard(t *testing.T) {
+	for _, test := range []struct {
+		name       string
+		compatible string
+		expected   string
+	}{
+		{
+			name:       "rock 5b",
+			compatible: "radxa,rock-5b\x00rockchip,rk3588\x00",
+			expected:   constants.BoardRock5b,
+		},
+		{
+			name:       "rock 5a",
+			compatible: "radxa,rock-5a\x00rockchip,rk3588s\x00",
+			expected:   constants.BoardRock5a,
+		},
+		{
+			name:       "most specific wins",
+			compatible: "radxa,rockpi4c\x00radxa,rockpi4\x00rockchip,rk3399\x00",
+			expected:   constants.BoardRockpi4c,
+		},
+		{
+			name:       "generic fallback",
+			compatible: "radxa,rockpi4-unknown\x00radxa,rockpi4\x00rockchip,rk3399\x00",
+			expected:   constants.BoardRockpi4,
+		},
+		{
+			name:       "unknown",
+			compatible: "foo,bar\x00rockchip,rk3588\x00",
+			expected:   "",
+		},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			path := filepath.Join(t.TempDir(), "compatible")
+
+			require.NoError(t, os.WriteFile(path, []byte(test.compatible), 0o644))
+
+			assert.Equal(t, test.expected, detectBoard(path))
+		})
+	}
+}
+
+func TestDetectBoardMissing(t *testing.T) {
+	assert.Equal(t, "", detectBoard(filepath.Join(t.TempDir(), "compatible")))
+}
+
+func TestDetectable(t *testing.T) {
+	for _, info := range Boards() {
+		assert.True(t, Detectable(info.Name), "board %q", info.Name)
+	}
+
+	assert.False(t, Detectable("foo"))
+}
+
+func TestBoards(t *testing.T) {
+	names := map[string]struct{}{}
+
+	for _, info := range Boards() {
+		_, duplicate := names[info.Name]
+		assert.False(t, duplicate, "duplicate board %q", info.Name)
+
+		names[info.Name] = struct{}{}
+
+		assert.NotEmpty(t, info.Compatible, "board %q", info.Name)
+
+		b, err := NewBoard(info.Name)
+		require.NoError(t, err)
+
+		assert.Equal(t, info.Name, b.Name())
+	}
+
+	_, err := NewBoard("foo")
+	assert.EqualError(t, err, `unsupported board: "foo"`)
+}
+
+func TestDescriptorBootloader(t *testing.T) {
+	for _, info := range Boards() {
+		b, err := NewBoard(info.Name)
+		require.NoError(t, err)
+
+		d, ok := b.(*descriptor.Board)
+		if !ok {
+			continue
+		}
+
+		for _, img := range d.Descriptor().Bootloader {
+			// each board ships its own U-Boot build
+			assert.Contains(t, img.Path, "/u-boot/"+info.Name+"/", "board %q", info.Name)
+
+			// only the vendor images for Rock 5 boards are laid out as the disk, others are raw blobs written at the offset
+			expected := info.Name == constants.BoardRock5a || info.Name == constants.BoardRock5b
+			assert.Equal(t, expected, img.DiskLayout, "board %q", info.Name)
+		}
+	}
+}
+
+func TestDescriptorBoard(t *testing.T) {
+	b, err := NewBoard(constants.BoardRock64)
+	require.NoError(t, err)
+
+	assert.Equal(t, "console=tty0 console=ttyS2,115200n8 talos.dashboard.disabled=1", b.KernelArgs().String())
+	assert.Equal(t, uint64(2048*10), b.PartitionOptions().PartitionsOffset)
+
+	b, err = NewBoard(constants.BoardRock5b)
+	require.NoError(t, err)
+
+	assert.Equal(t, "console=tty0 console=ttyS2,1500000n8 sysctl.kernel.kexec_load_disabled=1 talos.dashboard.disabled=1", b.KernelArgs().String())
+	assert.Nil(t, b.PartitionOptions())
+	assert.Equal(t, "/dtb/rockchip/rk3588-rock-5b.dtb", b.(runtime.BoardDeviceTree).DeviceTree())
+	assert.Equal(t, "/usr/install/arm64/u-boot/rock_5b/u-boot-rockchip-spi.bin", b.(runtime.BoardSPIFlash).SPIFlashImage())
+	assert.Equal(t, []string{
+		"/usr/install/arm64/u-boot/rock_5b/u-boot-rockchip-spi.bin",
+		"/usr/install/arm64/u-boot/rock_5b/u-boot.img",
+	}, b.(runtime.BoardBootloaderArtifacts).BootloaderArtifacts())
+
+	b, err = NewBoard(constants.BoardRock5a)
+	require.NoError(t, err)
+
+	assert.Empty(t, b.(runtime.BoardSPIFlash).SPIFlashImage())
+}
@@ -0,0 +1,203 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+// Package descriptor provides the board implementation driven by a declarative board description.
+package descriptor
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/siderolabs/go-procfs/procfs"
+
+	"github.com/siderolabs/talos/internal/app/machined/pkg/runtime"
+	"github.com/siderolabs/talos/internal/pkg/uboot"
+	"github.com/siderolabs/talos/pkg/copy"
+	"github.com/siderolabs/talos/pkg/machinery/constants"
+)
+
+const (
+	// AssetsPath is the path to the board assets in the installer image.
+	AssetsPath = "/usr/install/arm64"
+
+	bootPath = "/boot/EFI"
+)
+
+// Descriptor describes a board which boots via U-Boot written to the disk at a fixed offset.
+type Descriptor struct {
+	// Name is the board name as passed in the talos.board kernel argument, e.g. rock_5b.
+	Name string
+	// Model is the human-readable board name, e.g. Radxa Rock 5B.
+	Model string
+	// Compatible lists the device tree compatible strings of the board.
+	Compatible []string
+
+	// Bootloader lists the U-Boot images written to the system disk.
+	//
+	// Images are raw blobs written at the offset as a whole, unless uboot.Image.DiskLayout is set.
+	// The version of the installed U-Boot is read from the first image.
+	Bootloader []uboot.Image
+	// SPIFlash is the U-Boot image for the onboard SPI flash, if the board can boot from it.
+	SPIFlash *uboot.Image
+	// DeviceTree is the path to the device tree blob relative to AssetsPath, e.g. /dtb/rockchip/rk3588-rock-5b.dtb.
+	DeviceTree string
+
+	// Console lists the values of the console kernel argument, e.g. ttyS2,1500000n8.
+	Console []string
+	// KexecDisabled disables kexec, which doesn't work on some SoCs.
+	KexecDisabled bool
+	// PartitionsOffset is the offset of the first partition in sectors, if the bootloader needs more space
+	// than the default offset leaves.
+	PartitionsOffset uint64
+
+	// Hardware describes the board hardware for the BoardInformation resource.
+	Hardware *runtime.BoardHardware
+	// LEDs lists the default LED patterns for the machine stages.
+	LEDs []runtime.BoardLED
+}
+
+// Board implements runtime.Board for a board Descriptor.
+type Board struct {
+	descriptor *Descriptor
+}
+
+// New initializes a Board from the descriptor.
+func New(descriptor *Descriptor) *Board {
+	return &Board{
+		descriptor: descriptor,
+	}
+}
+
+// Descriptor returns the descriptor of the board.
+func (b *Board) Descriptor() *Descriptor {
+	return b.descriptor
+}
+
+// Name implements the runtime.Board.
+func (b *Board) Name() string {
+	return b.descriptor.Name
+}
+
+// Install implements the runtime.Board.
+func (b *Board) Install(disk string) error {
+	for _, img := range b.descriptor.Bootloader {
+		if _, err := uboot.Install(disk, img); err != nil {
+			return err
+		}
+	}
+
+	return b.installDeviceTree()
+}
+
+// SPIFlashImage implements the runtime.BoardSPIFlash.
+//
+// The returned path is empty if the board doesn't boot from SPI flash.
+func (b *Board) SPIFlashImage() string {
+	if b.descriptor.SPIFlash == nil {
+		return ""
+	}
+
+	return b.descriptor.SPIFlash.Path
+}
+
+// InstallSPIFlash implements the runtime.BoardSPIFlash.
+func (b *Board) InstallSPIFlash(device string) error {
+	if b.descriptor.SPIFlash == nil {
+		return fmt.Errorf("board %q doesn't support installing the bootloader to SPI flash", b.descriptor.Name)
+	}
+
+	if _, err := uboot.Install(device, *b.descriptor.SPIFlash); err != nil {
+		return err
+	}
+
+	return b.installDeviceTree()
+}
+
+// BootloaderArtifacts implements the runtime.BoardBootloaderArtifacts.
+//
+// The SPI flash image is listed first, followed by the system disk images.
+func (b *Board) BootloaderArtifacts() []string {
+	var artifacts []string
+
+	if b.descriptor.SPIFlash != nil {
+		artifacts = append(artifacts, b.descriptor.SPIFlash.Path)
+	}
+
+	for _, img := range b.descriptor.Bootloader {
+		artifacts = append(artifacts, img.Path)
+	}
+
+	return artifacts
+}
+
+// InstallBootAssets implements the runtime.BoardBootloaderArtifacts.
+func (b *Board) InstallBootAssets() error {
+	return b.installDeviceTree()
+}
+
+func (b *Board) installDeviceTree() error {
+	if b.descriptor.DeviceTree == "" {
+		return nil
+	}
+
+	src := AssetsPath + b.descriptor.DeviceTree
+	dst := bootPath + b.descriptor.DeviceTree
+
+	if err := os.MkdirAll(filepath.Dir(dst), 0o600); err != nil {
+		return err
+	}
+
+	return copy.File(src, dst)
+}
+
+// BootloaderVersion implements the runtime.BoardBootloader.
+func (b *Board) BootloaderVersion() (string, error) {
+	if len(b.descriptor.Bootloader) == 0 {
+		return "", nil
+	}
+
+	return b.descriptor.Bootloader[0].Version()
+}
+
+// KernelArgs implements the runtime.Board.
+func (b *Board) KernelArgs() procfs.Parameters {
+	console := procfs.NewParameter("console").Append("tty0")
+
+	for _, c := range b.descriptor.Console {
+		console.Append(c)
+	}
+
+	params := []*procfs.Parameter{console}
+
+	if b.descriptor.KexecDisabled {
+		params = append(params, procfs.NewParameter("sysctl.kernel.kexec_load_disabled").Append("1"))
+	}
+
+	return append(params, procfs.NewParameter(constants.KernelParamDashboardDisabled).Append("1"))
+}
+
+// PartitionOptions implements the runtime.Board.
+func (b *Board) PartitionOptions() *runtime.PartitionOptions {
+	if b.descriptor.PartitionsOffset == 0 {
+		return nil
+	}
+
+	return &runtime.PartitionOptions{PartitionsOffset: b.descriptor.PartitionsOffset}
+}
+
+// DeviceTree implements the runtime.BoardDeviceTree.
+func (b *Board) DeviceTree() string {
+	return b.descriptor.DeviceTree
+}
+
+// Hardware implements the runtime.BoardHardwareReporter.
+func (b *Board) Hardware() *runtime.BoardHardware {
+	return b.descriptor.Hardware
+}
+
+// LEDs implements the runtime.BoardLEDs.
+func (b *Board) LEDs() []runtime.BoardLED {
+	return b.descriptor.LEDs
+}
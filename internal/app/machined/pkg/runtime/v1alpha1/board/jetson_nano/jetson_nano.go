@@ -23,6 +23,11 @@ import (
 // - https://github.com/u-boot/u-boot/blob/v2021.10/include/configs/tegra210-common.h#L49
 var dtb = "/dtb/nvidia/tegra210-p3450-0000.dtb"
 
+// Compatible lists the device tree compatible strings of the board.
+var Compatible = []string{
+	"nvidia,p3450-0000",
+}
+
 // JetsonNano represents the JetsonNano board
 //
 // References:
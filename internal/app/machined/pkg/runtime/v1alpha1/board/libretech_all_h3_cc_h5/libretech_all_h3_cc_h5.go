@@ -2,100 +2,31 @@
 // License, v. 2.0. If a copy of the MPL was not distributed with this
 // file, You can obtain one at http://mozilla.org/MPL/2.0/.
 
-// Package libretechallh3cch5 provides the LibretechAllH3CCH5 board implementation.
+// Package libretechallh3cch5 provides the Libre Computer ALL-H3-CC board descriptor.
 package libretechallh3cch5
 
 import (
-	"fmt"
-	"log"
-	"os"
-	"path/filepath"
-
-	"github.com/siderolabs/go-procfs/procfs"
-	"golang.org/x/sys/unix"
-
-	"github.com/siderolabs/talos/internal/app/machined/pkg/runtime"
-	"github.com/siderolabs/talos/pkg/copy"
+	"github.com/siderolabs/talos/internal/app/machined/pkg/runtime/v1alpha1/board/descriptor"
+	"github.com/siderolabs/talos/internal/pkg/uboot"
 	"github.com/siderolabs/talos/pkg/machinery/constants"
 )
 
-var (
-	bin       = fmt.Sprintf("/usr/install/arm64/u-boot/%s/u-boot-sunxi-with-spl.bin", constants.BoardLibretechAllH3CCH5)
-	off int64 = 1024 * 8
-	dtb       = "/dtb/allwinner/sun50i-h5-libretech-all-h3-cc.dtb"
-)
-
-// LibretechAllH3CCH5 represents the Libre Computer ALL-H3-CC (Tritium).
+// Descriptor describes the Libre Computer ALL-H3-CC (Tritium) board.
 //
 // Reference: https://libre.computer/products/boards/all-h3-cc/
-type LibretechAllH3CCH5 struct{}
-
-// Name implements the runtime.Board.
-func (l *LibretechAllH3CCH5) Name() string {
-	return constants.BoardLibretechAllH3CCH5
-}
-
-// Install implements the runtime.Board.
-func (l *LibretechAllH3CCH5) Install(disk string) (err error) {
-	var f *os.File
-
-	if f, err = os.OpenFile(disk, os.O_RDWR|unix.O_CLOEXEC, 0o666); err != nil {
-		return err
-	}
-	//nolint:errcheck
-	defer f.Close()
-
-	var uboot []byte
-
-	uboot, err = os.ReadFile(bin)
-	if err != nil {
-		return err
-	}
-
-	log.Printf("writing %s at offset %d", bin, off)
-
-	var n int
-
-	n, err = f.WriteAt(uboot, off)
-	if err != nil {
-		return err
-	}
-
-	log.Printf("wrote %d bytes", n)
-
-	// NB: In the case that the block device is a loopback device, we sync here
-	// to esure that the file is written before the loopback device is
-	// unmounted.
-	err = f.Sync()
-	if err != nil {
-		return err
-	}
-
-	src := "/usr/install/arm64" + dtb
-	dst := "/boot/EFI" + dtb
-
-	err = os.MkdirAll(filepath.Dir(dst), 0o600)
-	if err != nil {
-		return err
-	}
-
-	err = copy.File(src, dst)
-	if err != nil {
-		return err
-	}
-
-	return nil
-}
-
-// KernelArgs implements the runtime.Board.
-func (l *LibretechAllH3CCH5) KernelArgs() procfs.Parameters {
-	return []*procfs.Parameter{
-		procfs.NewParameter("console").Append("tty0").Append("ttyS0,115200"),
-		procfs.NewParameter(constants.KernelParamDashboardDisabled).Append("1"),
-	}
-}
-
-// PartitionOptions implements the runtime.Board.
-func (l *LibretechAllH3CCH5) PartitionOptions() *runtime.PartitionOptions {
-	return &runtime.PartitionOptions{PartitionsOffset: 2048}
+var Descriptor = &descriptor.Descriptor{
+	Name:  constants.BoardLibretechAllH3CCH5,
+	Model: "Libre Computer ALL-H3-CC (Tritium)",
+	Compatible: []string{
+		"libretech,all-h3-cc-h5",
+	},
+	Bootloader: []uboot.Image{
+		{
+			Path:   "/usr/install/arm64/u-boot/" + constants.BoardLibretechAllH3CCH5 + "/u-boot-sunxi-with-spl.bin",
+			Offset: 1024 * 8,
+		},
+	},
+	DeviceTree:       "/dtb/allwinner/sun50i-h5-libretech-all-h3-cc.dtb",
+	Console:          []string{"ttyS0,115200"},
+	PartitionsOffset: 2048,
 }
@@ -0,0 +1,49 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+// Package nanopct6 provides the Friendlyelec NanoPC-T6 board descriptor.
+package nanopct6
+
+import (
+	"github.com/siderolabs/talos/internal/app/machined/pkg/runtime"
+	"github.com/siderolabs/talos/internal/app/machined/pkg/runtime/v1alpha1/board/descriptor"
+	"github.com/siderolabs/talos/internal/pkg/uboot"
+	"github.com/siderolabs/talos/pkg/machinery/constants"
+)
+
+// Descriptor describes the Friendlyelec NanoPC-T6 board.
+//
+// Reference: https://wiki.friendlyelec.com/wiki/index.php/NanoPC-T6
+var Descriptor = &descriptor.Descriptor{
+	Name:  constants.BoardNanoPCT6,
+	Model: "Friendlyelec NanoPC-T6",
+	Compatible: []string{
+		"friendlyelec,nanopc-t6",
+	},
+	Bootloader: []uboot.Image{
+		{
+			Path:   "/usr/install/arm64/u-boot/nanopc_t6/u-boot-rockchip.bin",
+			Offset: 512 * 0x40,
+		},
+	},
+	DeviceTree:       "/dtb/rockchip/rk3588-nanopc-t6.dtb",
+	Console:          []string{"ttyS2,1500000n8"},
+	KexecDisabled:    true,
+	PartitionsOffset: 2048 * 10,
+	Hardware: &runtime.BoardHardware{
+		SOC: "rk3588",
+		Features: map[string]string{
+			"npu":       "/npu@fdab0000",
+			"emmc":      "/mmc@fe2e0000",
+			"sd":        "/mmc@fe2c0000",
+			"spi-flash": "/spi@fe2b0000",
+			"pcie-m2":   "/pcie@fe150000",
+		},
+		BootDevices: map[string]string{
+			"/mmc@fe2e0000": "emmc",
+			"/mmc@fe2c0000": "sd",
+			"/spi@fe2b0000": "spi-flash",
+		},
+	},
+}
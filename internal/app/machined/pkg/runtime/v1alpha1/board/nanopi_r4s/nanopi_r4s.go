@@ -2,89 +2,32 @@
 // License, v. 2.0. If a copy of the MPL was not distributed with this
 // file, You can obtain one at http://mozilla.org/MPL/2.0/.
 
-// Package nanopir4s provides the Nano Pi R4S board implementation.
+// Package nanopir4s provides the Friendlyelec Nano Pi R4S board descriptor.
 package nanopir4s
 
 import (
-	"fmt"
-	"log"
-	"os"
-	"path/filepath"
-
-	"github.com/siderolabs/go-procfs/procfs"
-	"golang.org/x/sys/unix"
-
-	"github.com/siderolabs/talos/internal/app/machined/pkg/runtime"
-	"github.com/siderolabs/talos/pkg/copy"
+	"github.com/siderolabs/talos/internal/app/machined/pkg/runtime/v1alpha1/board/descriptor"
+	"github.com/siderolabs/talos/internal/pkg/uboot"
 	"github.com/siderolabs/talos/pkg/machinery/constants"
 )
 
-var (
-	bin       = fmt.Sprintf("/usr/install/arm64/u-boot/%s/u-boot-rockchip.bin", constants.BoardNanoPiR4S)
-	off int64 = 512 * 64
-	dtb       = "/dtb/rockchip/rk3399-nanopi-r4s.dtb"
-)
-
-// NanoPiR4S represents the Friendlyelec Nano Pi R4S board.
+// Descriptor describes the Friendlyelec Nano Pi R4S board.
 //
 // Reference: https://wiki.friendlyelec.com/wiki/index.php/NanoPi_R4S
-type NanoPiR4S struct{}
-
-// Name implements the runtime.Board.
-func (n *NanoPiR4S) Name() string {
-	return constants.BoardNanoPiR4S
-}
-
-// Install implements the runtime.Board.
-func (n *NanoPiR4S) Install(disk string) (err error) {
-	file, err := os.OpenFile(disk, os.O_RDWR|unix.O_CLOEXEC, 0o666)
-	if err != nil {
-		return err
-	}
-
-	defer file.Close() //nolint:errcheck
-
-	uboot, err := os.ReadFile(bin)
-	if err != nil {
-		return err
-	}
-
-	log.Printf("writing %s at offset %d", bin, off)
-
-	amount, err := file.WriteAt(uboot, off)
-	if err != nil {
-		return err
-	}
-
-	log.Printf("wrote %d bytes", amount)
-
-	// NB: In the case that the block device is a loopback device, we sync here
-	// to esure that the file is written before the loopback device is
-	// unmounted.
-	if err := file.Sync(); err != nil {
-		return err
-	}
-
-	src := "/usr/install/arm64" + dtb
-	dst := "/boot/EFI" + dtb
-
-	if err := os.MkdirAll(filepath.Dir(dst), 0o600); err != nil {
-		return err
-	}
-
-	return copy.File(src, dst)
-}
-
-// KernelArgs implements the runtime.Board.
-func (n *NanoPiR4S) KernelArgs() procfs.Parameters {
-	return []*procfs.Parameter{
-		procfs.NewParameter("console").Append("tty0").Append("ttyS2,1500000n8"),
-		procfs.NewParameter("sysctl.kernel.kexec_load_disabled").Append("1"),
-		procfs.NewParameter(constants.KernelParamDashboardDisabled).Append("1"),
-	}
-}
-
-// PartitionOptions implements the runtime.Board.
-func (n *NanoPiR4S) PartitionOptions() *runtime.PartitionOptions {
-	return &runtime.PartitionOptions{PartitionsOffset: 2048 * 10}
+var Descriptor = &descriptor.Descriptor{
+	Name:  constants.BoardNanoPiR4S,
+	Model: "Friendlyelec Nano Pi R4S",
+	Compatible: []string{
+		"friendlyarm,nanopi-r4s",
+	},
+	Bootloader: []uboot.Image{
+		{
+			Path:   "/usr/install/arm64/u-boot/" + constants.BoardNanoPiR4S + "/u-boot-rockchip.bin",
+			Offset: 512 * 64,
+		},
+	},
+	DeviceTree:       "/dtb/rockchip/rk3399-nanopi-r4s.dtb",
+	Console:          []string{"ttyS2,1500000n8"},
+	KexecDisabled:    true,
+	PartitionsOffset: 2048 * 10,
 }
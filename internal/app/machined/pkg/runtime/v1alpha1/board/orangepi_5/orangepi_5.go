@@ -0,0 +1,51 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+// Package orangepi5 provides the Orange Pi 5 board descriptor.
+package orangepi5
+
+import (
+	"github.com/siderolabs/talos/internal/app/machined/pkg/runtime"
+	"github.com/siderolabs/talos/internal/app/machined/pkg/runtime/v1alpha1/board/descriptor"
+	"github.com/siderolabs/talos/internal/pkg/uboot"
+	"github.com/siderolabs/talos/pkg/machinery/constants"
+)
+
+// Descriptor describes the Xunlong Orange Pi 5 board.
+//
+// Reference: http://www.orangepi.org/html/hardWare/computerAndMicrocontrollers/details/Orange-Pi-5.html
+var Descriptor = &descriptor.Descriptor{
+	Name:  constants.BoardOrangePi5,
+	Model: "Orange Pi 5",
+	Compatible: []string{
+		"xunlong,orangepi-5",
+	},
+	Bootloader: []uboot.Image{
+		{
+			Path:   "/usr/install/arm64/u-boot/orangepi_5/u-boot-rockchip.bin",
+			Offset: 512 * 0x40,
+		},
+	},
+	SPIFlash: &uboot.Image{
+		Path: "/usr/install/arm64/u-boot/orangepi_5/u-boot-rockchip-spi.bin",
+	},
+	DeviceTree:       "/dtb/rockchip/rk3588s-orangepi-5.dtb",
+	Console:          []string{"ttyS2,1500000n8"},
+	KexecDisabled:    true,
+	PartitionsOffset: 2048 * 10,
+	Hardware: &runtime.BoardHardware{
+		SOC: "rk3588s",
+		Features: map[string]string{
+			"npu":         "/npu@fdab0000",
+			"sd":          "/mmc@fe2c0000",
+			"spi-flash":   "/spi@fe2b0000",
+			"pcie-m2":     "/pcie@fe190000",
+			"ethernet-1g": "/ethernet@fe1c0000",
+		},
+		BootDevices: map[string]string{
+			"/mmc@fe2c0000": "sd",
+			"/spi@fe2b0000": "spi-flash",
+		},
+	},
+}
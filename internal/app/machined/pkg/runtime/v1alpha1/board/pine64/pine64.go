@@ -2,101 +2,33 @@
 // License, v. 2.0. If a copy of the MPL was not distributed with this
 // file, You can obtain one at http://mozilla.org/MPL/2.0/.
 
-// Package pine64 provides the Pine64 board implementation.
+// Package pine64 provides the Pine64 board descriptor.
 package pine64
 
 import (
-	"fmt"
-	"log"
-	"os"
-	"path/filepath"
-
-	"github.com/siderolabs/go-procfs/procfs"
-	"golang.org/x/sys/unix"
-
-	"github.com/siderolabs/talos/internal/app/machined/pkg/runtime"
-	"github.com/siderolabs/talos/pkg/copy"
+	"github.com/siderolabs/talos/internal/app/machined/pkg/runtime/v1alpha1/board/descriptor"
+	"github.com/siderolabs/talos/internal/pkg/uboot"
 	"github.com/siderolabs/talos/pkg/machinery/constants"
 )
 
-var (
-	bin       = fmt.Sprintf("usr/install/arm64/u-boot/%s/u-boot-sunxi-with-spl.bin", constants.BoardPine64)
-	off int64 = 1024 * 8
-	dtb       = "/dtb/allwinner/sun50i-a64-pine64-plus.dtb"
-)
-
-// Pine64 represents the Pine64 board
+// Descriptor describes the Pine64 board.
 //
 // References:
 //   - http://linux-sunxi.org/Pine64
-type Pine64 struct{}
-
-// Name implements the runtime.Board.
-func (b *Pine64) Name() string {
-	return constants.BoardPine64
-}
-
-// Install implements the runtime.Board.
-func (b Pine64) Install(disk string) (err error) {
-	var f *os.File
-
-	if f, err = os.OpenFile(disk, os.O_RDWR|unix.O_CLOEXEC, 0o666); err != nil {
-		return err
-	}
-	//nolint:errcheck
-	defer f.Close()
-
-	var uboot []byte
-
-	uboot, err = os.ReadFile(bin)
-	if err != nil {
-		return err
-	}
-
-	log.Printf("writing %s at offset %d", bin, off)
-
-	var n int
-
-	n, err = f.WriteAt(uboot, off)
-	if err != nil {
-		return err
-	}
-
-	log.Printf("wrote %d bytes", n)
-
-	// NB: In the case that the block device is a loopback device, we sync here
-	// to esure that the file is written before the loopback device is
-	// unmounted.
-	err = f.Sync()
-	if err != nil {
-		return err
-	}
-
-	src := "/usr/install/arm64" + dtb
-	dst := "/boot/EFI" + dtb
-
-	err = os.MkdirAll(filepath.Dir(dst), 0o600)
-	if err != nil {
-		return err
-	}
-
-	err = copy.File(src, dst)
-	if err != nil {
-		return err
-	}
-
-	return nil
-}
-
-// KernelArgs implements the runtime.Board.
-func (b Pine64) KernelArgs() procfs.Parameters {
-	return []*procfs.Parameter{
-		procfs.NewParameter("console").Append("tty0").Append("ttyS0,115200"),
-		procfs.NewParameter(constants.KernelParamDashboardDisabled).Append("1"),
-	}
-}
-
-// PartitionOptions implements the runtime.Board.
-func (b Pine64) PartitionOptions() *runtime.PartitionOptions {
-	return &runtime.PartitionOptions{PartitionsOffset: 2048}
+var Descriptor = &descriptor.Descriptor{
+	Name:  constants.BoardPine64,
+	Model: "Pine64",
+	Compatible: []string{
+		"pine64,pine64",
+		"pine64,pine64-plus",
+	},
+	Bootloader: []uboot.Image{
+		{
+			Path:   "/usr/install/arm64/u-boot/" + constants.BoardPine64 + "/u-boot-sunxi-with-spl.bin",
+			Offset: 1024 * 8,
+		},
+	},
+	DeviceTree:       "/dtb/allwinner/sun50i-a64-pine64-plus.dtb",
+	Console:          []string{"ttyS0,115200"},
+	PartitionsOffset: 2048,
 }
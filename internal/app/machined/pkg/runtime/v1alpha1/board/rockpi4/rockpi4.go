@@ -2,96 +2,36 @@
 // License, v. 2.0. If a copy of the MPL was not distributed with this
 // file, You can obtain one at http://mozilla.org/MPL/2.0/.
 
-// Package rockpi4 provides the Radxa rock pi implementation.
+// Package rockpi4 provides the Radxa Rock Pi 4 board descriptor.
 package rockpi4
 
 import (
-	"fmt"
-	"log"
-	"os"
-	"path/filepath"
-
-	"github.com/siderolabs/go-procfs/procfs"
-	"golang.org/x/sys/unix"
-
-	"github.com/siderolabs/talos/internal/app/machined/pkg/runtime"
-	"github.com/siderolabs/talos/pkg/copy"
+	"github.com/siderolabs/talos/internal/app/machined/pkg/runtime/v1alpha1/board/descriptor"
+	"github.com/siderolabs/talos/internal/pkg/uboot"
 	"github.com/siderolabs/talos/pkg/machinery/constants"
 )
 
-var (
-	bin       = fmt.Sprintf("/usr/install/arm64/u-boot/%s/u-boot-rockchip.bin", constants.BoardRockpi4)
-	off int64 = 512 * 64
-	// https://github.com/u-boot/u-boot/blob/4de720e98d552dfda9278516bf788c4a73b3e56f/configs/rock-pi-4-rk3399_defconfig#L7=
-	// 4a and 4b uses the same overlay.
-	dtb = "/dtb/rockchip/rk3399-rock-pi-4b.dtb"
-)
-
-// Rockpi4 represents the Radxa rock pi board.
+// Descriptor describes the Radxa Rock Pi 4 board.
 //
 // Reference: https://rockpi.org/
-type Rockpi4 struct{}
-
-// Name implements the runtime.Board.
-func (r *Rockpi4) Name() string {
-	return constants.BoardRockpi4
-}
-
-// Install implements the runtime.Board.
-func (r *Rockpi4) Install(disk string) (err error) {
-	var f *os.File
-
-	if f, err = os.OpenFile(disk, os.O_RDWR|unix.O_CLOEXEC, 0o666); err != nil {
-		return err
-	}
-
-	defer f.Close() //nolint:errcheck
-
-	uboot, err := os.ReadFile(bin)
-	if err != nil {
-		return err
-	}
-
-	log.Printf("writing %s at offset %d", bin, off)
-
-	var n int
-
-	n, err = f.WriteAt(uboot, off)
-	if err != nil {
-		return err
-	}
-
-	log.Printf("wrote %d bytes", n)
-
-	// NB: In the case that the block device is a loopback device, we sync here
-	// to esure that the file is written before the loopback device is
-	// unmounted.
-	err = f.Sync()
-	if err != nil {
-		return err
-	}
-
-	src := "/usr/install/arm64" + dtb
-	dst := "/boot/EFI" + dtb
-
-	err = os.MkdirAll(filepath.Dir(dst), 0o600)
-	if err != nil {
-		return err
-	}
-
-	return copy.File(src, dst)
-}
-
-// KernelArgs implements the runtime.Board.
-func (r *Rockpi4) KernelArgs() procfs.Parameters {
-	return []*procfs.Parameter{
-		procfs.NewParameter("console").Append("tty0").Append("ttyS2,1500000n8"),
-		procfs.NewParameter("sysctl.kernel.kexec_load_disabled").Append("1"),
-		procfs.NewParameter(constants.KernelParamDashboardDisabled).Append("1"),
-	}
-}
-
-// PartitionOptions implements the runtime.Board.
-func (r *Rockpi4) PartitionOptions() *runtime.PartitionOptions {
-	return &runtime.PartitionOptions{PartitionsOffset: 2048 * 10}
+var Descriptor = &descriptor.Descriptor{
+	Name:  constants.BoardRockpi4,
+	Model: "Radxa Rock Pi 4",
+	Compatible: []string{
+		"radxa,rockpi4a",
+		"radxa,rockpi4b",
+		"radxa,rockpi4",
+	},
+	Bootloader: []uboot.Image{
+		{
+			Path:   "/usr/install/arm64/u-boot/" + constants.BoardRockpi4 + "/u-boot-rockchip.bin",
+			Offset: 512 * 64,
+		},
+	},
+	// https://github.com/u-boot/u-boot/blob/4de720e98d552dfda9278516bf788c4a73b3e56f/configs/rock-pi-4-rk3399_defconfig#L7=
+	// 4a and 4b uses the same overlay.
+	DeviceTree:       "/dtb/rockchip/rk3399-rock-pi-4b.dtb",
+	Console:          []string{"ttyS2,1500000n8"},
+	KexecDisabled:    true,
+	PartitionsOffset: 2048 * 10,
 }
@@ -2,95 +2,33 @@
 // License, v. 2.0. If a copy of the MPL was not distributed with this
 // file, You can obtain one at http://mozilla.org/MPL/2.0/.
 
-// Package rockpi4c provides the Radxa rock pi implementation.
+// Package rockpi4c provides the Radxa Rock Pi 4C board descriptor.
 package rockpi4c
 
 import (
-	"fmt"
-	"log"
-	"os"
-	"path/filepath"
-
-	"github.com/siderolabs/go-procfs/procfs"
-	"golang.org/x/sys/unix"
-
-	"github.com/siderolabs/talos/internal/app/machined/pkg/runtime"
-	"github.com/siderolabs/talos/pkg/copy"
+	"github.com/siderolabs/talos/internal/app/machined/pkg/runtime/v1alpha1/board/descriptor"
+	"github.com/siderolabs/talos/internal/pkg/uboot"
 	"github.com/siderolabs/talos/pkg/machinery/constants"
 )
 
-var (
-	bin       = fmt.Sprintf("/usr/install/arm64/u-boot/%s/u-boot-rockchip.bin", constants.BoardRockpi4)
-	off int64 = 512 * 64
-	// https://github.com/u-boot/u-boot/blob/4de720e98d552dfda9278516bf788c4a73b3e56f/configs/rock-pi-4c-rk3399_defconfig#L7=
-	dtb = "/dtb/rockchip/rk3399-rock-pi-4c.dtb"
-)
-
-// Rockpi4c represents the Radxa rock pi board.
+// Descriptor describes the Radxa Rock Pi 4C board.
 //
 // Reference: https://rockpi.org/
-type Rockpi4c struct{}
-
-// Name implements the runtime.Board.
-func (r *Rockpi4c) Name() string {
-	return constants.BoardRockpi4
-}
-
-// Install implements the runtime.Board.
-func (r *Rockpi4c) Install(disk string) (err error) {
-	var f *os.File
-
-	if f, err = os.OpenFile(disk, os.O_RDWR|unix.O_CLOEXEC, 0o666); err != nil {
-		return err
-	}
-
-	defer f.Close() //nolint:errcheck
-
-	uboot, err := os.ReadFile(bin)
-	if err != nil {
-		return err
-	}
-
-	log.Printf("writing %s at offset %d", bin, off)
-
-	var n int
-
-	n, err = f.WriteAt(uboot, off)
-	if err != nil {
-		return err
-	}
-
-	log.Printf("wrote %d bytes", n)
-
-	// NB: In the case that the block device is a loopback device, we sync here
-	// to esure that the file is written before the loopback device is
-	// unmounted.
-	err = f.Sync()
-	if err != nil {
-		return err
-	}
-
-	src := "/usr/install/arm64" + dtb
-	dst := "/boot/EFI" + dtb
-
-	err = os.MkdirAll(filepath.Dir(dst), 0o600)
-	if err != nil {
-		return err
-	}
-
-	return copy.File(src, dst)
-}
-
-// KernelArgs implements the runtime.Board.
-func (r *Rockpi4c) KernelArgs() procfs.Parameters {
-	return []*procfs.Parameter{
-		procfs.NewParameter("console").Append("tty0").Append("ttyS2,1500000n8"),
-		procfs.NewParameter("sysctl.kernel.kexec_load_disabled").Append("1"),
-		procfs.NewParameter(constants.KernelParamDashboardDisabled).Append("1"),
-	}
-}
-
-// PartitionOptions implements the runtime.Board.
-func (r *Rockpi4c) PartitionOptions() *runtime.PartitionOptions {
-	return &runtime.PartitionOptions{PartitionsOffset: 2048 * 10}
+var Descriptor = &descriptor.Descriptor{
+	Name:  constants.BoardRockpi4c,
+	Model: "Radxa Rock Pi 4C",
+	Compatible: []string{
+		"radxa,rockpi4c",
+	},
+	Bootloader: []uboot.Image{
+		{
+			Path:   "/usr/install/arm64/u-boot/" + constants.BoardRockpi4c + "/u-boot-rockchip.bin",
+			Offset: 512 * 64,
+		},
+	},
+	// https://github.com/u-boot/u-boot/blob/4de720e98d552dfda9278516bf788c4a73b3e56f/configs/rock-pi-4c-rk3399_defconfig#L7=
+	DeviceTree:       "/dtb/rockchip/rk3399-rock-pi-4c.dtb",
+	Console:          []string{"ttyS2,1500000n8"},
+	KexecDisabled:    true,
+	PartitionsOffset: 2048 * 10,
 }
@@ -19,6 +19,11 @@ import (
 //go:embed config.txt
 var configTxt []byte
 
+// Compatible lists the device tree compatible strings of the board.
+var Compatible = []string{
+	"raspberrypi,4-model-b",
+}
+
 // RPi4 represents the Raspberry Pi 4 Model B.
 //
 // Reference: https://www.raspberrypi.org/products/raspberry-pi-4-model-b/
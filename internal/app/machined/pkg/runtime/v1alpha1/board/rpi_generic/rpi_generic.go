@@ -19,6 +19,14 @@ import (
 //go:embed config.txt
 var configTxt []byte
 
+// Compatible lists the device tree compatible strings of the board.
+var Compatible = []string{
+	"raspberrypi,4-compute-module",
+	"raspberrypi,400",
+	"raspberrypi,3-model-b-plus",
+	"raspberrypi,3-model-b",
+}
+
 // RPiGeneric represents the Raspberry Pi Compute Module 4.
 //
 // Reference: https://www.raspberrypi.com/products/compute-module-4/
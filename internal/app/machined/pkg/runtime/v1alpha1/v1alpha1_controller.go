@@ -16,6 +16,7 @@ import (
 	"syscall"
 	"time"
 
+	"github.com/cosi-project/runtime/pkg/state"
 	"golang.org/x/sync/errgroup"
 
 	"github.com/siderolabs/talos/internal/app/machined/pkg/runtime"
@@ -24,6 +25,7 @@ import (
 	"github.com/siderolabs/talos/internal/app/machined/pkg/runtime/v1alpha2"
 	"github.com/siderolabs/talos/pkg/machinery/api/common"
 	"github.com/siderolabs/talos/pkg/machinery/api/machine"
+	resourceruntime "github.com/siderolabs/talos/pkg/machinery/resources/runtime"
 )
 
 // Controller represents the controller responsible for managing the execution
@@ -106,6 +108,10 @@ func (c *Controller) Run(ctx context.Context, seq runtime.Sequence, data interfa
 
 	err = c.run(ctx, seq, phases, data)
 	if err != nil {
+		if !runtime.IsRebootError(err) {
+			c.rearmWatchdog()
+		}
+
 		code := common.Code_FATAL
 
 		if errors.Is(err, context.Canceled) {
@@ -127,6 +133,16 @@ func (c *Controller) Run(ctx context.Context, seq runtime.Sequence, data interfa
 	return nil
 }
 
+// rearmWatchdog removes the watchdog disarm signal which might be left by the failed reboot or shutdown sequence,
+// so that the watchdog is armed again while the machine keeps running.
+func (c *Controller) rearmWatchdog() {
+	err := c.r.State().V1Alpha2().Resources().Destroy(context.Background(),
+		resourceruntime.NewWatchdogTimerDisarmSignal(resourceruntime.NamespaceName, resourceruntime.WatchdogTimerDisarmSignalID).Metadata())
+	if err != nil && !state.IsNotFoundError(err) {
+		log.Printf("failed to rearm the watchdog: %s", err)
+	}
+}
+
 // V1Alpha2 implements the controller interface.
 func (c *Controller) V1Alpha2() runtime.V1Alpha2Controller {
 	return c.v2
@@ -20,6 +20,7 @@ import (
 	"github.com/siderolabs/talos/internal/app/machined/pkg/system/services"
 	"github.com/siderolabs/talos/pkg/machinery/config"
 	"github.com/siderolabs/talos/pkg/machinery/config/configloader"
+	"github.com/siderolabs/talos/pkg/machinery/config/secretref"
 	"github.com/siderolabs/talos/pkg/machinery/config/types/v1alpha1"
 	"github.com/siderolabs/talos/pkg/machinery/resources/k8s"
 )
@@ -33,6 +34,9 @@ type Runtime struct {
 
 	rollbackTimerMu sync.Mutex
 	rollbackTimer   *time.Timer
+	rollbackCancel  context.CancelFunc
+	// rollbackGeneration identifies the current try, so that the callbacks of the previous tries are ignored
+	rollbackGeneration uint64
 }
 
 // NewRuntime initializes and returns the v1alpha1 runtime.
@@ -52,7 +56,7 @@ func (r *Runtime) Config() config.Provider {
 
 // LoadAndValidateConfig implements the Runtime interface.
 func (r *Runtime) LoadAndValidateConfig(b []byte) (config.Provider, error) {
-	cfg, err := configloader.NewFromBytes(b)
+	cfg, err := configloader.NewFromBytes(b, configloader.WithSecretReferences(secretref.DefaultIdentities))
 	if err != nil {
 		return nil, fmt.Errorf("failed to parse config: %w", err)
 	}
@@ -65,34 +69,83 @@ func (r *Runtime) LoadAndValidateConfig(b []byte) (config.Provider, error) {
 }
 
 // RollbackToConfigAfter implements the Runtime interface.
-func (r *Runtime) RollbackToConfigAfter(cfg []byte, timeout time.Duration) error {
+func (r *Runtime) RollbackToConfigAfter(cfg []byte, timeout time.Duration, conditions runtime.TryConditions) error {
 	cfgProvider, err := r.LoadAndValidateConfig(cfg)
 	if err != nil {
 		return err
 	}
 
-	r.CancelConfigRollbackTimeout()
+	r.rollbackTimerMu.Lock()
+	defer r.rollbackTimerMu.Unlock()
 
-	r.rollbackTimer = time.AfterFunc(timeout, func() {
+	r.cancelConfigRollbackTimeout()
+
+	rollback := func() {
 		log.Println("rolling back the configuration")
 
 		if err := r.SetConfig(cfgProvider); err != nil {
 			log.Printf("config rollback failed %s", err)
 		}
-	})
+	}
+
+	generation := r.armRollbackTimer(timeout, rollback)
+
+	if !conditions.Empty() {
+		var ctx context.Context
+
+		ctx, r.rollbackCancel = context.WithTimeout(context.Background(), timeout)
+
+		go r.watchTryConditions(ctx, generation, conditions, rollback)
+	}
 
 	return nil
 }
 
+// armRollbackTimer starts the rollback timer for the new try and returns the generation of the try.
+//
+// The rollback runs only if the try is still the current one when the timer fires.
+// It should be called with the rollbackTimerMu held.
+func (r *Runtime) armRollbackTimer(timeout time.Duration, rollback func()) uint64 {
+	generation := r.rollbackGeneration
+
+	r.rollbackTimer = time.AfterFunc(timeout, func() {
+		// the rollback is no longer pending once the timer fires
+		if r.finishTry(generation) {
+			rollback()
+		}
+	})
+
+	return generation
+}
+
+// ConfigRollbackPending implements the Runtime interface.
+func (r *Runtime) ConfigRollbackPending() bool {
+	r.rollbackTimerMu.Lock()
+	defer r.rollbackTimerMu.Unlock()
+
+	return r.rollbackTimer != nil
+}
+
 // CancelConfigRollbackTimeout implements the Runtime interface.
 func (r *Runtime) CancelConfigRollbackTimeout() {
 	r.rollbackTimerMu.Lock()
 	defer r.rollbackTimerMu.Unlock()
 
+	r.cancelConfigRollbackTimeout()
+}
+
+func (r *Runtime) cancelConfigRollbackTimeout() {
+	r.rollbackGeneration++
+
 	if r.rollbackTimer != nil {
 		r.rollbackTimer.Stop()
 		r.rollbackTimer = nil
 	}
+
+	if r.rollbackCancel != nil {
+		r.rollbackCancel()
+		r.rollbackCancel = nil
+	}
 }
 
 // SetConfig implements the Runtime interface.
@@ -135,6 +188,8 @@ func (r *Runtime) CanApplyImmediate(cfg config.Provider) error {
 	// * .machine.seccompProfiles
 	// * .machine.nodeLabels
 	// * .machine.features.kubernetesTalosAPIAccess
+	//
+	// the standalone documents configure only the network and the registries, so they are not compared
 	newConfig.ConfigDebug = currentConfig.ConfigDebug
 	newConfig.ClusterConfig = currentConfig.ClusterConfig
 
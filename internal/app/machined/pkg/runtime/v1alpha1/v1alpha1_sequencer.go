@@ -132,6 +132,9 @@ func (*Sequencer) Initialize(r runtime.Runtime) []runtime.Phase {
 			},
 			"mountSystem",
 			MountStatePartition,
+		).Append(
+			"secretKey",
+			ProvisionSecretKey,
 		).Append(
 			"config",
 			LoadConfig,
@@ -198,6 +201,9 @@ func (*Sequencer) Install(r runtime.Runtime) []runtime.Phase {
 			).Append(
 				"unmountBoot",
 				UnmountBootPartition,
+			).Append(
+				"disarmWatchdog",
+				DisarmWatchdog,
 			).Append(
 				"reboot",
 				Reboot,
@@ -306,6 +312,7 @@ func (*Sequencer) Reboot(r runtime.Runtime) []runtime.Phase {
 		StopDBus,
 	).
 		AppendList(stopAllPhaselist(r, true)).
+		Append("disarmWatchdog", DisarmWatchdog).
 		Append("reboot", Reboot)
 
 	return phases
@@ -382,6 +389,9 @@ func (*Sequencer) Reset(r runtime.Runtime, in runtime.ResetOptions) []runtime.Ph
 			len(in.GetUserDisksToWipe()) > 0 && resetUserDisks,
 			"resetUserDisks",
 			ResetUserDisks,
+		).Append(
+			"disarmWatchdog",
+			DisarmWatchdog,
 		).AppendWhen(
 			in.GetReboot(),
 			"reboot",
@@ -410,6 +420,7 @@ func (*Sequencer) Shutdown(r runtime.Runtime, in *machineapi.ShutdownRequest) []
 		StopDBus,
 	).
 		AppendList(stopAllPhaselist(r, false)).
+		Append("disarmWatchdog", DisarmWatchdog).
 		Append("shutdown", Shutdown)
 
 	return phases
@@ -435,6 +446,9 @@ func (*Sequencer) StageUpgrade(r runtime.Runtime, in *machineapi.UpgradeRequest)
 			LeaveEtcd,
 		).AppendList(
 			stopAllPhaselist(r, true),
+		).Append(
+			"disarmWatchdog",
+			DisarmWatchdog,
 		).Append(
 			"reboot",
 			Reboot,
@@ -476,6 +490,9 @@ func (*Sequencer) MaintenanceUpgrade(r runtime.Runtime, _ *machineapi.UpgradeReq
 		).Append(
 			"stopEverything",
 			StopAllServices,
+		).Append(
+			"disarmWatchdog",
+			DisarmWatchdog,
 		).Append(
 			"reboot",
 			Reboot,
@@ -550,6 +567,9 @@ func (*Sequencer) Upgrade(r runtime.Runtime, in *machineapi.UpgradeRequest) []ru
 		).Append(
 			"stopEverything",
 			StopAllServices,
+		).Append(
+			"disarmWatchdog",
+			DisarmWatchdog,
 		).Append(
 			"reboot",
 			Reboot,
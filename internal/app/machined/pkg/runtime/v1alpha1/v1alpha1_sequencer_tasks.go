@@ -53,6 +53,7 @@ import (
 	"github.com/siderolabs/talos/internal/app/machined/pkg/system/events"
 	"github.com/siderolabs/talos/internal/app/machined/pkg/system/services"
 	"github.com/siderolabs/talos/internal/app/maintenance"
+	"github.com/siderolabs/talos/internal/pkg/confighistory"
 	"github.com/siderolabs/talos/internal/pkg/console"
 	"github.com/siderolabs/talos/internal/pkg/cri"
 	"github.com/siderolabs/talos/internal/pkg/environment"
@@ -62,6 +63,8 @@ import (
 	"github.com/siderolabs/talos/internal/pkg/meta"
 	"github.com/siderolabs/talos/internal/pkg/mount"
 	"github.com/siderolabs/talos/internal/pkg/partition"
+	"github.com/siderolabs/talos/internal/pkg/secretkey"
+	"github.com/siderolabs/talos/internal/pkg/uboot"
 	"github.com/siderolabs/talos/pkg/conditions"
 	"github.com/siderolabs/talos/pkg/images"
 	krnl "github.com/siderolabs/talos/pkg/kernel"
@@ -70,6 +73,7 @@ import (
 	machineapi "github.com/siderolabs/talos/pkg/machinery/api/machine"
 	"github.com/siderolabs/talos/pkg/machinery/config"
 	"github.com/siderolabs/talos/pkg/machinery/config/configloader"
+	"github.com/siderolabs/talos/pkg/machinery/config/secretref"
 	"github.com/siderolabs/talos/pkg/machinery/config/types/v1alpha1"
 	"github.com/siderolabs/talos/pkg/machinery/config/types/v1alpha1/machine"
 	"github.com/siderolabs/talos/pkg/machinery/constants"
@@ -537,7 +541,7 @@ func LoadConfig(runtime.Sequence, any) (runtime.TaskExecutionFunc, string) {
 			return r.SetConfig(cfg)
 		}
 
-		cfg, err := configloader.NewFromFile(constants.ConfigPath)
+		cfg, err := configloader.NewFromFile(constants.ConfigPath, configloader.WithSecretReferences(secretref.DefaultIdentities))
 		if err != nil {
 			logger.Printf("downloading config")
 
@@ -575,7 +579,16 @@ func SaveConfig(runtime.Sequence, any) (runtime.TaskExecutionFunc, string) {
 			return err
 		}
 
-		return os.WriteFile(constants.ConfigPath, b, 0o600)
+		if err = os.WriteFile(constants.ConfigPath, b, 0o600); err != nil {
+			return err
+		}
+
+		// the config is recorded only if it differs from the latest revision
+		if _, err = confighistory.New(constants.ConfigHistoryPath, constants.ConfigHistoryDepth).Record(b, confighistory.Revision{Mode: confighistory.ModeBoot}); err != nil {
+			logger.Printf("error recording config history: %s", err)
+		}
+
+		return nil
 	}, "saveConfig"
 }
 
@@ -629,7 +642,8 @@ func receiveConfigViaMaintenanceService(ctx context.Context, logger *log.Logger,
 		return nil, fmt.Errorf("maintenance service failed: %w", err)
 	}
 
-	provider, err := configloader.NewFromBytes(cfgBytes)
+	// the config is validated by the maintenance service without resolving the secret references
+	provider, err := configloader.NewFromBytes(cfgBytes, configloader.WithSecretReferences(secretref.DefaultIdentities))
 	if err != nil {
 		return nil, fmt.Errorf("failed to create config provider: %w", err)
 	}
@@ -1989,10 +2003,54 @@ func UpdateBootloader(runtime.Sequence, any) (runtime.TaskExecutionFunc, string)
 			}
 		}
 
+		// U-Boot is written by the installer during the upgrade, verify that the installed version is the one which booted
+		version, ok := r.State().Machine().Meta().ReadTag(meta.BootloaderVersion)
+		if !ok {
+			return nil
+		}
+
+		booted, err := uboot.BootedVersion()
+
+		switch {
+		case err != nil:
+			logger.Printf("failed to read the running U-Boot version: %s", err)
+		case booted == "":
+			logger.Printf("installed U-Boot version %s", version)
+		case booted != version:
+			logger.Printf("WARNING: running U-Boot version %s doesn't match the installed version %s", booted, version)
+		default:
+			logger.Printf("running U-Boot version %s", booted)
+		}
+
 		return nil
 	}, "updateBootloader"
 }
 
+// DisarmWatchdog represents the task for disarming the watchdog timer before the machine is rebooted or shut down.
+func DisarmWatchdog(runtime.Sequence, any) (runtime.TaskExecutionFunc, string) {
+	return func(ctx context.Context, logger *log.Logger, r runtime.Runtime) (err error) {
+		st := r.State().V1Alpha2().Resources()
+
+		if err = st.Create(ctx, resourceruntime.NewWatchdogTimerDisarmSignal(resourceruntime.NamespaceName, resourceruntime.WatchdogTimerDisarmSignalID)); err != nil && !state.IsConflictError(err) {
+			return err
+		}
+
+		ctx, cancel := context.WithTimeout(ctx, 10*time.Second)
+		defer cancel()
+
+		// the watchdog status is removed once the timer is disarmed
+		_, err = st.WatchFor(ctx,
+			resourceruntime.NewWatchdogTimerStatus(resourceruntime.NamespaceName, resourceruntime.WatchdogTimerStatusID).Metadata(),
+			state.WithEventTypes(state.Destroyed),
+		)
+		if err != nil {
+			return fmt.Errorf("error waiting for the watchdog to be disarmed: %w", err)
+		}
+
+		return nil
+	}, "disarmWatchdog"
+}
+
 // Reboot represents the Reboot task.
 func Reboot(runtime.Sequence, any) (runtime.TaskExecutionFunc, string) {
 	return func(ctx context.Context, logger *log.Logger, r runtime.Runtime) (err error) {
@@ -2184,6 +2242,7 @@ func Install(runtime.Sequence, any) (runtime.TaskExecutionFunc, string) {
 				install.WithForce(true),
 				install.WithZero(r.Config().Machine().Install().Zero()),
 				install.WithExtraKernelArgs(r.Config().Machine().Install().ExtraKernelArgs()),
+				install.WithRecordedBoard(r.State().Machine().Meta()),
 			)
 			if err != nil {
 				platform.FireEvent(
@@ -2464,6 +2523,18 @@ func FlushMeta(runtime.Sequence, any) (runtime.TaskExecutionFunc, string) {
 	}, "flushMeta"
 }
 
+// ProvisionSecretKey writes the secret key stored in META to the STATE, so that the secret references in the config can be resolved.
+func ProvisionSecretKey(runtime.Sequence, any) (runtime.TaskExecutionFunc, string) {
+	return func(ctx context.Context, logger *log.Logger, r runtime.Runtime) error {
+		// the config might not need the key, so the broken key is reported when the config is loaded
+		if err := secretkey.Provision(r.State().Machine().Meta()); err != nil {
+			logger.Printf("error provisioning secret key: %s", err)
+		}
+
+		return nil
+	}, "provisionSecretKey"
+}
+
 func pauseOnFailure(callback func(runtime.Sequence, any) (runtime.TaskExecutionFunc, string),
 	timeout time.Duration,
 ) func(seq runtime.Sequence, data any) (runtime.TaskExecutionFunc, string) {
@@ -0,0 +1,249 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package v1alpha1
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"os"
+	"time"
+
+	"github.com/cosi-project/runtime/pkg/safe"
+	"github.com/cosi-project/runtime/pkg/state"
+
+	"github.com/siderolabs/talos/internal/app/machined/pkg/runtime"
+	"github.com/siderolabs/talos/internal/pkg/confighistory"
+	"github.com/siderolabs/talos/pkg/machinery/constants"
+	"github.com/siderolabs/talos/pkg/machinery/resources/network"
+	"github.com/siderolabs/talos/pkg/machinery/resources/v1alpha1"
+)
+
+// tryConditionsInterval is the interval between the checks of the try mode conditions.
+const tryConditionsInterval = time.Second
+
+type tryConditionsStatus int
+
+const (
+	tryConditionsPending tryConditionsStatus = iota
+	tryConditionsHealthy
+	tryConditionsFailed
+)
+
+// checkTryConditions evaluates the try mode conditions.
+//
+// The message describes the condition which is pending or failed.
+func checkTryConditions(ctx context.Context, st state.State, conditions runtime.TryConditions) (tryConditionsStatus, string, error) {
+	status := tryConditionsHealthy
+	message := ""
+
+	pending := func(msg string) {
+		if status == tryConditionsHealthy {
+			status, message = tryConditionsPending, msg
+		}
+	}
+
+	for _, id := range conditions.Probes {
+		probe, err := safe.StateGet[*network.ProbeStatus](ctx, st, network.NewProbeStatus(network.NamespaceName, id).Metadata())
+		if err != nil {
+			if state.IsNotFoundError(err) {
+				pending(fmt.Sprintf("probe %q has no status yet", id))
+
+				continue
+			}
+
+			return 0, "", fmt.Errorf("error getting probe status %q: %w", id, err)
+		}
+
+		if !probe.TypedSpec().Success {
+			return tryConditionsFailed, fmt.Sprintf("probe %q failed: %s", id, probe.TypedSpec().LastError), nil
+		}
+	}
+
+	for _, id := range conditions.Services {
+		service, err := safe.StateGet[*v1alpha1.Service](ctx, st, v1alpha1.NewService(id).Metadata())
+		if err != nil {
+			if state.IsNotFoundError(err) {
+				pending(fmt.Sprintf("service %q is not running", id))
+
+				continue
+			}
+
+			return 0, "", fmt.Errorf("error getting service %q: %w", id, err)
+		}
+
+		spec := service.TypedSpec()
+
+		// the health of the services without health checks is always unknown, so they are only required to be running
+		switch {
+		case spec.Healthy:
+		case spec.Running && spec.Unknown:
+		case spec.Running:
+			return tryConditionsFailed, fmt.Sprintf("service %q is not healthy", id), nil
+		default:
+			pending(fmt.Sprintf("service %q is not healthy yet", id))
+		}
+	}
+
+	return status, message, nil
+}
+
+// tryDecision is the outcome of the try mode conditions.
+type tryDecision int
+
+const (
+	tryContinue tryDecision = iota
+	tryRollback
+	tryCommit
+)
+
+// tryConditionsTracker turns the sequence of the condition checks into the try mode outcome.
+//
+// Failures within the grace period after the config apply are ignored, and the config is rolled back
+// only after the number of consecutive failures reaches the threshold, so that a transient failure
+// (e.g. a service restarting with the new config) doesn't revert the config.
+type tryConditionsTracker struct {
+	conditions runtime.TryConditions
+
+	started      time.Time
+	failures     int
+	healthySince time.Time
+}
+
+func newTryConditionsTracker(conditions runtime.TryConditions, started time.Time) *tryConditionsTracker {
+	return &tryConditionsTracker{
+		conditions: conditions,
+		started:    started,
+	}
+}
+
+// observe records the result of the check done at the moment.
+func (t *tryConditionsTracker) observe(now time.Time, status tryConditionsStatus) tryDecision {
+	switch status {
+	case tryConditionsFailed:
+		t.healthySince = time.Time{}
+
+		if now.Sub(t.started) < t.conditions.GracePeriod {
+			return tryContinue
+		}
+
+		t.failures++
+
+		if t.failures >= t.conditions.FailureThreshold {
+			return tryRollback
+		}
+	case tryConditionsPending:
+		t.healthySince = time.Time{}
+	case tryConditionsHealthy:
+		t.failures = 0
+
+		if !t.conditions.CommitOnHealthy {
+			return tryContinue
+		}
+
+		if t.healthySince.IsZero() {
+			t.healthySince = now
+		}
+
+		if now.Sub(t.healthySince) >= t.conditions.HealthyPeriod {
+			return tryCommit
+		}
+	}
+
+	return tryContinue
+}
+
+// watchTryConditions evaluates the try mode conditions until the context is canceled.
+//
+// The config is rolled back once the conditions fail (see tryConditionsTracker), and it is committed once the conditions
+// stay healthy for the healthy period (if requested).
+func (r *Runtime) watchTryConditions(ctx context.Context, generation uint64, conditions runtime.TryConditions, rollback func()) {
+	ticker := time.NewTicker(tryConditionsInterval)
+	defer ticker.Stop()
+
+	tracker := newTryConditionsTracker(conditions, time.Now())
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+
+		status, message, err := checkTryConditions(ctx, r.s.V1Alpha2().Resources(), conditions)
+		if err != nil {
+			if ctx.Err() == nil {
+				log.Printf("error checking try mode conditions: %s", err)
+			}
+
+			continue
+		}
+
+		decision := tracker.observe(time.Now(), status)
+
+		if decision == tryContinue && status == tryConditionsFailed && tracker.failures > 0 {
+			log.Printf("try mode condition failed (%d/%d): %s", tracker.failures, conditions.FailureThreshold, message)
+		}
+
+		switch decision {
+		case tryContinue:
+		case tryRollback:
+			if !r.finishTry(generation) {
+				return
+			}
+
+			log.Printf("try mode condition failed: %s", message)
+
+			rollback()
+
+			return
+		case tryCommit:
+			if !r.finishTry(generation) {
+				return
+			}
+
+			log.Println("try mode conditions are healthy, committing the configuration")
+
+			if err = r.commitConfig(conditions.Revision); err != nil {
+				log.Printf("config commit failed %s", err)
+			}
+
+			return
+		}
+	}
+}
+
+// finishTry stops the rollback timer of the try, it returns false if the try is already over or replaced with another one.
+func (r *Runtime) finishTry(generation uint64) bool {
+	r.rollbackTimerMu.Lock()
+	defer r.rollbackTimerMu.Unlock()
+
+	if r.rollbackTimer == nil || r.rollbackGeneration != generation {
+		return false
+	}
+
+	r.cancelConfigRollbackTimeout()
+
+	return true
+}
+
+// commitConfig persists the active config, so that it is used after a reboot.
+func (r *Runtime) commitConfig(rev confighistory.Revision) error {
+	cfg, err := r.Config().Bytes()
+	if err != nil {
+		return err
+	}
+
+	if err = os.WriteFile(constants.ConfigPath, cfg, 0o600); err != nil {
+		return err
+	}
+
+	if _, err = confighistory.New(constants.ConfigHistoryPath, constants.ConfigHistoryDepth).Record(cfg, rev); err != nil {
+		log.Printf("error recording config history: %s", err)
+	}
+
+	// the committed config replaces the staged config
+	return r.State().V1Alpha2().SetStagedConfig(nil)
+}
@@ -0,0 +1,190 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+//nolint:testpackage
+package v1alpha1
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/cosi-project/runtime/pkg/state"
+	"github.com/cosi-project/runtime/pkg/state/impl/inmem"
+	"github.com/cosi-project/runtime/pkg/state/impl/namespaced"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/siderolabs/talos/internal/app/machined/pkg/runtime"
+	"github.com/siderolabs/talos/pkg/machinery/resources/network"
+	"github.com/siderolabs/talos/pkg/machinery/resources/v1alpha1"
+)
+
+func TestCheckTryConditions(t *testing.T) {
+	ctx := context.Background()
+	st := state.WrapCore(namespaced.NewState(inmem.Build))
+
+	conditions := runtime.TryConditions{
+		Probes:   []string{"tcp:192.168.1.1:80"},
+		Services: []string{"apid", "kubelet"},
+	}
+
+	check := func(expected tryConditionsStatus, expectedMessage string) {
+		t.Helper()
+
+		status, message, err := checkTryConditions(ctx, st, conditions)
+		require.NoError(t, err)
+
+		assert.Equal(t, expected, status)
+		assert.Equal(t, expectedMessage, message)
+	}
+
+	check(tryConditionsPending, `probe "tcp:192.168.1.1:80" has no status yet`)
+
+	probe := network.NewProbeStatus(network.NamespaceName, "tcp:192.168.1.1:80")
+	probe.TypedSpec().Success = true
+	require.NoError(t, st.Create(ctx, probe))
+
+	apid := v1alpha1.NewService("apid")
+	apid.TypedSpec().Running = true
+	apid.TypedSpec().Healthy = true
+	require.NoError(t, st.Create(ctx, apid))
+
+	check(tryConditionsPending, `service "kubelet" is not running`)
+
+	kubelet := v1alpha1.NewService("kubelet")
+	kubelet.TypedSpec().Unknown = true
+	require.NoError(t, st.Create(ctx, kubelet))
+
+	check(tryConditionsPending, `service "kubelet" is not healthy yet`)
+
+	// running service without the health check
+	kubelet.TypedSpec().Running = true
+	require.NoError(t, st.Update(ctx, kubelet))
+
+	check(tryConditionsHealthy, "")
+
+	kubelet.TypedSpec().Unknown = false
+	kubelet.TypedSpec().Healthy = true
+	require.NoError(t, st.Update(ctx, kubelet))
+
+	check(tryConditionsHealthy, "")
+
+	kubelet.TypedSpec().Healthy = false
+	require.NoError(t, st.Update(ctx, kubelet))
+
+	check(tryConditionsFailed, `service "kubelet" is not healthy`)
+
+	probe.TypedSpec().Success = false
+	probe.TypedSpec().LastError = "connection refused"
+	require.NoError(t, st.Update(ctx, probe))
+
+	check(tryConditionsFailed, `probe "tcp:192.168.1.1:80" failed: connection refused`)
+}
+
+func TestTryConditionsTracker(t *testing.T) {
+	started := time.Now()
+
+	at := func(seconds int) time.Time {
+		return started.Add(time.Duration(seconds) * time.Second)
+	}
+
+	conditions := runtime.TryConditions{
+		Services:         []string{"apid"},
+		CommitOnHealthy:  true,
+		HealthyPeriod:    5 * time.Second,
+		GracePeriod:      3 * time.Second,
+		FailureThreshold: 3,
+	}
+
+	t.Run("failure within grace period", func(t *testing.T) {
+		tracker := newTryConditionsTracker(conditions, started)
+
+		for i := 0; i < 3; i++ {
+			assert.Equal(t, tryContinue, tracker.observe(at(i), tryConditionsFailed))
+		}
+
+		assert.Equal(t, tryContinue, tracker.observe(at(3), tryConditionsFailed))
+		assert.Equal(t, tryContinue, tracker.observe(at(4), tryConditionsFailed))
+		assert.Equal(t, tryRollback, tracker.observe(at(5), tryConditionsFailed))
+	})
+
+	t.Run("transient failure recovers", func(t *testing.T) {
+		tracker := newTryConditionsTracker(conditions, started)
+
+		assert.Equal(t, tryContinue, tracker.observe(at(4), tryConditionsFailed))
+		assert.Equal(t, tryContinue, tracker.observe(at(5), tryConditionsFailed))
+		assert.Equal(t, tryContinue, tracker.observe(at(6), tryConditionsHealthy))
+		assert.Equal(t, tryContinue, tracker.observe(at(7), tryConditionsFailed))
+		assert.Equal(t, tryContinue, tracker.observe(at(8), tryConditionsFailed))
+		assert.Equal(t, tryContinue, tracker.observe(at(9), tryConditionsHealthy))
+
+		// the healthy period starts over after the failure
+		assert.Equal(t, tryContinue, tracker.observe(at(13), tryConditionsHealthy))
+		assert.Equal(t, tryCommit, tracker.observe(at(14), tryConditionsHealthy))
+	})
+
+	t.Run("pending doesn't reset failures", func(t *testing.T) {
+		tracker := newTryConditionsTracker(conditions, started)
+
+		assert.Equal(t, tryContinue, tracker.observe(at(4), tryConditionsFailed))
+		assert.Equal(t, tryContinue, tracker.observe(at(5), tryConditionsPending))
+		assert.Equal(t, tryContinue, tracker.observe(at(6), tryConditionsFailed))
+		assert.Equal(t, tryRollback, tracker.observe(at(7), tryConditionsFailed))
+	})
+
+	t.Run("no commit", func(t *testing.T) {
+		conditions := conditions
+		conditions.CommitOnHealthy = false
+
+		tracker := newTryConditionsTracker(conditions, started)
+
+		for i := 0; i < 20; i++ {
+			assert.Equal(t, tryContinue, tracker.observe(at(i), tryConditionsHealthy))
+		}
+	})
+}
+
+func TestRollbackTimerGeneration(t *testing.T) {
+	r := &Runtime{}
+
+	arm := func(timeout time.Duration, rollback func()) uint64 {
+		r.rollbackTimerMu.Lock()
+		defer r.rollbackTimerMu.Unlock()
+
+		r.cancelConfigRollbackTimeout()
+
+		return r.armRollbackTimer(timeout, rollback)
+	}
+
+	first := arm(time.Hour, func() {})
+
+	rolledBack := make(chan struct{})
+
+	second := arm(time.Hour, func() { close(rolledBack) })
+	assert.NotEqual(t, first, second)
+
+	// the stale timer or the conditions watcher of the replaced try don't finish the current try
+	assert.False(t, r.finishTry(first))
+	assert.True(t, r.ConfigRollbackPending())
+
+	assert.True(t, r.finishTry(second))
+	assert.False(t, r.ConfigRollbackPending())
+	assert.False(t, r.finishTry(second))
+
+	// the canceled try is not rolled back
+	third := arm(time.Hour, func() { t.Error("canceled try rolled back") })
+	r.CancelConfigRollbackTimeout()
+	assert.False(t, r.finishTry(third))
+
+	arm(10*time.Millisecond, func() { close(rolledBack) })
+
+	select {
+	case <-rolledBack:
+	case <-time.After(time.Second):
+		t.Fatal("rollback timeout")
+	}
+
+	assert.False(t, r.ConfigRollbackPending())
+}
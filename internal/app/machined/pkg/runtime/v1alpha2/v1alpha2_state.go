@@ -110,9 +110,11 @@ func NewState() (*State, error) {
 		&etcd.Member{},
 		&files.EtcFileSpec{},
 		&files.EtcFileStatus{},
+		&hardware.BoardInformation{},
 		&hardware.Processor{},
 		&hardware.MemoryModule{},
 		&hardware.SystemInformation{},
+		&hardware.ThermalZoneStatus{},
 		&k8s.AdmissionControlConfig{},
 		&k8s.AuditPolicyConfig{},
 		&k8s.APIServerConfig{},
@@ -143,12 +145,18 @@ func NewState() (*State, error) {
 		&network.AddressStatus{},
 		&network.AddressSpec{},
 		&network.DeviceConfigSpec{},
+		&network.DNSResolveCache{},
+		&network.DNSUpstream{},
+		&network.EthernetSpec{},
+		&network.EthernetStatus{},
 		&network.HardwareAddr{},
+		&network.HostDNSConfig{},
 		&network.HostnameStatus{},
 		&network.HostnameSpec{},
 		&network.LinkRefresh{},
 		&network.LinkStatus{},
 		&network.LinkSpec{},
+		&network.NfTablesChain{},
 		&network.NodeAddress{},
 		&network.NodeAddressFilter{},
 		&network.OperatorSpec{},
@@ -158,11 +166,15 @@ func NewState() (*State, error) {
 		&network.ResolverSpec{},
 		&network.RouteStatus{},
 		&network.RouteSpec{},
+		&network.RoutingRuleStatus{},
+		&network.RoutingRuleSpec{},
+		&network.RuleConfig{},
 		&network.Status{},
 		&network.TimeServerStatus{},
 		&network.TimeServerSpec{},
 		&perf.CPU{},
 		&perf.Memory{},
+		&runtime.BootloaderStatus{},
 		&runtime.ExtensionStatus{},
 		&runtime.KernelModuleSpec{},
 		&runtime.KernelParamSpec{},
@@ -172,6 +184,8 @@ func NewState() (*State, error) {
 		&runtime.MetaKey{},
 		&runtime.MountStatus{},
 		&runtime.PlatformMetadata{},
+		&runtime.WatchdogTimerDisarmSignal{},
+		&runtime.WatchdogTimerStatus{},
 		&secrets.API{},
 		&secrets.CertSAN{},
 		&secrets.Etcd{},
@@ -226,3 +240,34 @@ func (s *State) SetConfig(cfg talosconfig.Provider) error {
 
 	return s.resources.Update(ctx, cfgResource)
 }
+
+// SetStagedConfig implements runtime.V1alpha2State interface.
+//
+// Nil config removes the staged config resource.
+func (s *State) SetStagedConfig(cfg talosconfig.Provider) error {
+	ctx := context.TODO()
+
+	if cfg == nil {
+		err := s.resources.Destroy(ctx, resource.NewMetadata(config.NamespaceName, config.MachineConfigType, config.StagedID, resource.VersionUndefined))
+		if err != nil && !state.IsNotFoundError(err) {
+			return err
+		}
+
+		return nil
+	}
+
+	cfgResource := config.NewMachineConfigWithID(cfg, config.StagedID)
+
+	oldCfg, err := s.resources.Get(ctx, cfgResource.Metadata())
+	if err != nil {
+		if state.IsNotFoundError(err) {
+			return s.resources.Create(ctx, cfgResource)
+		}
+
+		return err
+	}
+
+	cfgResource.Metadata().SetVersion(oldCfg.Metadata().Version())
+
+	return s.resources.Update(ctx, cfgResource)
+}
@@ -36,7 +36,11 @@ var rules = map[string]role.Set{
 
 	"/machine.MachineService/ApplyConfiguration":          role.MakeSet(role.Admin),
 	"/machine.MachineService/Bootstrap":                   role.MakeSet(role.Admin),
+	"/machine.MachineService/CancelStagedConfiguration":   role.MakeSet(role.Admin),
 	"/machine.MachineService/CPUInfo":                     role.MakeSet(role.Admin, role.Operator, role.Reader),
+	"/machine.MachineService/ConfigDiff":                  role.MakeSet(role.Admin),
+	"/machine.MachineService/ConfigHistory":               role.MakeSet(role.Admin, role.Operator, role.Reader),
+	"/machine.MachineService/ConfigRollback":              role.MakeSet(role.Admin),
 	"/machine.MachineService/Containers":                  role.MakeSet(role.Admin, role.Operator, role.Reader),
 	"/machine.MachineService/Copy":                        role.MakeSet(role.Admin),
 	"/machine.MachineService/DiskStats":                   role.MakeSet(role.Admin, role.Operator, role.Reader),
@@ -79,9 +83,11 @@ var rules = map[string]role.Set{
 	"/machine.MachineService/ServiceStart":                role.MakeSet(role.Admin, role.Operator),
 	"/machine.MachineService/ServiceStop":                 role.MakeSet(role.Admin, role.Operator),
 	"/machine.MachineService/Shutdown":                    role.MakeSet(role.Admin, role.Operator),
+	"/machine.MachineService/StagedConfiguration":         role.MakeSet(role.Admin),
 	"/machine.MachineService/Stats":                       role.MakeSet(role.Admin, role.Operator, role.Reader),
 	"/machine.MachineService/SystemStat":                  role.MakeSet(role.Admin, role.Operator, role.Reader),
 	"/machine.MachineService/Upgrade":                     role.MakeSet(role.Admin),
+	"/machine.MachineService/ValidateConfiguration":       role.MakeSet(role.Admin, role.Operator, role.Reader),
 	"/machine.MachineService/Version":                     role.MakeSet(role.Admin, role.Operator, role.Reader),
 
 	// per-type authorization is handled by the service itself
@@ -8,6 +8,7 @@ import (
 	"context"
 	"fmt"
 	"log"
+	"os"
 	"strings"
 
 	cosiv1alpha1 "github.com/cosi-project/runtime/api/v1alpha1"
@@ -25,10 +26,13 @@ import (
 	"github.com/siderolabs/talos/internal/app/resources"
 	storaged "github.com/siderolabs/talos/internal/app/storaged"
 	"github.com/siderolabs/talos/internal/pkg/configuration"
+	"github.com/siderolabs/talos/internal/pkg/meta"
+	"github.com/siderolabs/talos/internal/pkg/secretkey"
 	"github.com/siderolabs/talos/pkg/machinery/api/machine"
 	"github.com/siderolabs/talos/pkg/machinery/api/resource"
 	"github.com/siderolabs/talos/pkg/machinery/api/storage"
 	"github.com/siderolabs/talos/pkg/machinery/config/configloader"
+	"github.com/siderolabs/talos/pkg/machinery/config/secretref"
 	v1alpha1machine "github.com/siderolabs/talos/pkg/machinery/config/types/v1alpha1/machine"
 	"github.com/siderolabs/talos/pkg/machinery/constants"
 	"github.com/siderolabs/talos/pkg/version"
@@ -81,6 +85,7 @@ func (s *Server) ApplyConfiguration(ctx context.Context, in *machine.ApplyConfig
 			strings.ReplaceAll(strings.ToLower(in.Mode.String()), "_", "-"))
 	}
 
+	// the secret references are resolved only once the config is applied, so that the validation errors don't reveal the secrets
 	cfgProvider, err := configloader.NewFromBytes(in.GetData())
 	if err != nil {
 		return nil, fmt.Errorf("failed to parse config: %w", err)
@@ -111,6 +116,51 @@ Node is running in maintenance mode and does not have a config yet.`
 	return reply, nil
 }
 
+// MetaWrite implements the machine.MachineServer interface.
+//
+// Only the secret key can be written in the maintenance mode, so that the config with the encrypted secrets can be applied,
+// and the existing secret key can't be overwritten.
+func (s *Server) MetaWrite(ctx context.Context, req *machine.MetaWriteRequest) (*machine.MetaWriteResponse, error) {
+	if req.Key != meta.SecretKey {
+		return nil, status.Errorf(codes.PermissionDenied, "only the secret key can be written in the maintenance mode")
+	}
+
+	st := s.controller.Runtime().State().Machine().Meta()
+
+	if _, ok := st.ReadTagBytes(meta.SecretKey); ok {
+		return nil, status.Errorf(codes.AlreadyExists, "the secret key is already provisioned")
+	}
+
+	if _, err := secretref.ParseIdentities(req.Value); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "%s", err)
+	}
+
+	ok, err := st.SetTagBytes(ctx, meta.SecretKey, req.Value)
+	if err != nil {
+		return nil, err
+	}
+
+	if !ok {
+		// META overflowed
+		return nil, status.Errorf(codes.ResourceExhausted, "meta write failed")
+	}
+
+	// META partition is created on install, the in-memory value is flushed after the install
+	if err = st.Flush(); err != nil && !os.IsNotExist(err) {
+		return nil, err
+	}
+
+	if err = secretkey.Provision(st); err != nil {
+		return nil, err
+	}
+
+	return &machine.MetaWriteResponse{
+		Messages: []*machine.MetaWrite{
+			{},
+		},
+	}, nil
+}
+
 // GenerateConfiguration implements the machine.MachineServer interface.
 func (s *Server) GenerateConfiguration(ctx context.Context, in *machine.GenerateConfigurationRequest) (*machine.GenerateConfigurationResponse, error) {
 	if in.MachineConfig == nil {
@@ -126,6 +176,13 @@ func (s *Server) GenerateConfiguration(ctx context.Context, in *machine.Generate
 	return configuration.Generate(ctx, in)
 }
 
+// ValidateConfiguration implements the machine.MachineServer interface.
+//
+// The configuration is validated against the hardware of the node before it is applied.
+func (s *Server) ValidateConfiguration(ctx context.Context, in *machine.ValidateConfigurationRequest) (*machine.ValidateConfigurationResponse, error) {
+	return configuration.Validate(ctx, s.controller.Runtime().State().V1Alpha2().Resources(), s.controller.Runtime().State().Platform().Mode(), in)
+}
+
 // GenerateClientConfiguration implements the machine.MachineServer interface.
 func (s *Server) GenerateClientConfiguration(ctx context.Context, in *machine.GenerateClientConfigurationRequest) (*machine.GenerateClientConfigurationResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "client configuration (talosconfig) can't be generated in the maintenance mode")
@@ -0,0 +1,221 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+// Package confighistory keeps the history of the machine configs applied to the node.
+package confighistory
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+	"sync"
+	"time"
+)
+
+// Modes of the revisions which are not apply modes.
+const (
+	// ModeBoot is the mode of the config saved on boot (e.g. acquired from the platform).
+	ModeBoot = "boot"
+	// ModeCancelStaged is the mode of the config restored when the staged config is discarded.
+	ModeCancelStaged = "cancel_staged"
+)
+
+const indexName = "index.json"
+
+// ErrNotFound is returned when the revision is not in the history.
+var ErrNotFound = errors.New("revision not found")
+
+// all the histories share the same directory on the STATE partition.
+var mu sync.Mutex
+
+// Revision describes a config in the history.
+type Revision struct {
+	// ID is the sequential number of the revision.
+	ID int `json:"id"`
+	// Timestamp is the time the config was applied.
+	Timestamp time.Time `json:"timestamp"`
+	// Identity is the identity of the client which applied the config.
+	Identity string `json:"identity,omitempty"`
+	// Mode is the apply mode or one of the modes defined in this package.
+	Mode string `json:"mode"`
+	// RollbackOf is the ID of the revision restored with the rollback.
+	RollbackOf int `json:"rollbackOf,omitempty"`
+}
+
+// History keeps the last applied configs in the directory.
+//
+// Each config is stored in a separate file, the revisions are described in the index file.
+type History struct {
+	path  string
+	depth int
+}
+
+// New creates the history in the directory keeping up to depth revisions.
+func New(path string, depth int) *History {
+	return &History{
+		path:  path,
+		depth: depth,
+	}
+}
+
+// Record adds the config to the history.
+//
+// The config is not recorded if it is the same as the latest revision, in that case
+// the latest revision is returned. ID and Timestamp are set by the history.
+func (h *History) Record(data []byte, rev Revision) (Revision, error) {
+	mu.Lock()
+	defer mu.Unlock()
+
+	index, err := h.readIndex()
+	if err != nil {
+		return Revision{}, err
+	}
+
+	if len(index) > 0 {
+		latest := index[len(index)-1]
+
+		previous, err := os.ReadFile(h.configPath(latest.ID))
+		if err == nil && bytes.Equal(previous, data) {
+			return latest, nil
+		}
+	}
+
+	rev.ID = 1
+
+	if len(index) > 0 {
+		rev.ID = index[len(index)-1].ID + 1
+	}
+
+	if rev.Timestamp.IsZero() {
+		rev.Timestamp = time.Now()
+	}
+
+	rev.Timestamp = rev.Timestamp.UTC().Truncate(time.Second)
+
+	if err = os.MkdirAll(h.path, 0o700); err != nil {
+		return Revision{}, err
+	}
+
+	if err = writeFile(h.configPath(rev.ID), data); err != nil {
+		return Revision{}, err
+	}
+
+	index = append(index, rev)
+
+	var pruned []Revision
+
+	if len(index) > h.depth {
+		pruned, index = index[:len(index)-h.depth], index[len(index)-h.depth:]
+	}
+
+	if err = h.writeIndex(index); err != nil {
+		return Revision{}, err
+	}
+
+	for _, old := range pruned {
+		if err = os.Remove(h.configPath(old.ID)); err != nil && !errors.Is(err, os.ErrNotExist) {
+			return Revision{}, err
+		}
+	}
+
+	return rev, nil
+}
+
+// List returns the revisions in the history, the latest revision is the last one.
+func (h *History) List() ([]Revision, error) {
+	mu.Lock()
+	defer mu.Unlock()
+
+	return h.readIndex()
+}
+
+// Get returns the revision and the config stored in the history.
+func (h *History) Get(id int) (Revision, []byte, error) {
+	mu.Lock()
+	defer mu.Unlock()
+
+	index, err := h.readIndex()
+	if err != nil {
+		return Revision{}, nil, err
+	}
+
+	for _, rev := range index {
+		if rev.ID != id {
+			continue
+		}
+
+		data, err := os.ReadFile(h.configPath(id))
+		if err != nil {
+			return Revision{}, nil, fmt.Errorf("error reading revision %d: %w", id, err)
+		}
+
+		return rev, data, nil
+	}
+
+	return Revision{}, nil, fmt.Errorf("%w: %d", ErrNotFound, id)
+}
+
+func (h *History) configPath(id int) string {
+	return filepath.Join(h.path, strconv.Itoa(id)+".yaml")
+}
+
+func (h *History) readIndex() ([]Revision, error) {
+	data, err := os.ReadFile(filepath.Join(h.path, indexName))
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return nil, nil
+		}
+
+		return nil, err
+	}
+
+	var index []Revision
+
+	if err = json.Unmarshal(data, &index); err != nil {
+		return nil, fmt.Errorf("error decoding config history index: %w", err)
+	}
+
+	return index, nil
+}
+
+func (h *History) writeIndex(index []Revision) error {
+	data, err := json.Marshal(index)
+	if err != nil {
+		return err
+	}
+
+	return writeFile(filepath.Join(h.path, indexName), data)
+}
+
+// writeFile replaces the file atomically, so that the history is consistent after a power loss.
+func writeFile(path string, data []byte) error {
+	tmp := path + ".tmp"
+
+	f, err := os.OpenFile(tmp, os.O_CREATE|os.O_TRUNC|os.O_WRONLY, 0o600)
+	if err != nil {
+		return err
+	}
+
+	if _, err = f.Write(data); err != nil {
+		f.Close() //nolint:errcheck
+
+		return err
+	}
+
+	if err = f.Sync(); err != nil {
+		f.Close() //nolint:errcheck
+
+		return err
+	}
+
+	if err = f.Close(); err != nil {
+		return err
+	}
+
+	return os.Rename(tmp, path)
+}
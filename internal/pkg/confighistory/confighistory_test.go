@@ -0,0 +1,76 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package confighistory_test
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/siderolabs/talos/internal/pkg/confighistory"
+)
+
+func TestHistory(t *testing.T) {
+	dir := filepath.Join(t.TempDir(), "history")
+
+	h := confighistory.New(dir, 3)
+
+	revisions, err := h.List()
+	require.NoError(t, err)
+	assert.Empty(t, revisions)
+
+	rev, err := h.Record([]byte("config 1"), confighistory.Revision{Mode: confighistory.ModeBoot})
+	require.NoError(t, err)
+	assert.Equal(t, 1, rev.ID)
+	assert.False(t, rev.Timestamp.IsZero())
+
+	// the same config is not recorded again
+	rev, err = h.Record([]byte("config 1"), confighistory.Revision{Mode: confighistory.ModeBoot})
+	require.NoError(t, err)
+	assert.Equal(t, 1, rev.ID)
+
+	for i := 2; i <= 5; i++ {
+		rev, err = h.Record([]byte(fmt.Sprintf("config %d", i)), confighistory.Revision{Mode: "no_reboot", Identity: "admin"})
+		require.NoError(t, err)
+		assert.Equal(t, i, rev.ID)
+	}
+
+	revisions, err = h.List()
+	require.NoError(t, err)
+	require.Len(t, revisions, 3)
+
+	for i, rev := range revisions {
+		assert.Equal(t, i+3, rev.ID)
+		assert.Equal(t, "admin", rev.Identity)
+	}
+
+	// the pruned configs are removed
+	files, err := os.ReadDir(dir)
+	require.NoError(t, err)
+	assert.Len(t, files, 4)
+
+	_, _, err = h.Get(2)
+	assert.ErrorIs(t, err, confighistory.ErrNotFound)
+
+	rev, data, err := h.Get(4)
+	require.NoError(t, err)
+	assert.Equal(t, 4, rev.ID)
+	assert.Equal(t, "config 4", string(data))
+
+	rev, err = h.Record(data, confighistory.Revision{Mode: "reboot", RollbackOf: 4})
+	require.NoError(t, err)
+	assert.Equal(t, 6, rev.ID)
+
+	// the history is read back from the directory
+	revisions, err = confighistory.New(dir, 3).List()
+	require.NoError(t, err)
+	require.Len(t, revisions, 3)
+	assert.Equal(t, 6, revisions[2].ID)
+	assert.Equal(t, 4, revisions[2].RollbackOf)
+}
@@ -17,6 +17,7 @@ import (
 	"github.com/siderolabs/talos/pkg/machinery/api/machine"
 	"github.com/siderolabs/talos/pkg/machinery/config"
 	"github.com/siderolabs/talos/pkg/machinery/config/configloader"
+	"github.com/siderolabs/talos/pkg/machinery/config/secretref"
 	"github.com/siderolabs/talos/pkg/machinery/config/types/v1alpha1"
 	"github.com/siderolabs/talos/pkg/machinery/config/types/v1alpha1/generate"
 	v1alpha1machine "github.com/siderolabs/talos/pkg/machinery/config/types/v1alpha1/machine"
@@ -110,7 +111,7 @@ func Generate(ctx context.Context, in *machine.GenerateConfigurationRequest) (re
 			secrets       *generate.SecretsBundle
 		)
 
-		baseConfig, err = configloader.NewFromFile(constants.ConfigPath)
+		baseConfig, err = configloader.NewFromFile(constants.ConfigPath, configloader.WithSecretReferences(secretref.DefaultIdentities))
 
 		clock := generate.NewClock()
 
@@ -157,6 +158,14 @@ func Generate(ctx context.Context, in *machine.GenerateConfigurationRequest) (re
 			return nil, err
 		}
 
+		// the secrets copied from the node config keep the secret references
+		if provider, ok := baseConfig.(*v1alpha1.ReadonlyProvider); ok {
+			cfgBytes, err = secretref.RestoreValuesYAML(cfgBytes, provider.References())
+			if err != nil {
+				return nil, err
+			}
+		}
+
 		talosconfig, err := generate.Talosconfig(input, options...)
 		if err != nil {
 			return nil, err
@@ -0,0 +1,239 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package configuration
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/cosi-project/runtime/pkg/resource"
+	"github.com/cosi-project/runtime/pkg/safe"
+	"github.com/cosi-project/runtime/pkg/state"
+	"github.com/hashicorp/go-multierror"
+	"github.com/pmorjan/kmod"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	networkctrl "github.com/siderolabs/talos/internal/app/machined/pkg/controllers/network"
+	"github.com/siderolabs/talos/pkg/machinery/api/machine"
+	"github.com/siderolabs/talos/pkg/machinery/config"
+	"github.com/siderolabs/talos/pkg/machinery/config/configloader"
+	"github.com/siderolabs/talos/pkg/machinery/resources/network"
+)
+
+// kernelModuleExists checks whether the kernel module is available on the node, either builtin or loadable.
+var kernelModuleExists = func(name string) error {
+	manager, err := kmod.New()
+	if err != nil {
+		return fmt.Errorf("error initializing kmod manager: %w", err)
+	}
+
+	_, err = manager.Dependencies(name)
+	if err != nil && strings.HasSuffix(err.Error(), "is builtin") {
+		return nil
+	}
+
+	return err
+}
+
+// Validate config for ValidateConfiguration grpc.
+//
+// The config is validated statically for the runtime mode, and then against the node hardware.
+func Validate(ctx context.Context, st state.State, mode config.RuntimeMode, in *machine.ValidateConfigurationRequest) (*machine.ValidateConfigurationResponse, error) {
+	cfg, err := configloader.NewFromBytes(in.GetData())
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "failed to parse config: %s", err)
+	}
+
+	var findings []*machine.ValidateConfigurationFinding
+
+	warnings, err := cfg.Validate(mode)
+	if err != nil {
+		var multiErr *multierror.Error
+
+		if errors.As(err, &multiErr) {
+			for _, e := range multiErr.Errors {
+				findings = append(findings, validationError("", e.Error()))
+			}
+		} else {
+			findings = append(findings, validationError("", err.Error()))
+		}
+	}
+
+	for _, w := range warnings {
+		findings = append(findings, validationWarning("", w))
+	}
+
+	hardwareFindings, err := validateHardware(ctx, st, cfg)
+	if err != nil {
+		return nil, err
+	}
+
+	return &machine.ValidateConfigurationResponse{
+		Messages: []*machine.ValidateConfiguration{
+			{
+				Findings: append(findings, hardwareFindings...),
+			},
+		},
+	}, nil
+}
+
+func validationError(field, message string) *machine.ValidateConfigurationFinding {
+	return &machine.ValidateConfigurationFinding{
+		Field:    field,
+		Severity: machine.ValidateConfigurationFinding_ERROR,
+		Message:  message,
+	}
+}
+
+func validationWarning(field, message string) *machine.ValidateConfigurationFinding {
+	return &machine.ValidateConfigurationFinding{
+		Field:    field,
+		Severity: machine.ValidateConfigurationFinding_WARNING,
+		Message:  message,
+	}
+}
+
+// validateHardware resolves the disks, network devices and kernel modules of the config on the node.
+//
+//nolint:gocyclo,cyclop
+func validateHardware(ctx context.Context, st state.State, cfg config.Provider) ([]*machine.ValidateConfigurationFinding, error) {
+	var findings []*machine.ValidateConfigurationFinding
+
+	if cfg.Machine() == nil {
+		return nil, nil
+	}
+
+	if install := cfg.Machine().Install(); install != nil {
+		field := ".machine.install.disk"
+
+		if len(install.DiskMatchers()) > 0 {
+			field = ".machine.install.diskSelector"
+		}
+
+		disk, err := install.Disk()
+
+		switch {
+		case err != nil:
+			findings = append(findings, validationError(field, err.Error()))
+		case disk != "":
+			if finding := validateBlockDevice(field, disk); finding != nil {
+				findings = append(findings, finding)
+			}
+		}
+	}
+
+	for i, disk := range cfg.Machine().Disks() {
+		if finding := validateBlockDevice(fmt.Sprintf(".machine.disks[%d].device", i), disk.Device()); finding != nil {
+			findings = append(findings, finding)
+		}
+	}
+
+	links, err := safe.StateList[*network.LinkStatus](ctx, st, resource.NewMetadata(network.NamespaceName, network.LinkStatusType, "", resource.VersionUndefined))
+	if err != nil {
+		return nil, fmt.Errorf("error listing links: %w", err)
+	}
+
+	linkExists := func(name string) bool {
+		for iter := safe.IteratorFromList(links); iter.Next(); {
+			if iter.Value().Metadata().ID() == name {
+				return true
+			}
+		}
+
+		return false
+	}
+
+	if cfg.Machine().Network() != nil {
+		for i, device := range cfg.Machine().Network().Devices() {
+			prefix := fmt.Sprintf(".machine.network.interfaces[%d]", i)
+
+			if device.Ignore() {
+				continue
+			}
+
+			if device.Selector() != nil {
+				switch matches := networkctrl.SelectLinks(device.Selector(), links); len(matches) {
+				case 0:
+					findings = append(findings, validationError(prefix+".deviceSelector", "no network device matches the selector"))
+				case 1:
+				default:
+					findings = append(findings, validationWarning(prefix+".deviceSelector",
+						fmt.Sprintf("%d network devices match the selector, the first one %q is used", len(matches), matches[0].Metadata().ID())))
+				}
+
+				continue
+			}
+
+			if bond := device.Bond(); bond != nil {
+				for j, selector := range bond.Selectors() {
+					if len(networkctrl.SelectLinks(selector, links)) == 0 {
+						findings = append(findings, validationError(fmt.Sprintf("%s.bond.deviceSelectors[%d]", prefix, j), "no network device matches the selector"))
+					}
+				}
+
+				for j, link := range bond.Interfaces() {
+					if !linkExists(link) {
+						findings = append(findings, validationError(fmt.Sprintf("%s.bond.interfaces[%d]", prefix, j), fmt.Sprintf("network device %q not found", link)))
+					}
+				}
+			}
+
+			if bridge := device.Bridge(); bridge != nil {
+				for j, link := range bridge.Interfaces() {
+					if !linkExists(link) {
+						findings = append(findings, validationError(fmt.Sprintf("%s.bridge.interfaces[%d]", prefix, j), fmt.Sprintf("network device %q not found", link)))
+					}
+				}
+			}
+
+			// logical links are created from the config
+			if device.Bond() != nil || device.Bridge() != nil || device.Dummy() || device.WireguardConfig() != nil ||
+				device.VXLAN() != nil || device.MACVLAN() != nil || device.MACVTAP() != nil || device.IPVLAN() != nil {
+				continue
+			}
+
+			if !linkExists(device.Interface()) {
+				findings = append(findings, validationWarning(prefix+".interface", fmt.Sprintf("network device %q not found", device.Interface())))
+			}
+		}
+	}
+
+	if cfg.Machine().Kernel() != nil {
+		for i, module := range cfg.Machine().Kernel().Modules() {
+			err := kernelModuleExists(module.Name())
+
+			switch {
+			case err == nil:
+			case errors.Is(err, kmod.ErrModuleNotFound):
+				findings = append(findings, validationError(fmt.Sprintf(".machine.kernel.modules[%d].name", i), fmt.Sprintf("kernel module %q not found", module.Name())))
+			default:
+				findings = append(findings, validationWarning(fmt.Sprintf(".machine.kernel.modules[%d].name", i), fmt.Sprintf("failed to check kernel module %q: %s", module.Name(), err)))
+			}
+		}
+	}
+
+	return findings, nil
+}
+
+func validateBlockDevice(field, path string) *machine.ValidateConfigurationFinding {
+	st, err := os.Stat(path)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return validationError(field, fmt.Sprintf("disk %q not found", path))
+		}
+
+		return validationError(field, err.Error())
+	}
+
+	if st.Mode()&os.ModeDevice == 0 {
+		return validationError(field, fmt.Sprintf("%q is not a block device", path))
+	}
+
+	return nil
+}
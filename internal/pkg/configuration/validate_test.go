@@ -0,0 +1,130 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package configuration
+
+import (
+	"context"
+	"testing"
+
+	"github.com/cosi-project/runtime/pkg/state"
+	"github.com/cosi-project/runtime/pkg/state/impl/inmem"
+	"github.com/cosi-project/runtime/pkg/state/impl/namespaced"
+	"github.com/pmorjan/kmod"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/siderolabs/talos/pkg/machinery/api/machine"
+	"github.com/siderolabs/talos/pkg/machinery/config/configloader"
+	"github.com/siderolabs/talos/pkg/machinery/resources/network"
+)
+
+const validateTestConfig = `version: v1alpha1
+machine:
+  type: worker
+  install:
+    disk: /dev/rock5-missing-disk
+  network:
+    interfaces:
+      - deviceSelector:
+          driver: r8169
+        dhcp: true
+      - deviceSelector:
+          driver: e1000*
+        dhcp: true
+      - deviceSelector:
+          driver: stmmac*
+        dhcp: true
+      - interface: eth2
+        dhcp: true
+      - interface: bond0
+        bond:
+          interfaces:
+            - eth0
+            - eth3
+          mode: active-backup
+      - interface: vxlan42
+        vxlan:
+          vni: 42
+          remote: 10.0.0.1
+  kernel:
+    modules:
+      - name: r8169
+      - name: rock5-missing-module
+`
+
+func TestValidateHardware(t *testing.T) {
+	ctx := context.Background()
+
+	st := state.WrapCore(namespaced.NewState(inmem.Build))
+
+	for _, link := range []struct {
+		name   string
+		driver string
+	}{
+		{"eth0", "r8169"},
+		{"eth1", "stmmac-dwmac"},
+		{"end0", "stmmac-rk"},
+	} {
+		linkStatus := network.NewLinkStatus(network.NamespaceName, link.name)
+		linkStatus.TypedSpec().Driver = link.driver
+
+		require.NoError(t, st.Create(ctx, linkStatus))
+	}
+
+	defer func(f func(string) error) { kernelModuleExists = f }(kernelModuleExists)
+
+	kernelModuleExists = func(name string) error {
+		if name == "r8169" {
+			return nil
+		}
+
+		return kmod.ErrModuleNotFound
+	}
+
+	cfg, err := configloader.NewFromBytes([]byte(validateTestConfig))
+	require.NoError(t, err)
+
+	findings, err := validateHardware(ctx, st, cfg)
+	require.NoError(t, err)
+
+	assert.Equal(t, []*machine.ValidateConfigurationFinding{
+		validationError(".machine.install.disk", `disk "/dev/rock5-missing-disk" not found`),
+		validationError(".machine.network.interfaces[1].deviceSelector", "no network device matches the selector"),
+		validationWarning(".machine.network.interfaces[2].deviceSelector", `2 network devices match the selector, the first one "end0" is used`),
+		validationWarning(".machine.network.interfaces[3].interface", `network device "eth2" not found`),
+		validationError(".machine.network.interfaces[4].bond.interfaces[1]", `network device "eth3" not found`),
+		validationError(".machine.kernel.modules[1].name", `kernel module "rock5-missing-module" not found`),
+	}, findings)
+}
+
+func TestValidateHardwareEmptyInstall(t *testing.T) {
+	ctx := context.Background()
+
+	st := state.WrapCore(namespaced.NewState(inmem.Build))
+
+	for _, source := range []string{
+		"version: v1alpha1\nmachine:\n  type: worker\n",
+		"version: v1alpha1\nmachine:\n  type: worker\n  install: {}\n",
+		"version: v1alpha1\ncluster:\n  clusterName: test\n",
+	} {
+		cfg, err := configloader.NewFromBytes([]byte(source))
+		require.NoError(t, err)
+
+		findings, err := validateHardware(ctx, st, cfg)
+		require.NoError(t, err)
+
+		assert.Empty(t, findings, source)
+	}
+}
+
+func TestValidateInvalidConfig(t *testing.T) {
+	_, err := Validate(context.Background(), nil, nil, &machine.ValidateConfigurationRequest{
+		Data: []byte("version: v1alpha1\nmachine: ["),
+	})
+	require.Error(t, err)
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+}
@@ -12,13 +12,21 @@ import (
 	"github.com/gizak/termui/v3/widgets"
 
 	"github.com/siderolabs/talos/internal/pkg/dashboard/apidata"
+	"github.com/siderolabs/talos/internal/pkg/dashboard/resourcedata"
+	"github.com/siderolabs/talos/pkg/machinery/resources/hardware"
 )
 
-// SystemGauges quickly show CPU/mem load.
+// defaultCriticalTemperature is used to scale the temperature gauge if the thermal zone has no critical trip point.
+const defaultCriticalTemperature = 100000
+
+// SystemGauges quickly show CPU/mem load and SoC temperature.
 type SystemGauges struct {
 	*TermUIWrapper
 
 	inner *systemGaugesInner
+
+	selectedNode string
+	thermalZones map[string]map[string]hardware.ThermalZoneStatusSpec
 }
 
 // NewSystemGauges creates SystemGauges.
@@ -35,11 +43,17 @@ func NewSystemGauges() *SystemGauges {
 	inner.memGauge.Title = "MEM"
 	inner.memGauge.Border = false
 
+	inner.tempGauge = widgets.NewGauge()
+	inner.tempGauge.Title = "TEMP"
+	inner.tempGauge.Border = false
+	inner.tempGauge.Label = noData
+
 	wrapper := NewTermUIWrapper(&inner)
 
 	widget := &SystemGauges{
 		TermUIWrapper: wrapper,
 		inner:         &inner,
+		thermalZones:  make(map[string]map[string]hardware.ThermalZoneStatusSpec),
 	}
 
 	widget.SetBorderPadding(1, 0, 0, 0)
@@ -69,11 +83,76 @@ func (widget *SystemGauges) OnAPIDataChange(node string, data *apidata.Data) {
 	}
 }
 
+// OnNodeSelect implements the NodeSelectListener interface.
+func (widget *SystemGauges) OnNodeSelect(node string) {
+	if node != widget.selectedNode {
+		widget.selectedNode = node
+
+		widget.updateTemperature()
+	}
+}
+
+// OnResourceDataChange implements the ResourceDataListener interface.
+func (widget *SystemGauges) OnResourceDataChange(data resourcedata.Data) {
+	res, ok := data.Resource.(*hardware.ThermalZoneStatus)
+	if !ok {
+		return
+	}
+
+	zones, ok := widget.thermalZones[data.Node]
+	if !ok {
+		zones = make(map[string]hardware.ThermalZoneStatusSpec)
+
+		widget.thermalZones[data.Node] = zones
+	}
+
+	if data.Deleted {
+		delete(zones, res.Metadata().ID())
+	} else {
+		zones[res.Metadata().ID()] = *res.TypedSpec()
+	}
+
+	if data.Node == widget.selectedNode {
+		widget.updateTemperature()
+	}
+}
+
+// updateTemperature shows the temperature of the hottest thermal zone of the selected node.
+func (widget *SystemGauges) updateTemperature() {
+	var (
+		hottest hardware.ThermalZoneStatusSpec
+		found   bool
+	)
+
+	for _, zone := range widget.thermalZones[widget.selectedNode] {
+		if !found || zone.Temperature > hottest.Temperature {
+			hottest = zone
+			found = true
+		}
+	}
+
+	if !found {
+		widget.inner.tempGauge.Label = notAvailable
+		widget.inner.tempGauge.Percent = 0
+
+		return
+	}
+
+	critical := hottest.CriticalTemperature
+	if critical <= 0 {
+		critical = defaultCriticalTemperature
+	}
+
+	widget.inner.tempGauge.Percent = int(math.Min(math.Round(float64(hottest.Temperature)*100.0/float64(critical)), 100))
+	widget.inner.tempGauge.Label = fmt.Sprintf("%.1f°C", float64(hottest.Temperature)/1000.0)
+}
+
 type systemGaugesInner struct {
 	ui.Block
 
-	cpuGauge *widgets.Gauge
-	memGauge *widgets.Gauge
+	cpuGauge  *widgets.Gauge
+	memGauge  *widgets.Gauge
+	tempGauge *widgets.Gauge
 }
 
 // Draw implements io.Drawable.
@@ -84,7 +163,7 @@ func (widget *systemGaugesInner) Draw(buf *ui.Buffer) {
 	y := 0
 	itemHeight := 2
 
-	for _, item := range []ui.Drawable{widget.cpuGauge, widget.memGauge} {
+	for _, item := range []ui.Drawable{widget.cpuGauge, widget.memGauge, widget.tempGauge} {
 		item.SetRect(widget.Min.X, widget.Min.Y+y, widget.Min.X+width, widget.Min.Y+y+itemHeight+1)
 		item.Draw(buf)
 
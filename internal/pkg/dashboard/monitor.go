@@ -10,6 +10,7 @@ import (
 
 	"github.com/siderolabs/talos/internal/pkg/dashboard/apidata"
 	"github.com/siderolabs/talos/internal/pkg/dashboard/components"
+	"github.com/siderolabs/talos/internal/pkg/dashboard/resourcedata"
 )
 
 // MonitorGrid represents the monitoring grid with a process table and various metrics.
@@ -18,7 +19,9 @@ type MonitorGrid struct {
 
 	app *tview.Application
 
-	apiDataListeners []APIDataListener
+	apiDataListeners      []APIDataListener
+	resourceDataListeners []ResourceDataListener
+	nodeSelectListeners   []NodeSelectListener
 
 	processTableInner *components.ProcessTable
 	processTable      *components.TermUIWrapper
@@ -86,6 +89,14 @@ func NewMonitorGrid(app *tview.Application) *MonitorGrid {
 		widget.processTableInner,
 	}
 
+	widget.resourceDataListeners = []ResourceDataListener{
+		sysGauges,
+	}
+
+	widget.nodeSelectListeners = []NodeSelectListener{
+		sysGauges,
+	}
+
 	return widget
 }
 
@@ -96,6 +107,20 @@ func (widget *MonitorGrid) OnAPIDataChange(node string, data *apidata.Data) {
 	}
 }
 
+// OnResourceDataChange implements the ResourceDataListener interface.
+func (widget *MonitorGrid) OnResourceDataChange(data resourcedata.Data) {
+	for _, dataWidget := range widget.resourceDataListeners {
+		dataWidget.OnResourceDataChange(data)
+	}
+}
+
+// OnNodeSelect implements the NodeSelectListener interface.
+func (widget *MonitorGrid) OnNodeSelect(node string) {
+	for _, nodeSelectListener := range widget.nodeSelectListeners {
+		nodeSelectListener.OnNodeSelect(node)
+	}
+}
+
 // OnScreenSelect implements the screenSelectListener interface.
 func (widget *MonitorGrid) onScreenSelect(active bool) {
 	if active {
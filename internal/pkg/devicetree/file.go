@@ -0,0 +1,45 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package devicetree
+
+import (
+	"bytes"
+	"fmt"
+	"os"
+
+	"github.com/u-root/u-root/pkg/dt"
+)
+
+// ApplyOverlayFiles applies the overlays to the device tree blob at path in place.
+func ApplyOverlayFiles(path string, overlays ...string) error {
+	base, err := dt.ReadFile(path)
+	if err != nil {
+		return fmt.Errorf("error reading device tree %q: %w", path, err)
+	}
+
+	for _, overlayPath := range overlays {
+		overlay, err := dt.ReadFile(overlayPath)
+		if err != nil {
+			return fmt.Errorf("error reading device tree overlay %q: %w", overlayPath, err)
+		}
+
+		if err = ApplyOverlay(base, overlay); err != nil {
+			return fmt.Errorf("error applying device tree overlay %q: %w", overlayPath, err)
+		}
+	}
+
+	var buf bytes.Buffer
+
+	if _, err = base.Write(&buf); err != nil {
+		return err
+	}
+
+	st, err := os.Stat(path)
+	if err != nil {
+		return err
+	}
+
+	return os.WriteFile(path, buf.Bytes(), st.Mode().Perm())
+}
@@ -0,0 +1,377 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+// Package devicetree provides functions to manipulate flattened device trees.
+package devicetree
+
+import (
+	"bytes"
+	"encoding/binary"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/u-root/u-root/pkg/dt"
+)
+
+const (
+	overlayNode     = "__overlay__"
+	symbolsNode     = "__symbols__"
+	fixupsNode      = "__fixups__"
+	localFixupsNode = "__local_fixups__"
+)
+
+// ApplyOverlay merges the compiled overlay (.dtbo) into the base device tree.
+//
+// The algorithm follows libfdt fdt_overlay_apply: overlay phandles are
+// renumbered to not clash with the base, references to the base labels are
+// resolved via __symbols__, and each fragment is merged into its target node.
+//
+//nolint:gocyclo
+func ApplyOverlay(base, overlay *dt.FDT) error {
+	delta := maxPHandle(base.RootNode)
+
+	if err := adjustPHandles(overlay.RootNode, delta); err != nil {
+		return err
+	}
+
+	if local, ok := child(overlay.RootNode, localFixupsNode); ok {
+		if err := applyLocalFixups(overlay.RootNode, local, delta); err != nil {
+			return err
+		}
+	}
+
+	if err := resolveFixups(base, overlay); err != nil {
+		return err
+	}
+
+	for _, fragment := range overlay.RootNode.Children {
+		content, ok := child(fragment, overlayNode)
+		if !ok {
+			continue
+		}
+
+		target, targetPath, err := fragmentTarget(base.RootNode, fragment)
+		if err != nil {
+			return fmt.Errorf("fragment %q: %w", fragment.Name, err)
+		}
+
+		merge(target, content)
+
+		updateSymbols(base.RootNode, overlay.RootNode, fragment.Name, targetPath)
+	}
+
+	return nil
+}
+
+func child(n *dt.Node, name string) (*dt.Node, bool) {
+	for _, c := range n.Children {
+		if c.Name == name {
+			return c, true
+		}
+	}
+
+	return nil, false
+}
+
+// nodeByPath looks up the node by the absolute path.
+func nodeByPath(root *dt.Node, path string) (*dt.Node, bool) {
+	n := root
+
+	for _, component := range strings.Split(strings.Trim(path, "/"), "/") {
+		if component == "" {
+			continue
+		}
+
+		var found bool
+
+		for _, c := range n.Children {
+			// node may be referenced without the unit address
+			if c.Name == component || strings.SplitN(c.Name, "@", 2)[0] == component {
+				n, found = c, true
+
+				break
+			}
+		}
+
+		if !found {
+			return nil, false
+		}
+	}
+
+	return n, true
+}
+
+func u32(p *dt.Property) (uint32, error) {
+	if len(p.Value) != 4 {
+		return 0, fmt.Errorf("property %q is not a u32", p.Name)
+	}
+
+	return binary.BigEndian.Uint32(p.Value), nil
+}
+
+func stringValue(p *dt.Property) string {
+	return string(bytes.TrimRight(p.Value, "\x00"))
+}
+
+func maxPHandle(root *dt.Node) uint32 {
+	var highest uint32
+
+	root.Walk(func(n *dt.Node) error { //nolint:errcheck
+		for _, name := range []string{"phandle", "linux,phandle"} {
+			if p, ok := n.LookProperty(name); ok {
+				if v, err := u32(p); err == nil && v != 0xffffffff && v > highest {
+					highest = v
+				}
+			}
+		}
+
+		return nil
+	})
+
+	return highest
+}
+
+func adjustPHandles(root *dt.Node, delta uint32) error {
+	return root.Walk(func(n *dt.Node) error {
+		for _, name := range []string{"phandle", "linux,phandle"} {
+			p, ok := n.LookProperty(name)
+			if !ok {
+				continue
+			}
+
+			v, err := u32(p)
+			if err != nil {
+				return err
+			}
+
+			binary.BigEndian.PutUint32(p.Value, v+delta)
+		}
+
+		return nil
+	})
+}
+
+// applyLocalFixups walks __local_fixups__ (which mirrors the overlay tree) and
+// adjusts the overlay internal phandle references.
+func applyLocalFixups(n, fixups *dt.Node, delta uint32) error {
+	for _, fixup := range fixups.Properties {
+		p, ok := n.LookProperty(fixup.Name)
+		if !ok {
+			return fmt.Errorf("local fixup for missing property %q in %q", fixup.Name, n.Name)
+		}
+
+		for off := 0; off+4 <= len(fixup.Value); off += 4 {
+			offset := int(binary.BigEndian.Uint32(fixup.Value[off:]))
+			if offset+4 > len(p.Value) {
+				return fmt.Errorf("local fixup offset %d out of range for property %q", offset, fixup.Name)
+			}
+
+			binary.BigEndian.PutUint32(p.Value[offset:], binary.BigEndian.Uint32(p.Value[offset:])+delta)
+		}
+	}
+
+	for _, fixupChild := range fixups.Children {
+		c, ok := child(n, fixupChild.Name)
+		if !ok {
+			return fmt.Errorf("local fixup for missing node %q in %q", fixupChild.Name, n.Name)
+		}
+
+		if err := applyLocalFixups(c, fixupChild, delta); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// resolveFixups patches the overlay references to labels defined in the base device tree.
+//
+//nolint:gocyclo
+func resolveFixups(base, overlay *dt.FDT) error {
+	fixups, ok := child(overlay.RootNode, fixupsNode)
+	if !ok {
+		return nil
+	}
+
+	symbols, ok := child(base.RootNode, symbolsNode)
+	if !ok {
+		return fmt.Errorf("base device tree has no %s, was it compiled with -@?", symbolsNode)
+	}
+
+	for _, fixup := range fixups.Properties {
+		symbol, ok := symbols.LookProperty(fixup.Name)
+		if !ok {
+			return fmt.Errorf("label %q is not defined in the base device tree", fixup.Name)
+		}
+
+		target, ok := nodeByPath(base.RootNode, stringValue(symbol))
+		if !ok {
+			return fmt.Errorf("label %q points to missing node %q", fixup.Name, stringValue(symbol))
+		}
+
+		phandle, err := ensurePHandle(base.RootNode, overlay.RootNode, target)
+		if err != nil {
+			return err
+		}
+
+		for _, ref := range strings.Split(stringValue(&fixup), "\x00") {
+			// <path>:<property>:<offset>
+			parts := strings.Split(ref, ":")
+			if len(parts) != 3 {
+				return fmt.Errorf("malformed fixup %q", ref)
+			}
+
+			offset, err := strconv.Atoi(parts[2])
+			if err != nil {
+				return fmt.Errorf("malformed fixup %q: %w", ref, err)
+			}
+
+			n, ok := nodeByPath(overlay.RootNode, parts[0])
+			if !ok {
+				return fmt.Errorf("fixup references missing node %q", parts[0])
+			}
+
+			p, ok := n.LookProperty(parts[1])
+			if !ok || offset+4 > len(p.Value) {
+				return fmt.Errorf("fixup references invalid property %q", ref)
+			}
+
+			binary.BigEndian.PutUint32(p.Value[offset:], phandle)
+		}
+	}
+
+	return nil
+}
+
+// ensurePHandle returns the phandle of the base node, allocating a new one if the node has none.
+func ensurePHandle(root, overlayRoot, n *dt.Node) (uint32, error) {
+	if p, ok := n.LookProperty("phandle"); ok {
+		return u32(p)
+	}
+
+	// overlay phandles are already renumbered above the base ones
+	phandle := maxPHandle(root)
+	if overlayMax := maxPHandle(overlayRoot); overlayMax > phandle {
+		phandle = overlayMax
+	}
+
+	phandle++
+
+	value := make([]byte, 4)
+	binary.BigEndian.PutUint32(value, phandle)
+
+	n.UpdateProperty("phandle", value)
+
+	return phandle, nil
+}
+
+func fragmentTarget(root, fragment *dt.Node) (*dt.Node, string, error) {
+	if p, ok := fragment.LookProperty("target"); ok {
+		phandle, err := u32(p)
+		if err != nil {
+			return nil, "", err
+		}
+
+		var path string
+
+		target, found := findPath(root, "", func(n *dt.Node) bool {
+			if p, ok := n.LookProperty("phandle"); ok {
+				v, err := u32(p)
+
+				return err == nil && v == phandle
+			}
+
+			return false
+		}, &path)
+		if !found {
+			return nil, "", fmt.Errorf("target phandle %#x not found", phandle)
+		}
+
+		return target, path, nil
+	}
+
+	if p, ok := fragment.LookProperty("target-path"); ok {
+		path := stringValue(p)
+
+		target, found := nodeByPath(root, path)
+		if !found {
+			return nil, "", fmt.Errorf("target path %q not found", path)
+		}
+
+		return target, path, nil
+	}
+
+	return nil, "", fmt.Errorf("no target specified")
+}
+
+func findPath(n *dt.Node, path string, f func(*dt.Node) bool, result *string) (*dt.Node, bool) {
+	if f(n) {
+		if path == "" {
+			path = "/"
+		}
+
+		*result = path
+
+		return n, true
+	}
+
+	for _, c := range n.Children {
+		if found, ok := findPath(c, path+"/"+c.Name, f, result); ok {
+			return found, true
+		}
+	}
+
+	return nil, false
+}
+
+func merge(target, content *dt.Node) {
+	for _, p := range content.Properties {
+		value := make([]byte, len(p.Value))
+		copy(value, p.Value)
+
+		target.UpdateProperty(p.Name, value)
+	}
+
+	for _, c := range content.Children {
+		existing, ok := child(target, c.Name)
+		if !ok {
+			existing = &dt.Node{Name: c.Name}
+			target.Children = append(target.Children, existing)
+		}
+
+		merge(existing, c)
+	}
+}
+
+// updateSymbols copies the overlay labels pointing into the fragment to the base __symbols__.
+func updateSymbols(root, overlayRoot *dt.Node, fragment, targetPath string) {
+	overlaySymbols, ok := child(overlayRoot, symbolsNode)
+	if !ok {
+		return
+	}
+
+	symbols, ok := child(root, symbolsNode)
+	if !ok {
+		symbols = &dt.Node{Name: symbolsNode}
+		root.Children = append(root.Children, symbols)
+	}
+
+	prefix := "/" + fragment + "/" + overlayNode
+
+	for _, p := range overlaySymbols.Properties {
+		path := stringValue(&p)
+
+		if !strings.HasPrefix(path, prefix) {
+			continue
+		}
+
+		path = strings.TrimSuffix(targetPath, "/") + strings.TrimPrefix(path, prefix)
+		if path == "" {
+			path = "/"
+		}
+
+		symbols.UpdateProperty(p.Name, append([]byte(path), 0))
+	}
+}
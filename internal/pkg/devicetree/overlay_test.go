@@ -0,0 +1,221 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package devicetree_test
+
+import (
+	"bytes"
+	"encoding/binary"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/u-root/u-root/pkg/dt"
+
+	"github.com/siderolabs/talos/internal/pkg/devicetree"
+)
+
+func u32(v uint32) []byte {
+	b := make([]byte, 4)
+	binary.BigEndian.PutUint32(b, v)
+
+	return b
+}
+
+func str(s string) []byte {
+	return append([]byte(s), 0)
+}
+
+func baseTree() *dt.FDT {
+	return &dt.FDT{
+		Header: dt.Header{
+			Magic:           dt.Magic,
+			Version:         17,
+			LastCompVersion: 16,
+		},
+		RootNode: &dt.Node{
+			Properties: []dt.Property{
+				{Name: "compatible", Value: str("radxa,rock-5b")},
+			},
+			Children: []*dt.Node{
+				{
+					Name: "spi@fe2b0000",
+					Properties: []dt.Property{
+						{Name: "status", Value: str("disabled")},
+						{Name: "phandle", Value: u32(5)},
+					},
+				},
+				{
+					Name: "pinctrl",
+					Properties: []dt.Property{
+						{Name: "phandle", Value: u32(7)},
+					},
+				},
+				{
+					Name: "__symbols__",
+					Properties: []dt.Property{
+						{Name: "sfc", Value: str("/spi@fe2b0000")},
+					},
+				},
+			},
+		},
+	}
+}
+
+func overlayTree() *dt.FDT {
+	return &dt.FDT{
+		Header: dt.Header{
+			Magic:           dt.Magic,
+			Version:         17,
+			LastCompVersion: 16,
+		},
+		RootNode: &dt.Node{
+			Children: []*dt.Node{
+				{
+					Name: "fragment@0",
+					Properties: []dt.Property{
+						{Name: "target", Value: u32(0xffffffff)},
+					},
+					Children: []*dt.Node{
+						{
+							Name: "__overlay__",
+							Properties: []dt.Property{
+								{Name: "status", Value: str("okay")},
+							},
+							Children: []*dt.Node{
+								{
+									Name: "flash@0",
+									Properties: []dt.Property{
+										{Name: "compatible", Value: str("jedec,spi-nor")},
+										{Name: "pinctrl-0", Value: u32(1)},
+									},
+								},
+							},
+						},
+					},
+				},
+				{
+					Name: "fragment@1",
+					Properties: []dt.Property{
+						{Name: "target-path", Value: str("/")},
+					},
+					Children: []*dt.Node{
+						{
+							Name: "__overlay__",
+							Children: []*dt.Node{
+								{
+									Name: "flash-pins",
+									Properties: []dt.Property{
+										{Name: "phandle", Value: u32(1)},
+									},
+								},
+							},
+						},
+					},
+				},
+				{
+					Name: "__fixups__",
+					Properties: []dt.Property{
+						{Name: "sfc", Value: str("/fragment@0:target:0")},
+					},
+				},
+				{
+					Name: "__local_fixups__",
+					Children: []*dt.Node{
+						{
+							Name: "fragment@0",
+							Children: []*dt.Node{
+								{
+									Name: "__overlay__",
+									Children: []*dt.Node{
+										{
+											Name: "flash@0",
+											Properties: []dt.Property{
+												{Name: "pinctrl-0", Value: u32(0)},
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+				{
+					Name: "__symbols__",
+					Properties: []dt.Property{
+						{Name: "flash_pins", Value: str("/fragment@1/__overlay__/flash-pins")},
+					},
+				},
+			},
+		},
+	}
+}
+
+func property(t *testing.T, root *dt.Node, path ...string) []byte {
+	t.Helper()
+
+	n := root
+
+	for _, name := range path[:len(path)-1] {
+		var ok bool
+
+		n, ok = n.NodeByName(name)
+		require.True(t, ok, "node %q not found", name)
+	}
+
+	p, ok := n.LookProperty(path[len(path)-1])
+	require.True(t, ok, "property %q not found", path[len(path)-1])
+
+	return p.Value
+}
+
+func TestApplyOverlay(t *testing.T) {
+	base := baseTree()
+
+	require.NoError(t, devicetree.ApplyOverlay(base, overlayTree()))
+
+	assert.Equal(t, str("okay"), property(t, base.RootNode, "spi@fe2b0000", "status"))
+	assert.Equal(t, str("jedec,spi-nor"), property(t, base.RootNode, "spi@fe2b0000", "flash@0", "compatible"))
+
+	// overlay phandles are renumbered above the base maximum, references are adjusted
+	assert.Equal(t, u32(8), property(t, base.RootNode, "flash-pins", "phandle"))
+	assert.Equal(t, u32(8), property(t, base.RootNode, "spi@fe2b0000", "flash@0", "pinctrl-0"))
+
+	assert.Equal(t, str("/flash-pins"), property(t, base.RootNode, "__symbols__", "flash_pins"))
+}
+
+func TestApplyOverlayMissingLabel(t *testing.T) {
+	base := baseTree()
+	base.RootNode.Children = base.RootNode.Children[:2]
+
+	assert.ErrorContains(t, devicetree.ApplyOverlay(base, overlayTree()), "base device tree has no __symbols__")
+}
+
+func TestApplyOverlayFiles(t *testing.T) {
+	dir := t.TempDir()
+
+	write := func(name string, fdt *dt.FDT) string {
+		var buf bytes.Buffer
+
+		_, err := fdt.Write(&buf)
+		require.NoError(t, err)
+
+		path := filepath.Join(dir, name)
+		require.NoError(t, os.WriteFile(path, buf.Bytes(), 0o644))
+
+		return path
+	}
+
+	basePath := write("base.dtb", baseTree())
+	overlayPath := write("spi.dtbo", overlayTree())
+
+	require.NoError(t, devicetree.ApplyOverlayFiles(basePath, overlayPath))
+
+	result, err := dt.ReadFile(basePath)
+	require.NoError(t, err)
+
+	assert.Equal(t, str("okay"), property(t, result.RootNode, "spi@fe2b0000", "status"))
+}
@@ -0,0 +1,210 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package dns
+
+import (
+	"math"
+	"sync"
+	"time"
+
+	"golang.org/x/net/dns/dnsmessage"
+)
+
+const (
+	// defaultCacheSize is the maximum number of the cached responses.
+	defaultCacheSize = 4096
+
+	// maxCacheTTL caps the time the response is cached for.
+	maxCacheTTL = time.Hour
+)
+
+type cacheKey struct {
+	name  string
+	qtype dnsmessage.Type
+	class dnsmessage.Class
+}
+
+type cacheEntry struct {
+	msg     dnsmessage.Message
+	stored  time.Time
+	expires time.Time
+}
+
+// cache keeps the upstream responses for their TTL.
+type cache struct {
+	mu         sync.Mutex
+	entries    map[cacheKey]cacheEntry
+	maxEntries int
+}
+
+func newCache(maxEntries int) *cache {
+	return &cache{
+		entries:    map[cacheKey]cacheEntry{},
+		maxEntries: maxEntries,
+	}
+}
+
+// get returns the cached response with the TTLs decreased by the time spent in the cache.
+func (c *cache) get(key cacheKey, now time.Time) (dnsmessage.Message, bool) {
+	c.mu.Lock()
+	entry, ok := c.entries[key]
+
+	if ok && !now.Before(entry.expires) {
+		delete(c.entries, key)
+
+		ok = false
+	}
+
+	c.mu.Unlock()
+
+	if !ok {
+		return dnsmessage.Message{}, false
+	}
+
+	elapsed := uint32(now.Sub(entry.stored) / time.Second)
+
+	msg := entry.msg
+	msg.Answers = decreaseTTL(msg.Answers, elapsed)
+	msg.Authorities = decreaseTTL(msg.Authorities, elapsed)
+	msg.Additionals = decreaseTTL(msg.Additionals, elapsed)
+
+	return msg, true
+}
+
+// put stores the response if it is cacheable.
+func (c *cache) put(key cacheKey, msg dnsmessage.Message, now time.Time) {
+	ttl, ok := cacheTTL(msg)
+	if !ok {
+		return
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if _, exists := c.entries[key]; !exists && len(c.entries) >= c.maxEntries {
+		c.evict(now)
+	}
+
+	c.entries[key] = cacheEntry{
+		msg:     msg,
+		stored:  now,
+		expires: now.Add(ttl),
+	}
+}
+
+// evict removes the expired entries, if there are none, removes the entry which expires first.
+func (c *cache) evict(now time.Time) {
+	var (
+		oldestKey     cacheKey
+		oldestExpires time.Time
+	)
+
+	for key, entry := range c.entries {
+		if !now.Before(entry.expires) {
+			delete(c.entries, key)
+
+			continue
+		}
+
+		if oldestExpires.IsZero() || entry.expires.Before(oldestExpires) {
+			oldestKey, oldestExpires = key, entry.expires
+		}
+	}
+
+	if len(c.entries) >= c.maxEntries {
+		delete(c.entries, oldestKey)
+	}
+}
+
+// flush removes all entries.
+func (c *cache) flush() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.entries = map[cacheKey]cacheEntry{}
+}
+
+func (c *cache) len() int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	return len(c.entries)
+}
+
+// cacheTTL returns the time the response can be cached for.
+//
+// Negative responses are cached as per RFC 2308, the truncated responses and failures are never cached.
+func cacheTTL(msg dnsmessage.Message) (time.Duration, bool) {
+	if msg.Header.Truncated {
+		return 0, false
+	}
+
+	var ttl uint32
+
+	switch {
+	case msg.Header.RCode == dnsmessage.RCodeSuccess && len(msg.Answers) > 0:
+		ttl = math.MaxUint32
+
+		for _, r := range msg.Answers {
+			if r.Header.TTL < ttl {
+				ttl = r.Header.TTL
+			}
+		}
+	case msg.Header.RCode == dnsmessage.RCodeSuccess || msg.Header.RCode == dnsmessage.RCodeNameError:
+		soaFound := false
+
+		for _, r := range msg.Authorities {
+			if soa, ok := r.Body.(*dnsmessage.SOAResource); ok {
+				ttl = r.Header.TTL
+				if soa.MinTTL < ttl {
+					ttl = soa.MinTTL
+				}
+
+				soaFound = true
+
+				break
+			}
+		}
+
+		if !soaFound {
+			return 0, false
+		}
+	default:
+		return 0, false
+	}
+
+	if ttl == 0 {
+		return 0, false
+	}
+
+	if duration := time.Duration(ttl) * time.Second; duration < maxCacheTTL {
+		return duration, true
+	}
+
+	return maxCacheTTL, true
+}
+
+// decreaseTTL returns the copy of the resources with the TTLs decreased by elapsed seconds.
+func decreaseTTL(resources []dnsmessage.Resource, elapsed uint32) []dnsmessage.Resource {
+	if resources == nil {
+		return nil
+	}
+
+	result := make([]dnsmessage.Resource, len(resources))
+
+	for i, r := range resources {
+		if r.Header.Type != dnsmessage.TypeOPT {
+			if r.Header.TTL > elapsed {
+				r.Header.TTL -= elapsed
+			} else {
+				r.Header.TTL = 0
+			}
+		}
+
+		result[i] = r
+	}
+
+	return result
+}
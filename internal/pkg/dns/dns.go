@@ -0,0 +1,315 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+// Package dns implements the caching DNS forwarder running on the host.
+package dns
+
+import (
+	"context"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/netip"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"go.uber.org/zap"
+	"golang.org/x/net/dns/dnsmessage"
+)
+
+const (
+	// maxMessageSize is the maximum size of the DNS message.
+	maxMessageSize = 65535
+
+	// maxInflightQueries limits the number of the UDP queries processed concurrently.
+	maxInflightQueries = 1024
+
+	// tcpIdleTimeout is the timeout for the idle TCP connections of the clients.
+	tcpIdleTimeout = 10 * time.Second
+
+	// DefaultTimeout is the default timeout for a single upstream query.
+	DefaultTimeout = 2 * time.Second
+)
+
+// Server is a caching DNS forwarder.
+//
+// Server resolves the local host names, answers from the cache and forwards the rest of the queries
+// to the upstream nameservers.
+type Server struct {
+	logger          *zap.Logger
+	listenAddresses []netip.AddrPort
+
+	mu        sync.Mutex
+	upstreams []*upstream
+	hosts     map[string][]netip.Addr
+
+	cache        *cache
+	hits, misses atomic.Uint64
+
+	ctx      context.Context //nolint:containedctx
+	cancel   context.CancelFunc
+	wg       sync.WaitGroup
+	inflight chan struct{}
+
+	connsMu   sync.Mutex
+	listeners []io.Closer
+	tcpConns  map[net.Conn]struct{}
+
+	// Timeout is the timeout for a single upstream query.
+	Timeout time.Duration
+}
+
+// Stats describes the cache statistics.
+type Stats struct {
+	Entries int
+	Hits    uint64
+	Misses  uint64
+}
+
+// NewServer creates a new Server listening on the specified addresses, both UDP and TCP.
+func NewServer(logger *zap.Logger, listenAddresses []netip.AddrPort) *Server {
+	return &Server{
+		logger:          logger,
+		listenAddresses: append([]netip.AddrPort(nil), listenAddresses...),
+		hosts:           map[string][]netip.Addr{},
+		cache:           newCache(defaultCacheSize),
+		inflight:        make(chan struct{}, maxInflightQueries),
+		tcpConns:        map[net.Conn]struct{}{},
+		Timeout:         DefaultTimeout,
+	}
+}
+
+// Start binds the listen addresses and starts serving the queries.
+func (s *Server) Start() error {
+	s.ctx, s.cancel = context.WithCancel(context.Background())
+
+	for _, addr := range s.listenAddresses {
+		udpConn, err := net.ListenUDP("udp", net.UDPAddrFromAddrPort(addr))
+		if err != nil {
+			s.Stop()
+
+			return fmt.Errorf("error listening on udp %s: %w", addr, err)
+		}
+
+		s.addListener(udpConn)
+
+		tcpListener, err := net.ListenTCP("tcp", net.TCPAddrFromAddrPort(addr))
+		if err != nil {
+			s.Stop()
+
+			return fmt.Errorf("error listening on tcp %s: %w", addr, err)
+		}
+
+		s.addListener(tcpListener)
+
+		s.wg.Add(2)
+
+		go s.serveUDP(udpConn)
+		go s.serveTCP(tcpListener)
+	}
+
+	return nil
+}
+
+// Stop closes the listeners and waits for the queries in flight to finish.
+func (s *Server) Stop() {
+	if s.cancel != nil {
+		s.cancel()
+	}
+
+	s.connsMu.Lock()
+
+	for _, l := range s.listeners {
+		l.Close() //nolint:errcheck
+	}
+
+	for conn := range s.tcpConns {
+		conn.Close() //nolint:errcheck
+	}
+
+	s.listeners = nil
+	s.connsMu.Unlock()
+
+	s.wg.Wait()
+}
+
+// SetHosts replaces the names resolved locally.
+//
+// Names are matched case-insensitively, the trailing dot is optional.
+func (s *Server) SetHosts(hosts map[string][]netip.Addr) {
+	canonical := make(map[string][]netip.Addr, len(hosts))
+
+	for name, addrs := range hosts {
+		key := canonicalName(name)
+
+		canonical[key] = append(canonical[key], addrs...)
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.hosts = canonical
+}
+
+// Stats returns the cache statistics.
+func (s *Server) Stats() Stats {
+	return Stats{
+		Entries: s.cache.len(),
+		Hits:    s.hits.Load(),
+		Misses:  s.misses.Load(),
+	}
+}
+
+func (s *Server) addListener(l io.Closer) {
+	s.connsMu.Lock()
+	defer s.connsMu.Unlock()
+
+	s.listeners = append(s.listeners, l)
+}
+
+func (s *Server) serveUDP(conn *net.UDPConn) {
+	defer s.wg.Done()
+
+	buf := make([]byte, maxMessageSize)
+
+	for {
+		n, addr, err := conn.ReadFromUDPAddrPort(buf)
+		if err != nil {
+			if errors.Is(err, net.ErrClosed) {
+				return
+			}
+
+			s.logger.Debug("error reading udp query", zap.Error(err))
+
+			continue
+		}
+
+		select {
+		case s.inflight <- struct{}{}:
+		default:
+			// too many queries in flight, drop the query, the client will retry
+			continue
+		}
+
+		req := append([]byte(nil), buf[:n]...)
+
+		s.wg.Add(1)
+
+		go func() {
+			defer s.wg.Done()
+			defer func() { <-s.inflight }()
+
+			if resp := s.handle(s.ctx, req, "udp"); resp != nil {
+				conn.WriteToUDPAddrPort(resp, addr) //nolint:errcheck
+			}
+		}()
+	}
+}
+
+func (s *Server) serveTCP(l *net.TCPListener) {
+	defer s.wg.Done()
+
+	for {
+		conn, err := l.Accept()
+		if err != nil {
+			if errors.Is(err, net.ErrClosed) {
+				return
+			}
+
+			s.logger.Debug("error accepting tcp connection", zap.Error(err))
+
+			continue
+		}
+
+		s.connsMu.Lock()
+
+		if s.listeners == nil {
+			// server is stopping
+			s.connsMu.Unlock()
+			conn.Close() //nolint:errcheck
+
+			return
+		}
+
+		s.tcpConns[conn] = struct{}{}
+		s.connsMu.Unlock()
+
+		s.wg.Add(1)
+
+		go func() {
+			defer s.wg.Done()
+
+			s.serveTCPConn(conn)
+
+			s.connsMu.Lock()
+			delete(s.tcpConns, conn)
+			s.connsMu.Unlock()
+		}()
+	}
+}
+
+func (s *Server) serveTCPConn(conn net.Conn) {
+	defer conn.Close() //nolint:errcheck
+
+	for {
+		if err := conn.SetDeadline(time.Now().Add(tcpIdleTimeout)); err != nil {
+			return
+		}
+
+		req, err := readTCPMessage(conn)
+		if err != nil {
+			return
+		}
+
+		resp := s.handle(s.ctx, req, "tcp")
+		if resp == nil {
+			return
+		}
+
+		if err = writeTCPMessage(conn, resp); err != nil {
+			return
+		}
+	}
+}
+
+// readTCPMessage reads the DNS message prefixed with the length.
+func readTCPMessage(r io.Reader) ([]byte, error) {
+	var length uint16
+
+	if err := binary.Read(r, binary.BigEndian, &length); err != nil {
+		return nil, err
+	}
+
+	msg := make([]byte, length)
+
+	if _, err := io.ReadFull(r, msg); err != nil {
+		return nil, err
+	}
+
+	return msg, nil
+}
+
+// writeTCPMessage writes the DNS message prefixed with the length.
+func writeTCPMessage(w io.Writer, msg []byte) error {
+	buf := make([]byte, 2, 2+len(msg))
+	binary.BigEndian.PutUint16(buf, uint16(len(msg)))
+
+	_, err := w.Write(append(buf, msg...))
+
+	return err
+}
+
+// canonicalName converts the name to the lowercase fully qualified form.
+func canonicalName(name string) string {
+	return strings.ToLower(strings.TrimSuffix(name, ".")) + "."
+}
+
+// questionName returns the canonical name of the question.
+func questionName(q dnsmessage.Question) string {
+	return canonicalName(q.Name.String())
+}
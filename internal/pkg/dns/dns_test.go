@@ -0,0 +1,359 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package dns_test
+
+import (
+	"encoding/binary"
+	"io"
+	"net"
+	"net/netip"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+	"go.uber.org/zap/zaptest"
+	"golang.org/x/net/dns/dnsmessage"
+
+	"github.com/siderolabs/talos/internal/pkg/dns"
+)
+
+// fakeUpstream answers A queries for example.com and fails the queries for fail.example.
+type fakeUpstream struct {
+	addr    netip.AddrPort
+	queries atomic.Int32
+
+	udpConn     *net.UDPConn
+	tcpListener *net.TCPListener
+	wg          sync.WaitGroup
+}
+
+func (f *fakeUpstream) answer(raw []byte) []byte {
+	f.queries.Add(1)
+
+	var query dnsmessage.Message
+
+	if err := query.Unpack(raw); err != nil {
+		return nil
+	}
+
+	resp := dnsmessage.Message{
+		Header: dnsmessage.Header{
+			ID:       query.Header.ID,
+			Response: true,
+		},
+		Questions: query.Questions,
+	}
+
+	switch strings.ToLower(query.Questions[0].Name.String()) {
+	case "example.com.":
+		resp.Answers = []dnsmessage.Resource{
+			{
+				Header: dnsmessage.ResourceHeader{
+					Name:  query.Questions[0].Name,
+					Type:  dnsmessage.TypeA,
+					Class: dnsmessage.ClassINET,
+					TTL:   60,
+				},
+				Body: &dnsmessage.AResource{A: [4]byte{192, 0, 2, 1}},
+			},
+		}
+	case "fail.example.":
+		resp.Header.RCode = dnsmessage.RCodeServerFailure
+	default:
+		resp.Header.RCode = dnsmessage.RCodeNameError
+	}
+
+	packed, err := resp.Pack()
+	if err != nil {
+		return nil
+	}
+
+	return packed
+}
+
+func (f *fakeUpstream) serve() {
+	f.wg.Add(2)
+
+	go func() {
+		defer f.wg.Done()
+
+		buf := make([]byte, 65535)
+
+		for {
+			n, addr, err := f.udpConn.ReadFromUDPAddrPort(buf)
+			if err != nil {
+				return
+			}
+
+			if resp := f.answer(buf[:n]); resp != nil {
+				f.udpConn.WriteToUDPAddrPort(resp, addr) //nolint:errcheck
+			}
+		}
+	}()
+
+	go func() {
+		defer f.wg.Done()
+
+		for {
+			conn, err := f.tcpListener.Accept()
+			if err != nil {
+				return
+			}
+
+			req, err := readTCP(conn)
+			if err == nil {
+				writeTCP(conn, f.answer(req)) //nolint:errcheck
+			}
+
+			conn.Close() //nolint:errcheck
+		}
+	}()
+}
+
+func (f *fakeUpstream) close() {
+	f.udpConn.Close()     //nolint:errcheck
+	f.tcpListener.Close() //nolint:errcheck
+
+	f.wg.Wait()
+}
+
+func readTCP(r io.Reader) ([]byte, error) {
+	var length uint16
+
+	if err := binary.Read(r, binary.BigEndian, &length); err != nil {
+		return nil, err
+	}
+
+	msg := make([]byte, length)
+
+	_, err := io.ReadFull(r, msg)
+
+	return msg, err
+}
+
+func writeTCP(w io.Writer, msg []byte) error {
+	buf := binary.BigEndian.AppendUint16(nil, uint16(len(msg)))
+
+	_, err := w.Write(append(buf, msg...))
+
+	return err
+}
+
+type DNSSuite struct {
+	suite.Suite
+
+	upstream *fakeUpstream
+	server   *dns.Server
+	addr     netip.AddrPort
+}
+
+func TestDNSSuite(t *testing.T) {
+	suite.Run(t, new(DNSSuite))
+}
+
+// listen binds UDP and TCP on the same random port.
+func (suite *DNSSuite) listen() (*net.UDPConn, *net.TCPListener) {
+	for i := 0; i < 10; i++ {
+		udpConn, err := net.ListenUDP("udp", &net.UDPAddr{IP: net.IPv4(127, 0, 0, 1)})
+		suite.Require().NoError(err)
+
+		tcpListener, err := net.ListenTCP("tcp", &net.TCPAddr{IP: net.IPv4(127, 0, 0, 1), Port: udpConn.LocalAddr().(*net.UDPAddr).Port})
+		if err == nil {
+			return udpConn, tcpListener
+		}
+
+		udpConn.Close() //nolint:errcheck
+	}
+
+	suite.FailNow("failed to find a free port")
+
+	return nil, nil
+}
+
+func (suite *DNSSuite) SetupTest() {
+	udpConn, tcpListener := suite.listen()
+
+	suite.upstream = &fakeUpstream{
+		addr:        udpConn.LocalAddr().(*net.UDPAddr).AddrPort(),
+		udpConn:     udpConn,
+		tcpListener: tcpListener,
+	}
+
+	suite.upstream.serve()
+
+	// reserve the port for the server under test
+	udpConn, tcpListener = suite.listen()
+	suite.addr = udpConn.LocalAddr().(*net.UDPAddr).AddrPort()
+
+	udpConn.Close()     //nolint:errcheck
+	tcpListener.Close() //nolint:errcheck
+
+	suite.server = dns.NewServer(zaptest.NewLogger(suite.T()), []netip.AddrPort{suite.addr})
+	suite.server.Timeout = 500 * time.Millisecond
+
+	suite.Require().NoError(suite.server.Start())
+}
+
+func (suite *DNSSuite) TearDownTest() {
+	suite.server.Stop()
+	suite.upstream.close()
+}
+
+func (suite *DNSSuite) query(network, name string, qtype dnsmessage.Type) dnsmessage.Message {
+	query := dnsmessage.Message{
+		Header: dnsmessage.Header{
+			ID:               0x1234,
+			RecursionDesired: true,
+		},
+		Questions: []dnsmessage.Question{
+			{
+				Name:  dnsmessage.MustNewName(name),
+				Type:  qtype,
+				Class: dnsmessage.ClassINET,
+			},
+		},
+	}
+
+	raw, err := query.Pack()
+	suite.Require().NoError(err)
+
+	conn, err := net.Dial(network, suite.addr.String())
+	suite.Require().NoError(err)
+
+	defer conn.Close() //nolint:errcheck
+
+	suite.Require().NoError(conn.SetDeadline(time.Now().Add(5 * time.Second)))
+
+	var resp []byte
+
+	if network == "tcp" {
+		suite.Require().NoError(writeTCP(conn, raw))
+
+		resp, err = readTCP(conn)
+		suite.Require().NoError(err)
+	} else {
+		_, err = conn.Write(raw)
+		suite.Require().NoError(err)
+
+		buf := make([]byte, 65535)
+
+		n, err := conn.Read(buf)
+		suite.Require().NoError(err)
+
+		resp = buf[:n]
+	}
+
+	var msg dnsmessage.Message
+
+	suite.Require().NoError(msg.Unpack(resp))
+	suite.Assert().EqualValues(0x1234, msg.Header.ID)
+	suite.Assert().True(msg.Header.Response)
+
+	return msg
+}
+
+func (suite *DNSSuite) TestForwardAndCache() {
+	suite.server.SetUpstreams([]netip.AddrPort{suite.upstream.addr})
+
+	for i := 0; i < 3; i++ {
+		msg := suite.query("udp", "Example.COM.", dnsmessage.TypeA)
+
+		suite.Require().Equal(dnsmessage.RCodeSuccess, msg.Header.RCode)
+		suite.Require().Len(msg.Answers, 1)
+		suite.Assert().Equal([4]byte{192, 0, 2, 1}, msg.Answers[0].Body.(*dnsmessage.AResource).A)
+		suite.Assert().Equal("Example.COM.", msg.Questions[0].Name.String())
+	}
+
+	suite.Assert().EqualValues(1, suite.upstream.queries.Load())
+	suite.Assert().Equal(dns.Stats{Entries: 1, Hits: 2, Misses: 1}, suite.server.Stats())
+
+	// negative responses without SOA are not cached
+	for i := 0; i < 2; i++ {
+		msg := suite.query("tcp", "missing.example.", dnsmessage.TypeA)
+
+		suite.Assert().Equal(dnsmessage.RCodeNameError, msg.Header.RCode)
+	}
+
+	suite.Assert().EqualValues(3, suite.upstream.queries.Load())
+
+	// changing the upstreams flushes the cache
+	suite.server.SetUpstreams([]netip.AddrPort{netip.MustParseAddrPort("127.0.0.1:1"), suite.upstream.addr})
+	suite.Assert().Equal(0, suite.server.Stats().Entries)
+}
+
+func (suite *DNSSuite) TestLocalHosts() {
+	suite.server.SetHosts(map[string][]netip.Addr{
+		"talos-node":        {netip.MustParseAddr("172.20.0.2"), netip.MustParseAddr("fd00::2")},
+		"talos-node.local.": {netip.MustParseAddr("172.20.0.2")},
+	})
+
+	msg := suite.query("udp", "TALOS-node.", dnsmessage.TypeA)
+	suite.Require().Equal(dnsmessage.RCodeSuccess, msg.Header.RCode)
+	suite.Assert().True(msg.Header.Authoritative)
+	suite.Require().Len(msg.Answers, 1)
+	suite.Assert().Equal([4]byte{172, 20, 0, 2}, msg.Answers[0].Body.(*dnsmessage.AResource).A)
+
+	msg = suite.query("tcp", "talos-node.", dnsmessage.TypeAAAA)
+	suite.Require().Len(msg.Answers, 1)
+	suite.Assert().Equal(netip.MustParseAddr("fd00::2").As16(), msg.Answers[0].Body.(*dnsmessage.AAAAResource).AAAA)
+
+	msg = suite.query("udp", "talos-node.local.", dnsmessage.TypeAAAA)
+	suite.Assert().Equal(dnsmessage.RCodeSuccess, msg.Header.RCode)
+	suite.Assert().Empty(msg.Answers)
+
+	// local names are never forwarded
+	suite.Assert().EqualValues(0, suite.upstream.queries.Load())
+}
+
+func (suite *DNSSuite) TestUpstreamFailure() {
+	// nothing listens on the first upstream
+	unreachable := netip.MustParseAddrPort("127.0.0.1:1")
+
+	suite.server.SetUpstreams([]netip.AddrPort{unreachable, suite.upstream.addr})
+
+	msg := suite.query("udp", "example.com.", dnsmessage.TypeA)
+	suite.Require().Equal(dnsmessage.RCodeSuccess, msg.Header.RCode)
+
+	upstreams := suite.server.Upstreams()
+	suite.Require().Len(upstreams, 2)
+	suite.Assert().Equal(unreachable, upstreams[0].Address)
+	suite.Assert().False(upstreams[0].Healthy)
+	suite.Assert().NotEmpty(upstreams[0].LastError)
+	suite.Assert().True(upstreams[1].Healthy)
+
+	// upstream failure is reported to the client
+	msg = suite.query("udp", "fail.example.", dnsmessage.TypeA)
+	suite.Assert().Equal(dnsmessage.RCodeServerFailure, msg.Header.RCode)
+
+	upstreams = suite.server.Upstreams()
+	suite.Assert().False(upstreams[1].Healthy)
+	suite.Assert().Equal("upstream returned RCodeServerFailure", upstreams[1].LastError)
+
+	// health is preserved across the updates
+	suite.server.SetUpstreams([]netip.AddrPort{suite.upstream.addr})
+	suite.Assert().False(suite.server.Upstreams()[0].Healthy)
+
+	msg = suite.query("tcp", "example.com.", dnsmessage.TypeA)
+	suite.Assert().Equal(dnsmessage.RCodeSuccess, msg.Header.RCode)
+	suite.Assert().True(suite.server.Upstreams()[0].Healthy)
+}
+
+func (suite *DNSSuite) TestNoUpstreams() {
+	msg := suite.query("udp", "example.com.", dnsmessage.TypeA)
+	suite.Assert().Equal(dnsmessage.RCodeServerFailure, msg.Header.RCode)
+}
+
+func (suite *DNSSuite) TestStartError() {
+	server := dns.NewServer(zaptest.NewLogger(suite.T()), []netip.AddrPort{suite.addr})
+
+	// the address is already in use
+	suite.Require().Error(server.Start())
+
+	// stopping the server which failed to start is a no-op
+	server.Stop()
+}
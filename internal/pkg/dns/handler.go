@@ -0,0 +1,269 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package dns
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"go.uber.org/zap"
+	"golang.org/x/net/dns/dnsmessage"
+)
+
+const (
+	// hostsTTL is the TTL of the answers for the local host names.
+	hostsTTL = 10
+
+	// minUDPSize is the maximum size of the UDP response without EDNS0.
+	minUDPSize = 512
+
+	// ednsUDPSize is the UDP payload size advertised in the responses.
+	ednsUDPSize = 1232
+)
+
+// request is the parsed client query.
+type request struct {
+	header   dnsmessage.Header
+	question dnsmessage.Question
+	edns     bool
+	udpSize  int
+}
+
+// handle processes the raw query, returns nil if the query should be dropped.
+func (s *Server) handle(ctx context.Context, raw []byte, network string) []byte {
+	req, rcode, err := parseRequest(raw)
+	if err != nil {
+		// not a valid query, drop it
+		return nil
+	}
+
+	if rcode != dnsmessage.RCodeSuccess {
+		return req.errorResponse(rcode)
+	}
+
+	now := time.Now()
+	key := cacheKey{
+		name:  questionName(req.question),
+		qtype: req.question.Type,
+		class: req.question.Class,
+	}
+
+	if msg, ok := s.resolveLocal(req, key.name); ok {
+		return req.response(msg, network)
+	}
+
+	if msg, ok := s.cache.get(key, now); ok {
+		s.hits.Add(1)
+
+		return req.response(msg, network)
+	}
+
+	s.misses.Add(1)
+
+	msg, resp, err := s.forward(ctx, raw, network)
+	if err != nil {
+		s.logger.Debug("error forwarding query", zap.Stringer("name", req.question.Name), zap.Stringer("type", req.question.Type), zap.Error(err))
+
+		return req.errorResponse(dnsmessage.RCodeServerFailure)
+	}
+
+	if msg == nil {
+		// the response can't be parsed, pass it through as is
+		return resp
+	}
+
+	s.cache.put(key, *msg, now)
+
+	return req.response(*msg, network)
+}
+
+// parseRequest parses the query, for the queries which can't be served returns the error rcode.
+func parseRequest(raw []byte) (request, dnsmessage.RCode, error) {
+	var (
+		p   dnsmessage.Parser
+		req request
+		err error
+	)
+
+	req.udpSize = minUDPSize
+
+	if req.header, err = p.Start(raw); err != nil {
+		return req, 0, err
+	}
+
+	if req.header.Response {
+		return req, 0, errors.New("not a query")
+	}
+
+	if req.question, err = p.Question(); err != nil {
+		return req, dnsmessage.RCodeFormatError, nil
+	}
+
+	if req.header.OpCode != 0 {
+		return req, dnsmessage.RCodeNotImplemented, nil
+	}
+
+	if err = p.SkipAllQuestions(); err != nil {
+		return req, dnsmessage.RCodeFormatError, nil
+	}
+
+	if err = p.SkipAllAnswers(); err != nil {
+		return req, dnsmessage.RCodeFormatError, nil
+	}
+
+	if err = p.SkipAllAuthorities(); err != nil {
+		return req, dnsmessage.RCodeFormatError, nil
+	}
+
+	for {
+		h, err := p.AdditionalHeader()
+		if err != nil {
+			break
+		}
+
+		if h.Type == dnsmessage.TypeOPT {
+			req.edns = true
+
+			// the class of the OPT record holds the UDP payload size
+			if size := int(h.Class); size > req.udpSize {
+				req.udpSize = size
+			}
+		}
+
+		if err = p.SkipAdditional(); err != nil {
+			return req, dnsmessage.RCodeFormatError, nil
+		}
+	}
+
+	return req, dnsmessage.RCodeSuccess, nil
+}
+
+// resolveLocal answers the query for the local host names.
+func (s *Server) resolveLocal(req request, name string) (dnsmessage.Message, bool) {
+	s.mu.Lock()
+	addrs, ok := s.hosts[name]
+	s.mu.Unlock()
+
+	if !ok {
+		return dnsmessage.Message{}, false
+	}
+
+	msg := dnsmessage.Message{
+		Header: dnsmessage.Header{
+			Response:           true,
+			Authoritative:      true,
+			RecursionAvailable: true,
+		},
+	}
+
+	for _, addr := range addrs {
+		header := dnsmessage.ResourceHeader{
+			Name:  req.question.Name,
+			Class: dnsmessage.ClassINET,
+			TTL:   hostsTTL,
+		}
+
+		switch {
+		case req.question.Type == dnsmessage.TypeA && addr.Is4():
+			header.Type = dnsmessage.TypeA
+
+			msg.Answers = append(msg.Answers, dnsmessage.Resource{
+				Header: header,
+				Body:   &dnsmessage.AResource{A: addr.As4()},
+			})
+		case req.question.Type == dnsmessage.TypeAAAA && addr.Is6() && !addr.Is4In6():
+			header.Type = dnsmessage.TypeAAAA
+
+			msg.Answers = append(msg.Answers, dnsmessage.Resource{
+				Header: header,
+				Body:   &dnsmessage.AAAAResource{AAAA: addr.As16()},
+			})
+		}
+	}
+
+	return msg, true
+}
+
+// response builds the response to the query from the message.
+func (req request) response(msg dnsmessage.Message, network string) []byte {
+	msg.Header.ID = req.header.ID
+	msg.Header.RecursionDesired = req.header.RecursionDesired
+	msg.Questions = []dnsmessage.Question{req.question}
+
+	// OPT record is hop-by-hop, so the upstream OPT record is never passed to the client
+	additionals := make([]dnsmessage.Resource, 0, len(msg.Additionals)+1)
+
+	for _, r := range msg.Additionals {
+		if r.Header.Type != dnsmessage.TypeOPT {
+			additionals = append(additionals, r)
+		}
+	}
+
+	if req.edns {
+		additionals = append(additionals, optResource())
+	}
+
+	msg.Additionals = additionals
+
+	resp, err := msg.Pack()
+	if err != nil {
+		return req.errorResponse(dnsmessage.RCodeServerFailure)
+	}
+
+	if network == "udp" && len(resp) > req.udpSize {
+		// the client should retry over TCP
+		msg.Header.Truncated = true
+		msg.Answers = nil
+		msg.Authorities = nil
+		msg.Additionals = nil
+
+		if req.edns {
+			msg.Additionals = []dnsmessage.Resource{optResource()}
+		}
+
+		if resp, err = msg.Pack(); err != nil {
+			return req.errorResponse(dnsmessage.RCodeServerFailure)
+		}
+	}
+
+	return resp
+}
+
+// errorResponse builds the response with the error rcode.
+func (req request) errorResponse(rcode dnsmessage.RCode) []byte {
+	msg := dnsmessage.Message{
+		Header: dnsmessage.Header{
+			ID:                 req.header.ID,
+			Response:           true,
+			OpCode:             req.header.OpCode,
+			RecursionDesired:   req.header.RecursionDesired,
+			RecursionAvailable: true,
+			RCode:              rcode,
+		},
+	}
+
+	if rcode != dnsmessage.RCodeFormatError {
+		msg.Questions = []dnsmessage.Question{req.question}
+	}
+
+	resp, err := msg.Pack()
+	if err != nil {
+		return nil
+	}
+
+	return resp
+}
+
+func optResource() dnsmessage.Resource {
+	var header dnsmessage.ResourceHeader
+
+	header.SetEDNS0(ednsUDPSize, dnsmessage.RCodeSuccess, false) //nolint:errcheck // fails only for the invalid rcode
+
+	return dnsmessage.Resource{
+		Header: header,
+		Body:   &dnsmessage.OPTResource{},
+	}
+}
@@ -0,0 +1,259 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package dns
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"net"
+	"net/netip"
+
+	"go.uber.org/zap"
+	"golang.org/x/net/dns/dnsmessage"
+)
+
+// upstream is the nameserver queries are forwarded to.
+type upstream struct {
+	addr    netip.AddrPort
+	healthy bool
+	lastErr string
+}
+
+// UpstreamStatus describes the state of the upstream nameserver.
+type UpstreamStatus struct {
+	Address   netip.AddrPort
+	Healthy   bool
+	LastError string
+}
+
+// SetUpstreams replaces the list of the upstream nameservers.
+//
+// The health of the nameservers which are kept in the list is preserved, new nameservers are considered healthy.
+// If the list changes, the cache is flushed.
+func (s *Server) SetUpstreams(addrs []netip.AddrPort) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	changed := len(addrs) != len(s.upstreams)
+
+	upstreams := make([]*upstream, 0, len(addrs))
+
+	for i, addr := range addrs {
+		if !changed && s.upstreams[i].addr != addr {
+			changed = true
+		}
+
+		u := &upstream{
+			addr:    addr,
+			healthy: true,
+		}
+
+		for _, existing := range s.upstreams {
+			if existing.addr == addr {
+				u = existing
+
+				break
+			}
+		}
+
+		upstreams = append(upstreams, u)
+	}
+
+	s.upstreams = upstreams
+
+	if changed {
+		s.cache.flush()
+	}
+}
+
+// Upstreams returns the status of the upstream nameservers.
+func (s *Server) Upstreams() []UpstreamStatus {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	result := make([]UpstreamStatus, 0, len(s.upstreams))
+
+	for _, u := range s.upstreams {
+		result = append(result, UpstreamStatus{
+			Address:   u.addr,
+			Healthy:   u.healthy,
+			LastError: u.lastErr,
+		})
+	}
+
+	return result
+}
+
+// forward sends the query to the upstream nameservers, healthy nameservers are tried first.
+//
+// If the response can't be parsed, it is returned as is along with the nil message.
+func (s *Server) forward(ctx context.Context, req []byte, network string) (*dnsmessage.Message, []byte, error) {
+	upstreams := s.orderedUpstreams()
+	if len(upstreams) == 0 {
+		return nil, nil, errors.New("no upstream nameservers")
+	}
+
+	var lastErr error
+
+	for _, u := range upstreams {
+		msg, resp, err := s.exchange(ctx, u.addr, req, network)
+
+		if err == nil && msg != nil && (msg.Header.RCode == dnsmessage.RCodeServerFailure || msg.Header.RCode == dnsmessage.RCodeRefused) {
+			err = fmt.Errorf("upstream returned %s", msg.Header.RCode)
+		}
+
+		s.setHealth(u, err)
+
+		if err != nil {
+			if ctx.Err() != nil {
+				return nil, nil, ctx.Err()
+			}
+
+			lastErr = err
+
+			continue
+		}
+
+		return msg, resp, nil
+	}
+
+	return nil, nil, lastErr
+}
+
+// orderedUpstreams returns the upstreams with the healthy ones first.
+func (s *Server) orderedUpstreams() []*upstream {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	result := make([]*upstream, 0, len(s.upstreams))
+
+	for _, u := range s.upstreams {
+		if u.healthy {
+			result = append(result, u)
+		}
+	}
+
+	for _, u := range s.upstreams {
+		if !u.healthy {
+			result = append(result, u)
+		}
+	}
+
+	return result
+}
+
+func (s *Server) setHealth(u *upstream, err error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	healthy := err == nil
+
+	switch {
+	case healthy && !u.healthy:
+		s.logger.Info("upstream nameserver is healthy", zap.Stringer("upstream", u.addr))
+	case !healthy && u.healthy:
+		s.logger.Warn("upstream nameserver is unhealthy", zap.Stringer("upstream", u.addr), zap.Error(err))
+	}
+
+	u.healthy = healthy
+
+	if err != nil {
+		u.lastErr = err.Error()
+	} else {
+		u.lastErr = ""
+	}
+}
+
+// exchange sends the query to the upstream and reads the response.
+//
+// The query is sent with the random ID, the response ID is restored to the original one.
+func (s *Server) exchange(ctx context.Context, addr netip.AddrPort, req []byte, network string) (*dnsmessage.Message, []byte, error) {
+	if len(req) < 2 {
+		return nil, nil, errors.New("query is too short")
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, s.Timeout)
+	defer cancel()
+
+	var dialer net.Dialer
+
+	conn, err := dialer.DialContext(ctx, network, addr.String())
+	if err != nil {
+		return nil, nil, err
+	}
+
+	defer conn.Close() //nolint:errcheck
+
+	deadline, _ := ctx.Deadline()
+
+	if err = conn.SetDeadline(deadline); err != nil {
+		return nil, nil, err
+	}
+
+	var idBuf [2]byte
+
+	if _, err = rand.Read(idBuf[:]); err != nil {
+		return nil, nil, err
+	}
+
+	originalID := binary.BigEndian.Uint16(req)
+	id := binary.BigEndian.Uint16(idBuf[:])
+
+	query := append([]byte(nil), req...)
+	binary.BigEndian.PutUint16(query, id)
+
+	var resp []byte
+
+	if network == "tcp" {
+		if err = writeTCPMessage(conn, query); err != nil {
+			return nil, nil, err
+		}
+
+		if resp, err = readTCPMessage(conn); err != nil {
+			return nil, nil, err
+		}
+	} else {
+		if _, err = conn.Write(query); err != nil {
+			return nil, nil, err
+		}
+
+		buf := make([]byte, maxMessageSize)
+
+		for {
+			n, err := conn.Read(buf)
+			if err != nil {
+				return nil, nil, err
+			}
+
+			// skip the stray responses
+			if n >= 2 && binary.BigEndian.Uint16(buf) == id {
+				resp = buf[:n]
+
+				break
+			}
+		}
+	}
+
+	if len(resp) < 2 || binary.BigEndian.Uint16(resp) != id {
+		return nil, nil, errors.New("response ID mismatch")
+	}
+
+	binary.BigEndian.PutUint16(resp, originalID)
+
+	var msg dnsmessage.Message
+
+	if err = msg.Unpack(resp); err != nil {
+		return nil, resp, nil //nolint:nilerr
+	}
+
+	if !msg.Header.Response {
+		return nil, nil, errors.New("upstream returned a query")
+	}
+
+	return &msg, resp, nil
+}
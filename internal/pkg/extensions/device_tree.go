@@ -0,0 +1,16 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package extensions
+
+import (
+	"path/filepath"
+
+	"github.com/siderolabs/talos/pkg/machinery/constants"
+)
+
+// DeviceTreeOverlaysDirectory returns the path to the device tree overlays directory.
+func (ext *Extension) DeviceTreeOverlaysDirectory() string {
+	return filepath.Join(ext.rootfsPath, constants.DeviceTreeOverlaysPath)
+}
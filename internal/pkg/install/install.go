@@ -29,6 +29,7 @@ import (
 	"github.com/siderolabs/talos/internal/pkg/containers/image"
 	"github.com/siderolabs/talos/internal/pkg/environment"
 	"github.com/siderolabs/talos/internal/pkg/extensions"
+	"github.com/siderolabs/talos/internal/pkg/meta"
 	machineapi "github.com/siderolabs/talos/pkg/machinery/api/machine"
 	"github.com/siderolabs/talos/pkg/machinery/config"
 	"github.com/siderolabs/talos/pkg/machinery/config/types/v1alpha1"
@@ -158,8 +159,12 @@ func RunInstallerContainer(disk, platform, ref string, cfg config.Provider, opts
 		"--zero=" + zero,
 	}
 
-	if c := procfs.ProcCmdline().Get(constants.KernelParamBoard).First(); c != nil {
+	// the board is passed only if it is recorded by the previous install, the device tree is not used to guess it
+	switch c := procfs.ProcCmdline().Get(constants.KernelParamBoard).First(); {
+	case c != nil:
 		args = append(args, "--board="+*c)
+	case options.Board != "":
+		args = append(args, "--board="+options.Board)
 	}
 
 	for _, arg := range options.ExtraKernelArgs {
@@ -278,6 +283,7 @@ func OptionsFromUpgradeRequest(r runtime.Runtime, in *machineapi.UpgradeRequest)
 		WithPull(false),
 		WithUpgrade(true),
 		WithForce(!in.GetPreserve()),
+		WithRecordedBoard(r.State().Machine().Meta()),
 	}
 
 	if r.Config() != nil {
@@ -286,3 +292,10 @@ func OptionsFromUpgradeRequest(r runtime.Runtime, in *machineapi.UpgradeRequest)
 
 	return opts
 }
+
+// WithRecordedBoard sets the board recorded in the META by the installer.
+func WithRecordedBoard(m runtime.Meta) Option {
+	board, _ := m.ReadTag(meta.Board)
+
+	return WithBoard(board)
+}
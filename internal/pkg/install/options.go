@@ -14,6 +14,7 @@ type Options struct {
 	Upgrade         bool
 	Zero            bool
 	ExtraKernelArgs []string
+	Board           string
 }
 
 // DefaultInstallOptions returns default options.
@@ -87,3 +88,12 @@ func WithExtraKernelArgs(s []string) Option {
 		return nil
 	}
 }
+
+// WithBoard sets the board.
+func WithBoard(board string) Option {
+	return func(o *Options) error {
+		o.Board = board
+
+		return nil
+	}
+}
@@ -23,4 +23,12 @@ const (
 	UserReserved2
 	// UserReserved3 is reserved for user-defined metadata.
 	UserReserved3
+	// BootloaderVersion stores the version of U-Boot installed by the board installer.
+	BootloaderVersion
+	// SecretKey stores the age identity which decrypts the secret references in the machine configuration.
+	SecretKey
+	// Board stores the name of the board installed by the installer.
+	Board
+	// BoardLayout stores the layout of the board image (e.g. nvme) installed by the installer.
+	BoardLayout
 )
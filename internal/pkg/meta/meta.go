@@ -308,11 +308,19 @@ func (meta *Meta) DeleteTag(ctx context.Context, t uint8) (bool, error) {
 	return ok, err
 }
 
+// redactedValue replaces the value of the secret tags in the MetaKey resources.
+const redactedValue = "******"
+
 func updateTagResource(ctx context.Context, st state.State, t uint8, val string) error {
 	if st == nil {
 		return nil
 	}
 
+	// the secret key is not exposed via the API, only its presence is
+	if t == SecretKey {
+		val = redactedValue
+	}
+
 	_, err := safe.StateUpdateWithConflicts(ctx, st, runtime.NewMetaKey(runtime.NamespaceName, runtime.MetaKeyTagToID(t)).Metadata(), func(r *runtime.MetaKey) error {
 		r.TypedSpec().Value = val
 
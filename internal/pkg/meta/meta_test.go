@@ -96,3 +96,23 @@ func TestFlow(t *testing.T) {
 		assert.Equal(t, "install-fast", iter.Value().TypedSpec().Value)
 	}
 }
+
+func TestSecretKeyRedacted(t *testing.T) {
+	t.Parallel()
+
+	m, _, st := setupTest(t)
+
+	ctx := context.Background()
+
+	ok, err := m.SetTagBytes(ctx, meta.SecretKey, []byte("AGE-SECRET-KEY-1"))
+	require.NoError(t, err)
+	assert.True(t, ok)
+
+	val, ok := m.ReadTag(meta.SecretKey)
+	assert.True(t, ok)
+	assert.Equal(t, "AGE-SECRET-KEY-1", val)
+
+	key, err := safe.StateGet[*runtime.MetaKey](ctx, st, runtime.NewMetaKey(runtime.NamespaceName, runtime.MetaKeyTagToID(meta.SecretKey)).Metadata())
+	require.NoError(t, err)
+	assert.Equal(t, "******", key.TypedSpec().Value)
+}
@@ -0,0 +1,90 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+// Package mtd provides information about the Linux memory technology devices (raw flash).
+package mtd
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+const sysfsClassMTD = "/sys/class/mtd"
+
+// Device describes a MTD device.
+type Device struct {
+	// Name of the MTD partition as reported by the driver, e.g. sfc_nor.
+	Name string
+	// Type of the device, e.g. nor.
+	Type string
+	// Size of the device in bytes.
+	Size int64
+	// EraseSize is the size of the erase block in bytes.
+	EraseSize int64
+}
+
+var devicePathRe = regexp.MustCompile(`^/dev/mtd(?:block)?(\d+)$`)
+
+// Lookup returns information about the MTD device by the block device (/dev/mtdblockN) or character device (/dev/mtdN) path.
+func Lookup(device string) (*Device, error) {
+	return lookup(sysfsClassMTD, device)
+}
+
+func lookup(root, device string) (*Device, error) {
+	match := devicePathRe.FindStringSubmatch(device)
+	if match == nil {
+		return nil, fmt.Errorf("%q is not a MTD device", device)
+	}
+
+	dir := filepath.Join(root, "mtd"+match[1])
+
+	if _, err := os.Stat(dir); err != nil {
+		return nil, fmt.Errorf("MTD device %q not found: %w", device, err)
+	}
+
+	var (
+		dev Device
+		err error
+	)
+
+	if dev.Name, err = readString(filepath.Join(dir, "name")); err != nil {
+		return nil, err
+	}
+
+	if dev.Type, err = readString(filepath.Join(dir, "type")); err != nil {
+		return nil, err
+	}
+
+	if dev.Size, err = readInt(filepath.Join(dir, "size")); err != nil {
+		return nil, err
+	}
+
+	if dev.EraseSize, err = readInt(filepath.Join(dir, "erasesize")); err != nil {
+		return nil, err
+	}
+
+	return &dev, nil
+}
+
+func readString(path string) (string, error) {
+	contents, err := os.ReadFile(path)
+	if err != nil {
+		return "", err
+	}
+
+	return strings.TrimSpace(string(contents)), nil
+}
+
+func readInt(path string) (int64, error) {
+	s, err := readString(path)
+	if err != nil {
+		return 0, err
+	}
+
+	return strconv.ParseInt(s, 10, 64)
+}
@@ -0,0 +1,48 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package mtd_test
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/siderolabs/talos/internal/pkg/mtd"
+)
+
+func TestLookup(t *testing.T) {
+	root := t.TempDir()
+
+	for name, contents := range map[string]string{
+		"name":      "sfc_nor",
+		"type":      "nor",
+		"size":      "16777216",
+		"erasesize": "4096",
+	} {
+		require.NoError(t, os.MkdirAll(filepath.Join(root, "mtd0"), 0o755))
+		require.NoError(t, os.WriteFile(filepath.Join(root, "mtd0", name), []byte(contents+"\n"), 0o644))
+	}
+
+	for _, device := range []string{"/dev/mtdblock0", "/dev/mtd0"} {
+		dev, err := mtd.LookupWithRoot(root, device)
+		require.NoError(t, err)
+
+		assert.Equal(t, &mtd.Device{
+			Name:      "sfc_nor",
+			Type:      "nor",
+			Size:      16 * 1024 * 1024,
+			EraseSize: 4096,
+		}, dev)
+	}
+
+	_, err := mtd.LookupWithRoot(root, "/dev/mtdblock1")
+	assert.ErrorContains(t, err, "not found")
+
+	_, err = mtd.LookupWithRoot(root, "/dev/nvme0n1")
+	assert.ErrorContains(t, err, "is not a MTD device")
+}
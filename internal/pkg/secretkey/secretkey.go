@@ -0,0 +1,55 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+// Package secretkey provisions the key which decrypts the secret references in the machine configuration.
+package secretkey
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/siderolabs/talos/internal/app/machined/pkg/runtime"
+	"github.com/siderolabs/talos/internal/pkg/meta"
+	"github.com/siderolabs/talos/pkg/machinery/config/secretref"
+	"github.com/siderolabs/talos/pkg/machinery/constants"
+)
+
+// Provision writes the age identity stored in META to the constants.SecretKeyPath.
+//
+// If the key is not in META, the existing key file is kept as is.
+func Provision(m runtime.Meta) error {
+	return provision(m, constants.SecretKeyPath)
+}
+
+// Remove removes the key file, it is called when the key is removed from META.
+func Remove() error {
+	if err := os.Remove(constants.SecretKeyPath); err != nil && !os.IsNotExist(err) {
+		return fmt.Errorf("error removing secret key: %w", err)
+	}
+
+	return nil
+}
+
+func provision(m runtime.Meta, path string) error {
+	key, ok := m.ReadTagBytes(meta.SecretKey)
+	if !ok {
+		return nil
+	}
+
+	if _, err := secretref.ParseIdentities(key); err != nil {
+		return err
+	}
+
+	// STATE is not mounted in the maintenance mode, so the key is written to the tmpfs mount point until the install
+	if err := os.MkdirAll(filepath.Dir(path), 0o700); err != nil {
+		return fmt.Errorf("error creating secret key directory: %w", err)
+	}
+
+	if err := os.WriteFile(path, key, 0o600); err != nil {
+		return fmt.Errorf("error writing secret key: %w", err)
+	}
+
+	return nil
+}
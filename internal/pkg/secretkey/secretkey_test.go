@@ -0,0 +1,59 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package secretkey
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"filippo.io/age"
+	"github.com/cosi-project/runtime/pkg/state"
+	"github.com/cosi-project/runtime/pkg/state/impl/inmem"
+	"github.com/cosi-project/runtime/pkg/state/impl/namespaced"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/siderolabs/talos/internal/pkg/meta"
+	"github.com/siderolabs/talos/pkg/machinery/config/secretref"
+)
+
+func TestProvision(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	tmpDir := t.TempDir()
+
+	metaPath := filepath.Join(tmpDir, "meta")
+	require.NoError(t, os.WriteFile(metaPath, make([]byte, 1024*1024), 0o600))
+
+	m, err := meta.New(ctx, state.WrapCore(namespaced.NewState(inmem.Build)), meta.WithFixedPath(metaPath))
+	require.NoError(t, err)
+
+	keyPath := filepath.Join(tmpDir, "state", "secret.key")
+
+	// no key in META
+	require.NoError(t, provision(m, keyPath))
+	assert.NoFileExists(t, keyPath)
+
+	_, err = m.SetTag(ctx, meta.SecretKey, "not a key")
+	require.NoError(t, err)
+	assert.Error(t, provision(m, keyPath))
+
+	identity, err := age.GenerateX25519Identity()
+	require.NoError(t, err)
+
+	_, err = m.SetTagBytes(ctx, meta.SecretKey, secretref.FormatIdentity(identity))
+	require.NoError(t, err)
+	require.NoError(t, provision(m, keyPath))
+
+	data, err := os.ReadFile(keyPath)
+	require.NoError(t, err)
+
+	identities, err := secretref.ParseIdentities(data)
+	require.NoError(t, err)
+	assert.Equal(t, []age.Identity{identity}, identities)
+}
@@ -0,0 +1,13 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package uboot
+
+// BlockDevice is exported for testing.
+type BlockDevice = blockDevice
+
+// Update is exported for testing.
+func Update(dev BlockDevice, data, previous []byte, offset int64) error {
+	return update(dev, data, previous, offset)
+}
@@ -0,0 +1,265 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+// Package uboot implements installing U-Boot images to raw block devices.
+package uboot
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"log"
+	"os"
+	"regexp"
+
+	"golang.org/x/sys/unix"
+)
+
+// Image describes a U-Boot image which is written to the block device at the specified offset.
+type Image struct {
+	Path   string
+	Offset int64
+
+	// DiskLayout is set if the image file is laid out as the device (i.e. it starts at the sector 0),
+	// so the contents before the offset are skipped.
+	//
+	// Otherwise the image is a raw blob which is written at the offset as a whole.
+	DiskLayout bool
+}
+
+// Read returns the contents of the image to be written at the offset.
+func (img Image) Read() ([]byte, error) {
+	contents, err := os.ReadFile(img.Path)
+	if err != nil {
+		return nil, err
+	}
+
+	if !img.DiskLayout {
+		return contents, nil
+	}
+
+	if int64(len(contents)) <= img.Offset {
+		return nil, fmt.Errorf("image %q is smaller than the offset %d", img.Path, img.Offset)
+	}
+
+	return contents[img.Offset:], nil
+}
+
+// Version returns the U-Boot version embedded into the image.
+func (img Image) Version() (string, error) {
+	contents, err := img.Read()
+	if err != nil {
+		return "", err
+	}
+
+	return Version(contents), nil
+}
+
+var versionRe = regexp.MustCompile(`U-Boot (?:SPL )?(\d{4}\.\d{2}[[:graph:]]*)`)
+
+// Version extracts the U-Boot version string (e.g. 2023.07-rc3) from the binary.
+//
+// Empty string is returned if the version can't be found.
+func Version(data []byte) string {
+	match := versionRe.FindSubmatch(data)
+	if match == nil {
+		return ""
+	}
+
+	return string(match[1])
+}
+
+// bootedVersionPath is the device tree property U-Boot sets to its version before starting the kernel.
+const bootedVersionPath = "/proc/device-tree/chosen/u-boot,version"
+
+// BootedVersion returns the version of U-Boot which booted the running kernel.
+//
+// Empty string is returned if the bootloader doesn't report the version.
+func BootedVersion() (string, error) {
+	contents, err := os.ReadFile(bootedVersionPath)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return "", nil
+		}
+
+		return "", err
+	}
+
+	return string(bytes.TrimRight(contents, "\x00\n")), nil
+}
+
+// Install writes the image to the device if the contents on the device differ.
+//
+// The boot ROM loads the image from the fixed offset, so it is overwritten in place, but the update is made as close
+// to atomic as possible:
+//   - the previous contents are saved to a backup file, which is read back and verified before the device is touched;
+//   - the first sector of the image (the header the boot ROM looks for) is invalidated first and written last,
+//     so that an interrupted write leaves no valid image, and the boot ROM falls back to the next boot source;
+//   - the written data is read back and verified, on mismatch the previous image is restored from the backup.
+//
+// Install reports whether the device was updated.
+func Install(device string, img Image) (bool, error) {
+	uboot, err := img.Read()
+	if err != nil {
+		return false, err
+	}
+
+	f, err := os.OpenFile(device, os.O_RDWR|unix.O_CLOEXEC, 0o666)
+	if err != nil {
+		return false, err
+	}
+
+	defer f.Close() //nolint:errcheck
+
+	previous, err := readAt(f, int64(len(uboot)), img.Offset)
+	if err != nil {
+		return false, fmt.Errorf("error reading current U-Boot: %w", err)
+	}
+
+	if bytes.Equal(previous, uboot) {
+		log.Printf("U-Boot %s on %s is up to date", printableVersion(uboot), device)
+
+		return false, nil
+	}
+
+	log.Printf("updating U-Boot on %s: %s -> %s", device, printableVersion(previous), printableVersion(uboot))
+	log.Printf("writing %s (%d) at offset %d", img.Path, len(uboot), img.Offset)
+
+	if err = update(f, uboot, previous, img.Offset); err != nil {
+		return false, err
+	}
+
+	log.Printf("wrote %d bytes", len(uboot))
+
+	return true, nil
+}
+
+// blockDevice is the device U-Boot is written to.
+type blockDevice interface {
+	io.ReaderAt
+	io.WriterAt
+	Sync() error
+}
+
+// update replaces the previous image with the new one, restoring the previous image from the backup on failure.
+func update(dev blockDevice, data, previous []byte, offset int64) error {
+	backupPath, backup, err := saveBackup(previous)
+	if err != nil {
+		return err
+	}
+
+	if err = writeAndVerify(dev, data, offset); err != nil {
+		log.Printf("restoring previous U-Boot: %s", err)
+
+		if restoreErr := writeAndVerify(dev, backup, offset); restoreErr != nil {
+			// keep the backup, so that the previous image can be restored manually
+			return fmt.Errorf("error restoring U-Boot after failed update (%s), the previous image is saved at %q: %w", err, backupPath, restoreErr)
+		}
+
+		os.Remove(backupPath) //nolint:errcheck
+
+		return err
+	}
+
+	return os.Remove(backupPath)
+}
+
+// saveBackup writes the previous image to a file and returns the contents read back from it.
+func saveBackup(previous []byte) (string, []byte, error) {
+	f, err := os.CreateTemp("", "u-boot-backup-*.bin")
+	if err != nil {
+		return "", nil, fmt.Errorf("error creating U-Boot backup: %w", err)
+	}
+
+	defer f.Close() //nolint:errcheck
+
+	if err = writeAndVerify(f, previous, 0); err != nil {
+		os.Remove(f.Name()) //nolint:errcheck
+
+		return "", nil, fmt.Errorf("error saving U-Boot backup: %w", err)
+	}
+
+	backup, err := readAt(f, int64(len(previous)), 0)
+	if err != nil {
+		os.Remove(f.Name()) //nolint:errcheck
+
+		return "", nil, fmt.Errorf("error reading U-Boot backup: %w", err)
+	}
+
+	return f.Name(), backup, nil
+}
+
+// headerSize is the size of the image header, which is written last.
+const headerSize = 512
+
+func writeAndVerify(dev blockDevice, data []byte, offset int64) error {
+	header := data
+	if len(header) > headerSize {
+		header = header[:headerSize]
+	}
+
+	// invalidate the header, write the rest of the image, and then the header
+	for _, chunk := range []struct {
+		data   []byte
+		offset int64
+	}{
+		{data: make([]byte, len(header)), offset: offset},
+		{data: data[len(header):], offset: offset + int64(len(header))},
+		{data: header, offset: offset},
+	} {
+		if len(chunk.data) == 0 {
+			continue
+		}
+
+		if _, err := dev.WriteAt(chunk.data, chunk.offset); err != nil {
+			return fmt.Errorf("error writing U-Boot: %w", err)
+		}
+
+		// NB: In the case that the block device is a loopback device, we sync here
+		// to ensure that the file is written before the loopback device is
+		// unmounted.
+		if err := dev.Sync(); err != nil {
+			return fmt.Errorf("error syncing U-Boot: %w", err)
+		}
+	}
+
+	// drop the page cache, so that the data is read back from the device
+	if f, ok := dev.(*os.File); ok {
+		if err := unix.Fadvise(int(f.Fd()), offset, int64(len(data)), unix.FADV_DONTNEED); err != nil {
+			return fmt.Errorf("error dropping cache: %w", err)
+		}
+	}
+
+	written, err := readAt(dev, int64(len(data)), offset)
+	if err != nil {
+		return fmt.Errorf("error reading back U-Boot: %w", err)
+	}
+
+	if !bytes.Equal(written, data) {
+		return errors.New("U-Boot verification failed: contents on the device don't match the image")
+	}
+
+	return nil
+}
+
+// readAt reads up to size bytes, short read is not an error if the device ends before.
+func readAt(f io.ReaderAt, size, offset int64) ([]byte, error) {
+	buf := make([]byte, size)
+
+	n, err := f.ReadAt(buf, offset)
+	if err != nil && !errors.Is(err, io.EOF) {
+		return nil, err
+	}
+
+	return buf[:n], nil
+}
+
+func printableVersion(data []byte) string {
+	if version := Version(data); version != "" {
+		return version
+	}
+
+	return "unknown"
+}
@@ -0,0 +1,187 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package uboot_test
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/siderolabs/talos/internal/pkg/uboot"
+)
+
+const offset = 512 * 0x40
+
+func writeImage(t *testing.T, dir, version string) uboot.Image {
+	t.Helper()
+
+	contents := make([]byte, offset)
+	contents = append(contents, []byte("\x00\x01U-Boot "+version+" (Jun 01 2023 - 12:00:00 +0000)\x00")...)
+	contents = append(contents, bytes.Repeat([]byte{0xaa}, 4096)...)
+
+	path := filepath.Join(dir, "u-boot-"+version+".img")
+	require.NoError(t, os.WriteFile(path, contents, 0o644))
+
+	return uboot.Image{
+		Path:       path,
+		Offset:     offset,
+		DiskLayout: true,
+	}
+}
+
+func TestVersion(t *testing.T) {
+	for _, test := range []struct {
+		data     string
+		expected string
+	}{
+		{
+			data:     "\x00U-Boot 2023.07-rc3-g1a2b3c4 (Jun 01 2023 - 12:00:00 +0000)\x00",
+			expected: "2023.07-rc3-g1a2b3c4",
+		},
+		{
+			data:     "U-Boot SPL 2017.09-g5f3b2a7\x00",
+			expected: "2017.09-g5f3b2a7",
+		},
+		{
+			data: "\x00\x00\x00",
+		},
+	} {
+		assert.Equal(t, test.expected, uboot.Version([]byte(test.data)))
+	}
+}
+
+func TestInstall(t *testing.T) {
+	dir := t.TempDir()
+
+	device := filepath.Join(dir, "disk.img")
+	require.NoError(t, os.WriteFile(device, make([]byte, 1024*1024), 0o644))
+
+	v1 := writeImage(t, dir, "2023.07")
+	v2 := writeImage(t, dir, "2023.10")
+
+	updated, err := uboot.Install(device, v1)
+	require.NoError(t, err)
+	assert.True(t, updated)
+
+	updated, err = uboot.Install(device, v1)
+	require.NoError(t, err)
+	assert.False(t, updated)
+
+	updated, err = uboot.Install(device, v2)
+	require.NoError(t, err)
+	assert.True(t, updated)
+
+	contents, err := os.ReadFile(device)
+	require.NoError(t, err)
+
+	expected, err := v2.Read()
+	require.NoError(t, err)
+
+	assert.Equal(t, expected, contents[offset:offset+len(expected)])
+	assert.Equal(t, "2023.10", uboot.Version(contents))
+	assert.Len(t, contents, 1024*1024)
+}
+
+func TestInstallRaw(t *testing.T) {
+	dir := t.TempDir()
+
+	device := filepath.Join(dir, "disk.img")
+	require.NoError(t, os.WriteFile(device, make([]byte, 1024*1024), 0o644))
+
+	// raw blob starts with the SPL, it is written as a whole at the offset
+	blob := append([]byte("SPL\x00U-Boot SPL 2023.07 (Jun 01 2023 - 12:00:00 +0000)\x00"), bytes.Repeat([]byte{0xbb}, 2*offset)...)
+
+	path := filepath.Join(dir, "u-boot-rockchip.bin")
+	require.NoError(t, os.WriteFile(path, blob, 0o644))
+
+	img := uboot.Image{
+		Path:   path,
+		Offset: offset,
+	}
+
+	contents, err := img.Read()
+	require.NoError(t, err)
+	assert.Equal(t, blob, contents)
+
+	version, err := img.Version()
+	require.NoError(t, err)
+	assert.Equal(t, "2023.07", version)
+
+	updated, err := uboot.Install(device, img)
+	require.NoError(t, err)
+	assert.True(t, updated)
+
+	contents, err = os.ReadFile(device)
+	require.NoError(t, err)
+
+	assert.Equal(t, make([]byte, offset), contents[:offset])
+	assert.Equal(t, blob, contents[offset:offset+len(blob)])
+}
+
+func TestInstallMissingImage(t *testing.T) {
+	_, err := uboot.Install(filepath.Join(t.TempDir(), "disk.img"), uboot.Image{Path: "/nonexistent"})
+	assert.Error(t, err)
+}
+
+// faultyDevice is an in-memory device which corrupts the first writes.
+type faultyDevice struct {
+	data    []byte
+	corrupt int
+	writes  [][2]int64
+}
+
+func (d *faultyDevice) ReadAt(p []byte, off int64) (int, error) {
+	return copy(p, d.data[off:]), nil
+}
+
+func (d *faultyDevice) WriteAt(p []byte, off int64) (int, error) {
+	d.writes = append(d.writes, [2]int64{off, int64(len(p))})
+
+	n := copy(d.data[off:], p)
+
+	if d.corrupt > 0 {
+		d.corrupt--
+
+		d.data[off] ^= 0xff
+	}
+
+	return n, nil
+}
+
+func (d *faultyDevice) Sync() error {
+	return nil
+}
+
+func TestUpdateHeaderLast(t *testing.T) {
+	previous := bytes.Repeat([]byte{0x11}, 2048)
+	data := bytes.Repeat([]byte{0x22}, 2048)
+
+	dev := &faultyDevice{data: make([]byte, offset+4096)}
+	copy(dev.data[offset:], previous)
+
+	require.NoError(t, uboot.Update(dev, data, previous, offset))
+
+	assert.Equal(t, data, dev.data[offset:offset+len(data)])
+
+	// header is invalidated, the body is written, and the header is written last
+	assert.Equal(t, [][2]int64{{offset, 512}, {offset + 512, 2048 - 512}, {offset, 512}}, dev.writes)
+}
+
+func TestUpdateRestore(t *testing.T) {
+	previous := bytes.Repeat([]byte{0x11}, 2048)
+	data := bytes.Repeat([]byte{0x22}, 2048)
+
+	dev := &faultyDevice{data: make([]byte, offset+4096), corrupt: 2}
+	copy(dev.data[offset:], previous)
+
+	require.ErrorContains(t, uboot.Update(dev, data, previous, offset), "verification failed")
+
+	// the previous image is restored from the backup
+	assert.Equal(t, previous, dev.data[offset:offset+len(previous)])
+}
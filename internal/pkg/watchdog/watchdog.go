@@ -0,0 +1,67 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+// Package watchdog provides access to the kernel watchdog timer devices.
+package watchdog
+
+import (
+	"fmt"
+	"os"
+	"time"
+
+	"golang.org/x/sys/unix"
+)
+
+// Timer is an open watchdog timer device.
+//
+// The timer is armed as soon as the device is opened.
+type Timer struct {
+	f *os.File
+}
+
+// Open arms the watchdog timer device.
+func Open(device string) (*Timer, error) {
+	f, err := os.OpenFile(device, os.O_WRONLY|unix.O_CLOEXEC, 0)
+	if err != nil {
+		return nil, fmt.Errorf("error opening watchdog device: %w", err)
+	}
+
+	return &Timer{f: f}, nil
+}
+
+// SetTimeout sets the timeout of the watchdog timer.
+//
+// The timeout is rounded up to whole seconds.
+func (t *Timer) SetTimeout(timeout time.Duration) error {
+	seconds := int((timeout + time.Second - 1) / time.Second)
+
+	if err := unix.IoctlSetPointerInt(int(t.f.Fd()), unix.WDIOC_SETTIMEOUT, seconds); err != nil {
+		return fmt.Errorf("error setting watchdog timeout: %w", err)
+	}
+
+	return nil
+}
+
+// Feed resets the watchdog timer.
+func (t *Timer) Feed() error {
+	if err := unix.IoctlWatchdogKeepalive(int(t.f.Fd())); err != nil {
+		return fmt.Errorf("error feeding watchdog: %w", err)
+	}
+
+	return nil
+}
+
+// Close disarms the watchdog timer and closes the device.
+//
+// The timer is disarmed by writing the magic character before closing the device,
+// drivers with the nowayout option keep the timer running.
+func (t *Timer) Close() error {
+	if _, err := t.f.Write([]byte("V")); err != nil {
+		t.f.Close() //nolint:errcheck
+
+		return fmt.Errorf("error disarming watchdog: %w", err)
+	}
+
+	return t.f.Close()
+}
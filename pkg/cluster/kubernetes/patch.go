@@ -13,6 +13,7 @@ import (
 
 	"github.com/siderolabs/talos/pkg/machinery/api/machine"
 	"github.com/siderolabs/talos/pkg/machinery/client"
+	"github.com/siderolabs/talos/pkg/machinery/config/secretref"
 	v1alpha1config "github.com/siderolabs/talos/pkg/machinery/config/types/v1alpha1"
 	"github.com/siderolabs/talos/pkg/machinery/resources/config"
 )
@@ -46,7 +47,23 @@ func patchNodeConfig(ctx context.Context, cluster UpgradeProvider, node string,
 		return fmt.Errorf("error patching config: %w", err)
 	}
 
-	cfgBytes, err := cfg.Bytes()
+	// keep the standalone documents and the secret references next to the patched config
+	var (
+		documents  []v1alpha1config.Document
+		references []secretref.Reference
+	)
+
+	if provider, ok := mc.Config().(*v1alpha1config.ReadonlyProvider); ok {
+		documents = provider.Documents()
+		references = provider.References()
+	}
+
+	patched, err := v1alpha1config.WrapReadonlyWithDocuments(cfg, documents, nil, references)
+	if err != nil {
+		return fmt.Errorf("error patching config: %w", err)
+	}
+
+	cfgBytes, err := patched.Bytes()
 	if err != nil {
 		return fmt.Errorf("error serializing config: %w", err)
 	}
@@ -0,0 +1,73 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package authz
+
+import (
+	"context"
+	"crypto/x509"
+	"fmt"
+
+	"google.golang.org/grpc/metadata"
+
+	"github.com/siderolabs/talos/pkg/machinery/constants"
+)
+
+// identityCtxKey is used to store the client identity in the context.
+type identityCtxKey struct{}
+
+// identityMDKey is used to store the client identity in gRPC metadata.
+const identityMDKey = constants.APIAuthzIdentityMetadataKey
+
+// GetIdentity returns the client identity stored in the context by the Injector interceptor.
+//
+// Empty string is returned if the identity is not known (e.g. RBAC is disabled).
+func GetIdentity(ctx context.Context) string {
+	identity, _ := ctx.Value(identityCtxKey{}).(string) //nolint:errcheck
+
+	return identity
+}
+
+// ContextWithIdentity returns derived context with the client identity set.
+func ContextWithIdentity(ctx context.Context, identity string) context.Context {
+	return context.WithValue(ctx, identityCtxKey{}, identity)
+}
+
+// SetIdentityMetadata sets given identity in gRPC metadata.
+//
+// The identity coming from the client is always replaced, so that it can't be spoofed.
+func SetIdentityMetadata(md metadata.MD, identity string) {
+	if identity == "" {
+		md.Delete(identityMDKey)
+
+		return
+	}
+
+	md.Set(identityMDKey, identity)
+}
+
+// getIdentityFromMetadata returns the client identity extracted from gRPC metadata.
+func getIdentityFromMetadata(ctx context.Context) string {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return ""
+	}
+
+	if values := md.Get(identityMDKey); len(values) > 0 {
+		return values[0]
+	}
+
+	return ""
+}
+
+// certificateIdentity returns the identity of the client certificate.
+//
+// The certificates generated by Talos have no common name, so the serial number is used instead.
+func certificateIdentity(cert *x509.Certificate) string {
+	if cert.Subject.CommonName != "" {
+		return cert.Subject.CommonName
+	}
+
+	return fmt.Sprintf("serial:%x", cert.SerialNumber)
+}
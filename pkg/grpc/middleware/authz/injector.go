@@ -48,11 +48,11 @@ func (i *Injector) logf(format string, v ...interface{}) {
 	}
 }
 
-// extractRoles returns roles extracted from the user's certificate (in case of the first apid instance),
+// extractRoles returns roles and the client identity extracted from the user's certificate (in case of the first apid instance),
 // or from gRPC metadata (in case of subsequent apid instances, machined, or user with impersonator role).
 //
 //nolint:gocyclo
-func (i *Injector) extractRoles(ctx context.Context) role.Set {
+func (i *Injector) extractRoles(ctx context.Context) (role.Set, string) {
 	// sanity check
 	if _, ok := getFromContext(ctx); ok {
 		panic("roles should not be present in the context at this point")
@@ -62,15 +62,15 @@ func (i *Injector) extractRoles(ctx context.Context) role.Set {
 	case Disabled:
 		i.logf("RBAC is disabled, injecting all roles")
 
-		return role.All
+		return role.All, ""
 
 	case ReadOnly:
-		return role.MakeSet(role.Reader)
+		return role.MakeSet(role.Reader), ""
 
 	case MetadataOnly:
 		roles, _ := getFromMetadata(ctx, i.logf)
 
-		return roles
+		return roles, getIdentityFromMetadata(ctx)
 
 	case Enabled:
 		p, ok := peer.FromContext(ctx)
@@ -90,6 +90,7 @@ func (i *Injector) extractRoles(ctx context.Context) role.Set {
 		// PeerCertificates[0] is the leaf certificate the connection was verified against, so this
 		// is the client cert. Other certificates in the chain might be CAs or intermediates.
 		strings := tlsInfo.State.PeerCertificates[0].Subject.Organization
+		identity := certificateIdentity(tlsInfo.State.PeerCertificates[0])
 
 		// TODO validate cert.KeyUsage, cert.ExtKeyUsage, cert.Issuer.Organization, other fields there?
 
@@ -101,14 +102,18 @@ func (i *Injector) extractRoles(ctx context.Context) role.Set {
 		if roles.Includes(role.Impersonator) {
 			metadataRoles, ok := getFromMetadata(ctx, i.logf)
 			if ok {
-				return metadataRoles
+				if metadataIdentity := getIdentityFromMetadata(ctx); metadataIdentity != "" {
+					identity = metadataIdentity
+				}
+
+				return metadataRoles, identity
 			}
 
 			// that's a real user with impersonator role then
 			i.logf("no roles in metadadata, returning parsed roles")
 		}
 
-		return roles
+		return roles, identity
 	}
 
 	panic("unreachable")
@@ -117,7 +122,8 @@ func (i *Injector) extractRoles(ctx context.Context) role.Set {
 // UnaryInterceptor returns grpc UnaryServerInterceptor.
 func (i *Injector) UnaryInterceptor() grpc.UnaryServerInterceptor {
 	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
-		ctx = ContextWithRoles(ctx, i.extractRoles(ctx))
+		roles, identity := i.extractRoles(ctx)
+		ctx = ContextWithIdentity(ContextWithRoles(ctx, roles), identity)
 
 		return handler(ctx, req)
 	}
@@ -127,7 +133,8 @@ func (i *Injector) UnaryInterceptor() grpc.UnaryServerInterceptor {
 func (i *Injector) StreamInterceptor() grpc.StreamServerInterceptor {
 	return func(srv interface{}, stream grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
 		ctx := stream.Context()
-		ctx = ContextWithRoles(ctx, i.extractRoles(ctx))
+		roles, identity := i.extractRoles(ctx)
+		ctx = ContextWithIdentity(ContextWithRoles(ctx, roles), identity)
 
 		wrapped := grpc_middleware.WrapServerStream(stream)
 		wrapped.WrappedContext = ctx
@@ -45,6 +45,7 @@ func (l *Local) GetConnection(ctx context.Context, fullMethodName string) (conte
 	md = md.Copy()
 
 	authz.SetMetadata(md, authz.GetRoles(ctx))
+	authz.SetIdentityMetadata(md, authz.GetIdentity(ctx))
 
 	outCtx := metadata.NewOutgoingContext(ctx, md)
 
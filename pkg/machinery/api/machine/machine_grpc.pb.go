@@ -72,6 +72,12 @@ const (
 	MachineService_Netstat_FullMethodName                     = "/machine.MachineService/Netstat"
 	MachineService_MetaWrite_FullMethodName                   = "/machine.MachineService/MetaWrite"
 	MachineService_MetaDelete_FullMethodName                  = "/machine.MachineService/MetaDelete"
+	MachineService_ConfigDiff_FullMethodName                  = "/machine.MachineService/ConfigDiff"
+	MachineService_ValidateConfiguration_FullMethodName       = "/machine.MachineService/ValidateConfiguration"
+	MachineService_StagedConfiguration_FullMethodName         = "/machine.MachineService/StagedConfiguration"
+	MachineService_CancelStagedConfiguration_FullMethodName   = "/machine.MachineService/CancelStagedConfiguration"
+	MachineService_ConfigHistory_FullMethodName               = "/machine.MachineService/ConfigHistory"
+	MachineService_ConfigRollback_FullMethodName              = "/machine.MachineService/ConfigRollback"
 )
 
 // MachineServiceClient is the client API for MachineService service.
@@ -169,6 +175,26 @@ type MachineServiceClient interface {
 	MetaWrite(ctx context.Context, in *MetaWriteRequest, opts ...grpc.CallOption) (*MetaWriteResponse, error)
 	// MetaDelete deletes a META key.
 	MetaDelete(ctx context.Context, in *MetaDeleteRequest, opts ...grpc.CallOption) (*MetaDeleteResponse, error)
+	// ConfigDiff compares the supplied configuration with the active and the staged configuration.
+	//
+	// Secrets are redacted in the returned values, the values of the active configuration are still sensitive,
+	// so the call requires the os:admin role.
+	ConfigDiff(ctx context.Context, in *ConfigDiffRequest, opts ...grpc.CallOption) (*ConfigDiffResponse, error)
+	// ValidateConfiguration validates the configuration against the node hardware.
+	//
+	// Install disk, machine disks, network device selectors and kernel modules are resolved on the node.
+	ValidateConfiguration(ctx context.Context, in *ValidateConfigurationRequest, opts ...grpc.CallOption) (*ValidateConfigurationResponse, error)
+	// StagedConfiguration returns the configuration staged to be applied after the next reboot.
+	//
+	// Secrets are redacted in the returned configuration and values, the configuration is still sensitive,
+	// so the call requires the os:admin role.
+	StagedConfiguration(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*StagedConfigurationResponse, error)
+	// CancelStagedConfiguration discards the staged configuration, the active configuration is used after the next reboot.
+	CancelStagedConfiguration(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*CancelStagedConfigurationResponse, error)
+	// ConfigHistory returns the history of the configurations applied to the node, the latest revision is the last one.
+	ConfigHistory(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*ConfigHistoryResponse, error)
+	// ConfigRollback applies the configuration revision from the history with the apply mode.
+	ConfigRollback(ctx context.Context, in *ConfigRollbackRequest, opts ...grpc.CallOption) (*ApplyConfigurationResponse, error)
 }
 
 type machineServiceClient struct {
@@ -876,6 +902,60 @@ func (c *machineServiceClient) MetaDelete(ctx context.Context, in *MetaDeleteReq
 	return out, nil
 }
 
+func (c *machineServiceClient) ConfigDiff(ctx context.Context, in *ConfigDiffRequest, opts ...grpc.CallOption) (*ConfigDiffResponse, error) {
+	out := new(ConfigDiffResponse)
+	err := c.cc.Invoke(ctx, MachineService_ConfigDiff_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *machineServiceClient) ValidateConfiguration(ctx context.Context, in *ValidateConfigurationRequest, opts ...grpc.CallOption) (*ValidateConfigurationResponse, error) {
+	out := new(ValidateConfigurationResponse)
+	err := c.cc.Invoke(ctx, MachineService_ValidateConfiguration_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *machineServiceClient) StagedConfiguration(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*StagedConfigurationResponse, error) {
+	out := new(StagedConfigurationResponse)
+	err := c.cc.Invoke(ctx, MachineService_StagedConfiguration_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *machineServiceClient) CancelStagedConfiguration(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*CancelStagedConfigurationResponse, error) {
+	out := new(CancelStagedConfigurationResponse)
+	err := c.cc.Invoke(ctx, MachineService_CancelStagedConfiguration_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *machineServiceClient) ConfigHistory(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*ConfigHistoryResponse, error) {
+	out := new(ConfigHistoryResponse)
+	err := c.cc.Invoke(ctx, MachineService_ConfigHistory_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *machineServiceClient) ConfigRollback(ctx context.Context, in *ConfigRollbackRequest, opts ...grpc.CallOption) (*ApplyConfigurationResponse, error) {
+	out := new(ApplyConfigurationResponse)
+	err := c.cc.Invoke(ctx, MachineService_ConfigRollback_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MachineServiceServer is the server API for MachineService service.
 // All implementations must embed UnimplementedMachineServiceServer
 // for forward compatibility
@@ -971,6 +1051,26 @@ type MachineServiceServer interface {
 	MetaWrite(context.Context, *MetaWriteRequest) (*MetaWriteResponse, error)
 	// MetaDelete deletes a META key.
 	MetaDelete(context.Context, *MetaDeleteRequest) (*MetaDeleteResponse, error)
+	// ConfigDiff compares the supplied configuration with the active and the staged configuration.
+	//
+	// Secrets are redacted in the returned values, the values of the active configuration are still sensitive,
+	// so the call requires the os:admin role.
+	ConfigDiff(context.Context, *ConfigDiffRequest) (*ConfigDiffResponse, error)
+	// ValidateConfiguration validates the configuration against the node hardware.
+	//
+	// Install disk, machine disks, network device selectors and kernel modules are resolved on the node.
+	ValidateConfiguration(context.Context, *ValidateConfigurationRequest) (*ValidateConfigurationResponse, error)
+	// StagedConfiguration returns the configuration staged to be applied after the next reboot.
+	//
+	// Secrets are redacted in the returned configuration and values, the configuration is still sensitive,
+	// so the call requires the os:admin role.
+	StagedConfiguration(context.Context, *emptypb.Empty) (*StagedConfigurationResponse, error)
+	// CancelStagedConfiguration discards the staged configuration, the active configuration is used after the next reboot.
+	CancelStagedConfiguration(context.Context, *emptypb.Empty) (*CancelStagedConfigurationResponse, error)
+	// ConfigHistory returns the history of the configurations applied to the node, the latest revision is the last one.
+	ConfigHistory(context.Context, *emptypb.Empty) (*ConfigHistoryResponse, error)
+	// ConfigRollback applies the configuration revision from the history with the apply mode.
+	ConfigRollback(context.Context, *ConfigRollbackRequest) (*ApplyConfigurationResponse, error)
 	mustEmbedUnimplementedMachineServiceServer()
 }
 
@@ -1125,6 +1225,24 @@ func (UnimplementedMachineServiceServer) MetaWrite(context.Context, *MetaWriteRe
 func (UnimplementedMachineServiceServer) MetaDelete(context.Context, *MetaDeleteRequest) (*MetaDeleteResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method MetaDelete not implemented")
 }
+func (UnimplementedMachineServiceServer) ConfigDiff(context.Context, *ConfigDiffRequest) (*ConfigDiffResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ConfigDiff not implemented")
+}
+func (UnimplementedMachineServiceServer) ValidateConfiguration(context.Context, *ValidateConfigurationRequest) (*ValidateConfigurationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ValidateConfiguration not implemented")
+}
+func (UnimplementedMachineServiceServer) StagedConfiguration(context.Context, *emptypb.Empty) (*StagedConfigurationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StagedConfiguration not implemented")
+}
+func (UnimplementedMachineServiceServer) CancelStagedConfiguration(context.Context, *emptypb.Empty) (*CancelStagedConfigurationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelStagedConfiguration not implemented")
+}
+func (UnimplementedMachineServiceServer) ConfigHistory(context.Context, *emptypb.Empty) (*ConfigHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ConfigHistory not implemented")
+}
+func (UnimplementedMachineServiceServer) ConfigRollback(context.Context, *ConfigRollbackRequest) (*ApplyConfigurationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ConfigRollback not implemented")
+}
 func (UnimplementedMachineServiceServer) mustEmbedUnimplementedMachineServiceServer() {}
 
 // UnsafeMachineServiceServer may be embedded to opt out of forward compatibility for this service.
@@ -2058,6 +2176,114 @@ func _MachineService_MetaDelete_Handler(srv interface{}, ctx context.Context, de
 	return interceptor(ctx, in, info, handler)
 }
 
+func _MachineService_ConfigDiff_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ConfigDiffRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MachineServiceServer).ConfigDiff(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MachineService_ConfigDiff_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MachineServiceServer).ConfigDiff(ctx, req.(*ConfigDiffRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MachineService_ValidateConfiguration_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ValidateConfigurationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MachineServiceServer).ValidateConfiguration(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MachineService_ValidateConfiguration_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MachineServiceServer).ValidateConfiguration(ctx, req.(*ValidateConfigurationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MachineService_StagedConfiguration_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MachineServiceServer).StagedConfiguration(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MachineService_StagedConfiguration_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MachineServiceServer).StagedConfiguration(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MachineService_CancelStagedConfiguration_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MachineServiceServer).CancelStagedConfiguration(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MachineService_CancelStagedConfiguration_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MachineServiceServer).CancelStagedConfiguration(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MachineService_ConfigHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MachineServiceServer).ConfigHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MachineService_ConfigHistory_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MachineServiceServer).ConfigHistory(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MachineService_ConfigRollback_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ConfigRollbackRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MachineServiceServer).ConfigRollback(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MachineService_ConfigRollback_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MachineServiceServer).ConfigRollback(ctx, req.(*ConfigRollbackRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // MachineService_ServiceDesc is the grpc.ServiceDesc for MachineService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -2217,6 +2443,30 @@ var MachineService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "MetaDelete",
 			Handler:    _MachineService_MetaDelete_Handler,
 		},
+		{
+			MethodName: "ConfigDiff",
+			Handler:    _MachineService_ConfigDiff_Handler,
+		},
+		{
+			MethodName: "ValidateConfiguration",
+			Handler:    _MachineService_ValidateConfiguration_Handler,
+		},
+		{
+			MethodName: "StagedConfiguration",
+			Handler:    _MachineService_StagedConfiguration_Handler,
+		},
+		{
+			MethodName: "CancelStagedConfiguration",
+			Handler:    _MachineService_CancelStagedConfiguration_Handler,
+		},
+		{
+			MethodName: "ConfigHistory",
+			Handler:    _MachineService_ConfigHistory_Handler,
+		},
+		{
+			MethodName: "ConfigRollback",
+			Handler:    _MachineService_ConfigRollback_Handler,
+		},
 	},
 	Streams: []grpc.StreamDesc{
 		{
@@ -259,6 +259,72 @@ func (c *Client) ApplyConfiguration(ctx context.Context, req *machineapi.ApplyCo
 	return
 }
 
+// ConfigDiff implements proto.MachineServiceClient interface.
+func (c *Client) ConfigDiff(ctx context.Context, req *machineapi.ConfigDiffRequest, callOptions ...grpc.CallOption) (resp *machineapi.ConfigDiffResponse, err error) {
+	resp, err = c.MachineClient.ConfigDiff(ctx, req, callOptions...)
+
+	var filtered interface{}
+	filtered, err = FilterMessages(resp, err)
+	resp, _ = filtered.(*machineapi.ConfigDiffResponse) //nolint:errcheck
+
+	return
+}
+
+// ValidateConfiguration implements proto.MachineServiceClient interface.
+func (c *Client) ValidateConfiguration(ctx context.Context, req *machineapi.ValidateConfigurationRequest, callOptions ...grpc.CallOption) (resp *machineapi.ValidateConfigurationResponse, err error) {
+	resp, err = c.MachineClient.ValidateConfiguration(ctx, req, callOptions...)
+
+	var filtered interface{}
+	filtered, err = FilterMessages(resp, err)
+	resp, _ = filtered.(*machineapi.ValidateConfigurationResponse) //nolint:errcheck
+
+	return
+}
+
+// ConfigHistory returns the history of the configurations applied to the node.
+func (c *Client) ConfigHistory(ctx context.Context, callOptions ...grpc.CallOption) (resp *machineapi.ConfigHistoryResponse, err error) {
+	resp, err = c.MachineClient.ConfigHistory(ctx, &emptypb.Empty{}, callOptions...)
+
+	var filtered interface{}
+	filtered, err = FilterMessages(resp, err)
+	resp, _ = filtered.(*machineapi.ConfigHistoryResponse) //nolint:errcheck
+
+	return
+}
+
+// ConfigRollback applies the configuration revision from the history.
+func (c *Client) ConfigRollback(ctx context.Context, req *machineapi.ConfigRollbackRequest, callOptions ...grpc.CallOption) (resp *machineapi.ApplyConfigurationResponse, err error) {
+	resp, err = c.MachineClient.ConfigRollback(ctx, req, callOptions...)
+
+	var filtered interface{}
+	filtered, err = FilterMessages(resp, err)
+	resp, _ = filtered.(*machineapi.ApplyConfigurationResponse) //nolint:errcheck
+
+	return
+}
+
+// StagedConfiguration returns the configuration staged to be applied after the next reboot.
+func (c *Client) StagedConfiguration(ctx context.Context, callOptions ...grpc.CallOption) (resp *machineapi.StagedConfigurationResponse, err error) {
+	resp, err = c.MachineClient.StagedConfiguration(ctx, &emptypb.Empty{}, callOptions...)
+
+	var filtered interface{}
+	filtered, err = FilterMessages(resp, err)
+	resp, _ = filtered.(*machineapi.StagedConfigurationResponse) //nolint:errcheck
+
+	return
+}
+
+// CancelStagedConfiguration discards the staged configuration.
+func (c *Client) CancelStagedConfiguration(ctx context.Context, callOptions ...grpc.CallOption) (resp *machineapi.CancelStagedConfigurationResponse, err error) {
+	resp, err = c.MachineClient.CancelStagedConfiguration(ctx, &emptypb.Empty{}, callOptions...)
+
+	var filtered interface{}
+	filtered, err = FilterMessages(resp, err)
+	resp, _ = filtered.(*machineapi.CancelStagedConfigurationResponse) //nolint:errcheck
+
+	return
+}
+
 // GenerateConfiguration implements proto.MachineServiceClient interface.
 func (c *Client) GenerateConfiguration(ctx context.Context, req *machineapi.GenerateConfigurationRequest, callOptions ...grpc.CallOption) (resp *machineapi.GenerateConfigurationResponse, err error) {
 	resp, err = c.MachineClient.GenerateConfiguration(ctx, req, callOptions...)
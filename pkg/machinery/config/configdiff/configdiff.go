@@ -0,0 +1,256 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+// Package configdiff computes the structured difference between two machine configurations.
+package configdiff
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"reflect"
+	"sort"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+
+	"github.com/siderolabs/talos/pkg/machinery/config"
+	"github.com/siderolabs/talos/pkg/machinery/config/encoder"
+)
+
+// Redacted replaces the secrets in the values of the changes.
+const Redacted = "******"
+
+// Change describes the difference between the configurations at the path.
+//
+// The values are YAML-encoded (strings are kept as is), a missing value is empty.
+type Change struct {
+	// Path is the path to the value, e.g. machine.network.interfaces[0].mtu.
+	Path    string
+	Current string
+	Desired string
+}
+
+// Diff returns the changes required to turn the current configuration into the desired one.
+//
+// Either configuration can be nil.
+// Changes to the secrets are reported, but the secret values are replaced with Redacted.
+// Changes to the standalone documents are reported under the Kind/name path, e.g. ["NetworkLinkConfig/eth1"].mtu.
+func Diff(current, desired config.Provider) ([]Change, error) {
+	currentTree, currentRedacted, err := trees(current)
+	if err != nil {
+		return nil, fmt.Errorf("error encoding current config: %w", err)
+	}
+
+	desiredTree, desiredRedacted, err := trees(desired)
+	if err != nil {
+		return nil, fmt.Errorf("error encoding desired config: %w", err)
+	}
+
+	var changes []Change
+
+	for _, path := range diffPaths(nil, currentTree, desiredTree) {
+		change := Change{
+			Path: formatPath(path),
+		}
+
+		if change.Current, err = lookup(currentRedacted, path); err != nil {
+			return nil, err
+		}
+
+		if change.Desired, err = lookup(desiredRedacted, path); err != nil {
+			return nil, err
+		}
+
+		changes = append(changes, change)
+	}
+
+	return changes, nil
+}
+
+// trees returns the generic representation of the config and of the config with the secrets redacted.
+func trees(cfg config.Provider) (tree, redacted any, err error) {
+	if cfg == nil || reflect.ValueOf(cfg).IsNil() {
+		return nil, nil, nil
+	}
+
+	if tree, err = toTree(cfg); err != nil {
+		return nil, nil, err
+	}
+
+	if redacted, err = toTree(cfg.RedactSecrets(Redacted)); err != nil {
+		return nil, nil, err
+	}
+
+	return tree, redacted, nil
+}
+
+// toTree decodes the config into the generic representation.
+//
+// The standalone documents are stored under the Kind/name key next to the v1alpha1 configuration keys.
+func toTree(cfg config.Provider) (any, error) {
+	b, err := cfg.EncodeBytes(encoder.WithComments(encoder.CommentsDisabled))
+	if err != nil {
+		return nil, err
+	}
+
+	tree := map[string]any{}
+	dec := yaml.NewDecoder(bytes.NewReader(b))
+
+	for {
+		var doc map[string]any
+
+		err = dec.Decode(&doc)
+		if errors.Is(err, io.EOF) {
+			break
+		}
+
+		if err != nil {
+			return nil, err
+		}
+
+		kind, _ := doc["kind"].(string) //nolint:errcheck
+		if kind == "" {
+			for key, value := range doc {
+				tree[key] = value
+			}
+
+			continue
+		}
+
+		if spec, ok := doc["spec"].(map[string]any); ok {
+			if name, ok := spec["name"].(string); ok && name != "" {
+				kind += "/" + name
+			}
+		}
+
+		tree[kind] = doc["spec"]
+	}
+
+	return tree, nil
+}
+
+// diffPaths returns the paths to the values which differ, the path segments are either map keys or list indices.
+func diffPaths(path []any, current, desired any) [][]any {
+	switch currentValue := current.(type) {
+	case map[string]any:
+		if desiredValue, ok := desired.(map[string]any); ok {
+			keys := make([]string, 0, len(currentValue)+len(desiredValue))
+
+			for key := range currentValue {
+				keys = append(keys, key)
+			}
+
+			for key := range desiredValue {
+				if _, ok := currentValue[key]; !ok {
+					keys = append(keys, key)
+				}
+			}
+
+			sort.Strings(keys)
+
+			var result [][]any
+
+			for _, key := range keys {
+				result = append(result, diffPaths(appendPath(path, key), currentValue[key], desiredValue[key])...)
+			}
+
+			return result
+		}
+	case []any:
+		if desiredValue, ok := desired.([]any); ok {
+			var result [][]any
+
+			for i := 0; i < len(currentValue) || i < len(desiredValue); i++ {
+				var currentItem, desiredItem any
+
+				if i < len(currentValue) {
+					currentItem = currentValue[i]
+				}
+
+				if i < len(desiredValue) {
+					desiredItem = desiredValue[i]
+				}
+
+				result = append(result, diffPaths(appendPath(path, i), currentItem, desiredItem)...)
+			}
+
+			return result
+		}
+	}
+
+	if reflect.DeepEqual(current, desired) {
+		return nil
+	}
+
+	return [][]any{path}
+}
+
+func appendPath(path []any, segment any) []any {
+	return append(append(make([]any, 0, len(path)+1), path...), segment)
+}
+
+// lookup returns the YAML-encoded value at the path, or an empty string if the value is missing.
+func lookup(tree any, path []any) (string, error) {
+	for _, segment := range path {
+		switch segment := segment.(type) {
+		case string:
+			m, ok := tree.(map[string]any)
+			if !ok {
+				return "", nil
+			}
+
+			if tree, ok = m[segment]; !ok {
+				return "", nil
+			}
+		case int:
+			l, ok := tree.([]any)
+			if !ok || segment >= len(l) {
+				return "", nil
+			}
+
+			tree = l[segment]
+		}
+	}
+
+	switch value := tree.(type) {
+	case nil:
+		return "", nil
+	case string:
+		return value, nil
+	}
+
+	b, err := yaml.Marshal(tree)
+	if err != nil {
+		return "", err
+	}
+
+	return strings.TrimSuffix(string(b), "\n"), nil
+}
+
+func formatPath(path []any) string {
+	var sb strings.Builder
+
+	for _, segment := range path {
+		switch segment := segment.(type) {
+		case string:
+			if strings.ContainsAny(segment, ".[]*/ ") {
+				fmt.Fprintf(&sb, "[%q]", segment)
+
+				continue
+			}
+
+			if sb.Len() > 0 {
+				sb.WriteByte('.')
+			}
+
+			sb.WriteString(segment)
+		case int:
+			fmt.Fprintf(&sb, "[%d]", segment)
+		}
+	}
+
+	return sb.String()
+}
@@ -0,0 +1,149 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package configdiff_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/siderolabs/talos/pkg/machinery/config/configdiff"
+	"github.com/siderolabs/talos/pkg/machinery/config/configloader"
+)
+
+const current = `version: v1alpha1
+machine:
+  type: worker
+  token: abcdef.0123456789abcdef
+  network:
+    hostname: node-1
+    interfaces:
+      - interface: eth0
+        mtu: 1500
+  registries:
+    mirrors:
+      docker.io:
+        endpoints:
+          - https://registry.local
+cluster:
+  controlPlane:
+    endpoint: https://localhost:6443
+`
+
+const desired = `version: v1alpha1
+machine:
+  type: worker
+  token: fedcba.0123456789abcdef
+  network:
+    interfaces:
+      - interface: eth0
+        mtu: 9000
+      - interface: eth1
+        dhcp: true
+  registries:
+    mirrors:
+      docker.io:
+        endpoints:
+          - https://registry.local
+cluster:
+  controlPlane:
+    endpoint: https://localhost:6443
+`
+
+func TestDiff(t *testing.T) {
+	t.Parallel()
+
+	currentCfg, err := configloader.NewFromBytes([]byte(current))
+	require.NoError(t, err)
+
+	desiredCfg, err := configloader.NewFromBytes([]byte(desired))
+	require.NoError(t, err)
+
+	changes, err := configdiff.Diff(currentCfg, desiredCfg)
+	require.NoError(t, err)
+
+	assert.Equal(t, []configdiff.Change{
+		{
+			Path:    "machine.network.hostname",
+			Current: "node-1",
+		},
+		{
+			Path:    "machine.network.interfaces[0].mtu",
+			Current: "1500",
+			Desired: "9000",
+		},
+		{
+			Path:    "machine.network.interfaces[1]",
+			Desired: "dhcp: true\ninterface: eth1",
+		},
+		{
+			Path:    "machine.token",
+			Current: configdiff.Redacted,
+			Desired: configdiff.Redacted,
+		},
+	}, changes)
+
+	changes, err = configdiff.Diff(currentCfg, currentCfg)
+	require.NoError(t, err)
+	assert.Empty(t, changes)
+}
+
+func TestDiffNil(t *testing.T) {
+	t.Parallel()
+
+	desiredCfg, err := configloader.NewFromBytes([]byte(desired))
+	require.NoError(t, err)
+
+	changes, err := configdiff.Diff(nil, desiredCfg)
+	require.NoError(t, err)
+	require.Len(t, changes, 1)
+
+	assert.Equal(t, "", changes[0].Path)
+	assert.Empty(t, changes[0].Current)
+	assert.NotContains(t, changes[0].Desired, "fedcba.0123456789abcdef")
+}
+
+func TestDiffDocuments(t *testing.T) {
+	t.Parallel()
+
+	currentCfg, err := configloader.NewFromBytes([]byte(current + `---
+kind: NetworkLinkConfig
+version: v1alpha1
+spec:
+  name: eth1
+  mtu: 1500
+`))
+	require.NoError(t, err)
+
+	desiredCfg, err := configloader.NewFromBytes([]byte(current + `---
+kind: NetworkLinkConfig
+version: v1alpha1
+spec:
+  name: eth1
+  mtu: 9000
+---
+kind: KubeSpanConfig
+version: v1alpha1
+spec:
+  enabled: true
+`))
+	require.NoError(t, err)
+
+	changes, err := configdiff.Diff(currentCfg, desiredCfg)
+	require.NoError(t, err)
+
+	assert.Equal(t, []configdiff.Change{
+		{
+			Path:    "KubeSpanConfig",
+			Desired: "enabled: true",
+		},
+		{
+			Path:    `["NetworkLinkConfig/eth1"].mtu`,
+			Current: "1500",
+			Desired: "9000",
+		},
+	}, changes)
+}
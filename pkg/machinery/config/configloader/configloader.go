@@ -14,44 +14,94 @@ import (
 
 	"github.com/siderolabs/talos/pkg/machinery/config"
 	"github.com/siderolabs/talos/pkg/machinery/config/decoder"
+	"github.com/siderolabs/talos/pkg/machinery/config/secretref"
+	// register the standalone document kinds.
+	_ "github.com/siderolabs/talos/pkg/machinery/config/types/cri"
+	_ "github.com/siderolabs/talos/pkg/machinery/config/types/kubespan"
+	_ "github.com/siderolabs/talos/pkg/machinery/config/types/network"
 	"github.com/siderolabs/talos/pkg/machinery/config/types/v1alpha1"
 )
 
-// ErrNoConfig is returned when no configuration was found in the input.
-var ErrNoConfig = errors.New("config not found")
+var (
+	// ErrNoConfig is returned when no configuration was found in the input.
+	ErrNoConfig = errors.New("config not found")
+	// ErrMultipleConfigs is returned when the input contains more than one v1alpha1 configuration.
+	ErrMultipleConfigs = errors.New("multiple v1alpha1 configs found")
+)
 
 // newConfig initializes and returns a Configurator.
-func newConfig(source []byte) (config config.Provider, err error) {
-	dec := decoder.NewDecoder(source)
+//
+// The standalone documents (e.g. NetworkLinkConfig) are kept next to the v1alpha1 configuration,
+// the input without the v1alpha1 configuration is rejected.
+// The secret references are resolved (or masked) before decoding, while the source keeps the references,
+// and the references are restored when the config is encoded back.
+func newConfig(source []byte, opts ...Option) (config config.Provider, err error) {
+	options := newOptions(opts...)
+
+	var (
+		resolved   []byte
+		references []secretref.Reference
+	)
+
+	if options.Identities != nil {
+		resolved, references, err = secretref.ResolveYAML(source, options.Identities)
+	} else {
+		resolved, references, err = secretref.MaskYAML(source)
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	dec := decoder.NewDecoder(resolved)
 
 	manifests, err := dec.Decode()
 	if err != nil {
 		return nil, err
 	}
 
-	// Look for the older flat v1alpha1 file first, since we have to handle it in
-	// a special way.
+	var (
+		talosconfig *v1alpha1.Config
+		documents   []v1alpha1.Document
+	)
+
 	for _, manifest := range manifests {
-		if talosconfig, ok := manifest.(*v1alpha1.Config); ok {
-			return v1alpha1.WrapReadonly(talosconfig, source), nil
+		switch manifest := manifest.(type) {
+		case *v1alpha1.Config:
+			if talosconfig != nil {
+				return nil, ErrMultipleConfigs
+			}
+
+			talosconfig = manifest
+		case v1alpha1.Document:
+			documents = append(documents, manifest)
 		}
 	}
 
-	return nil, ErrNoConfig
+	if talosconfig == nil {
+		return nil, ErrNoConfig
+	}
+
+	provider, err := v1alpha1.WrapReadonlyWithDocuments(talosconfig, documents, source, references)
+	if err != nil {
+		return nil, err
+	}
+
+	return provider, nil
 }
 
 // NewFromFile will take a filepath and attempt to parse a config file from it.
-func NewFromFile(filepath string) (config.Provider, error) {
+func NewFromFile(filepath string, opts ...Option) (config.Provider, error) {
 	source, err := fromFile(filepath)
 	if err != nil {
 		return nil, err
 	}
 
-	return newConfig(source)
+	return newConfig(source, opts...)
 }
 
 // NewFromStdin initializes a config provider by reading from stdin.
-func NewFromStdin() (config.Provider, error) {
+func NewFromStdin(opts ...Option) (config.Provider, error) {
 	buf := bytes.NewBuffer(nil)
 
 	_, err := io.Copy(buf, os.Stdin)
@@ -59,7 +109,7 @@ func NewFromStdin() (config.Provider, error) {
 		return nil, err
 	}
 
-	config, err := NewFromBytes(buf.Bytes())
+	config, err := NewFromBytes(buf.Bytes(), opts...)
 	if err != nil {
 		return nil, fmt.Errorf("failed load config from stdin: %w", err)
 	}
@@ -68,8 +118,8 @@ func NewFromStdin() (config.Provider, error) {
 }
 
 // NewFromBytes will take a byteslice and attempt to parse a config file from it.
-func NewFromBytes(source []byte) (config.Provider, error) {
-	return newConfig(source)
+func NewFromBytes(source []byte, opts ...Option) (config.Provider, error) {
+	return newConfig(source, opts...)
 }
 
 // fromFile is a convenience function that reads the config from disk.
@@ -0,0 +1,36 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package configloader
+
+import "github.com/siderolabs/talos/pkg/machinery/config/secretref"
+
+// Options defines the config loader options.
+type Options struct {
+	// Identities decrypt the secret references, the references are resolved only if it is set.
+	Identities secretref.IdentitiesFunc
+}
+
+func newOptions(opts ...Option) *Options {
+	res := &Options{}
+
+	for _, o := range opts {
+		o(res)
+	}
+
+	return res
+}
+
+// Option gives ability to alter the config loader behavior.
+type Option func(*Options)
+
+// WithSecretReferences resolves the secret references: decrypts ENC[...] with the identities, and reads FILE[...] from the local files.
+//
+// It should be used only for the node's own configuration, as the resolved values might be revealed e.g. in the validation errors.
+// Without the option, the references are replaced with secretref.MaskedValue.
+func WithSecretReferences(identities secretref.IdentitiesFunc) Option {
+	return func(o *Options) {
+		o.Identities = identities
+	}
+}
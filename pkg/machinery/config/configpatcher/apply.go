@@ -75,36 +75,61 @@ type Output = Input
 //
 // Apply either JSON6902 or StrategicMergePatch.
 //
+// SelectorPatch is applied only if the selector matches the target built from the config
+// being patched and the options.
+//
 // This method tries to minimize conversion between byte and unmarshalled
 // config representation as much as possible.
-func Apply(in Input, patches []Patch) (Output, error) {
+func Apply(in Input, patches []Patch, opts ...ApplyOption) (Output, error) {
 	for _, patch := range patches {
-		switch p := patch.(type) {
-		case jsonpatch.Patch:
-			bytes, err := in.Bytes()
-			if err != nil {
-				return nil, err
-			}
-
-			patched, err := JSON6902(bytes, p)
-			if err != nil {
-				return nil, err
-			}
-
-			in = WithBytes(patched)
-		case StrategicMergePatch:
-			cfg, err := in.Config()
-			if err != nil {
-				return nil, err
-			}
-
-			patched, err := StrategicMerge(cfg, p)
-			if err != nil {
-				return nil, err
-			}
-
-			in = WithConfig(patched)
+		var err error
+
+		in, err = apply(in, patch, opts)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return in, nil
+}
+
+func apply(in Input, patch Patch, opts []ApplyOption) (Output, error) {
+	switch p := patch.(type) {
+	case jsonpatch.Patch:
+		bytes, err := in.Bytes()
+		if err != nil {
+			return nil, err
+		}
+
+		patched, err := JSON6902(bytes, p)
+		if err != nil {
+			return nil, err
 		}
+
+		return WithBytes(patched), nil
+	case StrategicMergePatch:
+		cfg, err := in.Config()
+		if err != nil {
+			return nil, err
+		}
+
+		patched, err := StrategicMerge(cfg, p)
+		if err != nil {
+			return nil, err
+		}
+
+		return WithConfig(patched), nil
+	case SelectorPatch:
+		cfg, err := in.Config()
+		if err != nil {
+			return nil, err
+		}
+
+		if !p.Selector.Matches(targetFor(cfg, opts)) {
+			return in, nil
+		}
+
+		return apply(in, p.Patch, opts)
 	}
 
 	return in, nil
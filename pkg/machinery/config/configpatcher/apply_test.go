@@ -58,3 +58,78 @@ func TestApply(t *testing.T) {
 		})
 	}
 }
+
+func TestApplyDocuments(t *testing.T) {
+	cfg, err := configloader.NewFromBytes([]byte(`version: v1alpha1
+machine:
+  type: worker
+cluster:
+  controlPlane:
+    endpoint: https://localhost:6443
+---
+kind: NetworkLinkConfig
+version: v1alpha1
+spec:
+  name: eth0
+  mtu: 9000
+---
+kind: NetworkLinkConfig
+version: v1alpha1
+spec:
+  name: eth1
+  mtu: 9000
+`))
+	require.NoError(t, err)
+
+	patch, err := configpatcher.LoadPatch([]byte(`machine:
+  network:
+    hostname: foo
+---
+kind: NetworkLinkConfig
+version: v1alpha1
+spec:
+  name: eth1
+  mtu: 1500
+---
+kind: KubeSpanConfig
+version: v1alpha1
+spec:
+  enabled: true
+`))
+	require.NoError(t, err)
+
+	out, err := configpatcher.Apply(configpatcher.WithConfig(cfg), []configpatcher.Patch{patch})
+	require.NoError(t, err)
+
+	bytes, err := out.Bytes()
+	require.NoError(t, err)
+
+	assert.Equal(t, `version: v1alpha1
+machine:
+    type: worker
+    token: ""
+    certSANs: []
+    network:
+        hostname: foo
+cluster:
+    controlPlane:
+        endpoint: https://localhost:6443
+---
+kind: NetworkLinkConfig
+version: v1alpha1
+spec:
+    name: eth0
+    mtu: 9000
+---
+kind: NetworkLinkConfig
+version: v1alpha1
+spec:
+    name: eth1
+    mtu: 1500
+---
+kind: KubeSpanConfig
+version: v1alpha1
+spec:
+    enabled: true
+`, string(bytes))
+}
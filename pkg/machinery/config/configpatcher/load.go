@@ -8,6 +8,7 @@ import (
 	"bytes"
 	"encoding/json"
 	"os"
+	"path/filepath"
 	"strings"
 
 	jsonpatch "github.com/evanphx/json-patch"
@@ -19,7 +20,23 @@ import (
 type patch []map[string]interface{}
 
 // LoadPatch loads the strategic merge patch or JSON patch (JSON/YAML for JSON patch).
+//
+// If the patch starts with the selector header document, SelectorPatch is returned.
 func LoadPatch(in []byte) (Patch, error) {
+	selector, in, err := splitSelector(in)
+	if err != nil {
+		return nil, err
+	}
+
+	if selector != nil {
+		p, err := LoadPatch(in)
+		if err != nil {
+			return nil, err
+		}
+
+		return SelectorPatch{Selector: *selector, Patch: p}, nil
+	}
+
 	// try configloader first, it is more strict about config format
 	cfg, strategicErr := configloader.NewFromBytes(in)
 	if strategicErr == nil {
@@ -70,29 +87,79 @@ func LoadPatch(in []byte) (Patch, error) {
 	return p, nil
 }
 
-// LoadPatches loads the JSON patch either from value literal or from a file if the patch starts with '@'.
-func LoadPatches(in []string) ([]Patch, error) {
-	var result []Patch
+// ReadPatches reads the patches either from value literal or from a file if the patch starts with '@'.
+//
+// If the path after '@' is a directory, all the .yaml, .yml and .json files in the directory are read
+// in the lexical order.
+func ReadPatches(in []string) ([][]byte, error) {
+	var result [][]byte
 
 	for _, patchString := range in {
-		var (
-			p        Patch
-			contents []byte
-			err      error
-		)
+		if !strings.HasPrefix(patchString, "@") {
+			result = append(result, []byte(patchString))
+
+			continue
+		}
+
+		filename := patchString[1:]
 
-		if strings.HasPrefix(patchString, "@") {
-			filename := patchString[1:]
+		st, err := os.Stat(filename)
+		if err != nil {
+			return result, err
+		}
 
-			contents, err = os.ReadFile(filename)
+		if !st.IsDir() {
+			contents, err := os.ReadFile(filename)
 			if err != nil {
 				return result, err
 			}
-		} else {
-			contents = []byte(patchString)
+
+			result = append(result, contents)
+
+			continue
 		}
 
-		p, err = LoadPatch(contents)
+		entries, err := os.ReadDir(filename)
+		if err != nil {
+			return result, err
+		}
+
+		for _, entry := range entries {
+			switch filepath.Ext(entry.Name()) {
+			case ".yaml", ".yml", ".json":
+			default:
+				continue
+			}
+
+			if entry.IsDir() {
+				continue
+			}
+
+			contents, err := os.ReadFile(filepath.Join(filename, entry.Name()))
+			if err != nil {
+				return result, err
+			}
+
+			result = append(result, contents)
+		}
+	}
+
+	return result, nil
+}
+
+// LoadPatches loads the JSON patch either from value literal or from a file if the patch starts with '@'.
+//
+// See ReadPatches for the patch directories.
+func LoadPatches(in []string) ([]Patch, error) {
+	var result []Patch
+
+	contents, err := ReadPatches(in)
+	if err != nil {
+		return result, err
+	}
+
+	for _, patchContents := range contents {
+		p, err := LoadPatch(patchContents)
 		if err != nil {
 			return result, err
 		}
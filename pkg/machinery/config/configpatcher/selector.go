@@ -0,0 +1,261 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package configpatcher
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"path"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+
+	"github.com/siderolabs/talos/pkg/machinery/config"
+	"github.com/siderolabs/talos/pkg/machinery/config/types/v1alpha1/machine"
+)
+
+// selectorKey is the key of the patch header document carrying the selector.
+const selectorKey = "selector"
+
+// Selector restricts the patch to the machines matching all the fields set in the selector.
+//
+// The selector is set in the first document of the patch:
+//
+//	selector:
+//	  machineType: worker
+//	  board: rock_5b
+//	  arch: arm64
+//	  hostname: rock5-*
+//	  labels:
+//	    topology.kubernetes.io/zone: rack1
+//	---
+//	machine:
+//	  install:
+//	    disk: /dev/nvme0n1
+type Selector struct {
+	// MachineType is the machine type, controlplane matches init machines as well.
+	MachineType string `yaml:"machineType,omitempty"`
+	// Board is the name of the single board computer, e.g. rock_5b.
+	Board string `yaml:"board,omitempty"`
+	// Arch is the architecture, e.g. amd64 or arm64.
+	Arch string `yaml:"arch,omitempty"`
+	// Hostname is the glob pattern matched against the machine hostname.
+	Hostname string `yaml:"hostname,omitempty"`
+	// Labels should all be set on the machine with the same values.
+	Labels map[string]string `yaml:"labels,omitempty"`
+}
+
+// Validate the selector.
+func (s *Selector) Validate() error {
+	if s.MachineType != "" {
+		if _, err := machine.ParseType(s.MachineType); err != nil {
+			return err
+		}
+	}
+
+	if s.Hostname != "" {
+		if _, err := path.Match(s.Hostname, ""); err != nil {
+			return fmt.Errorf("invalid hostname pattern %q: %w", s.Hostname, err)
+		}
+	}
+
+	return nil
+}
+
+// Matches checks if the selector matches the target.
+//
+// The fields which are not set in the selector match any target, the fields set in the selector
+// don't match the target if the target value is unknown.
+func (s *Selector) Matches(target Target) bool {
+	if s.MachineType != "" {
+		selected, _ := machine.ParseType(s.MachineType) //nolint:errcheck // validated on load
+
+		switch selected { //nolint:exhaustive
+		case machine.TypeControlPlane:
+			if !target.MachineType.IsControlPlane() {
+				return false
+			}
+		default:
+			if selected != target.MachineType {
+				return false
+			}
+		}
+	}
+
+	if s.Board != "" && s.Board != target.Board {
+		return false
+	}
+
+	if s.Arch != "" && s.Arch != target.Arch {
+		return false
+	}
+
+	if s.Hostname != "" {
+		if matched, _ := path.Match(s.Hostname, target.Hostname); !matched || target.Hostname == "" { //nolint:errcheck // validated on load
+			return false
+		}
+	}
+
+	for key, value := range s.Labels {
+		if actual, ok := target.Labels[key]; !ok || actual != value {
+			return false
+		}
+	}
+
+	return true
+}
+
+// SelectorPatch is a patch which is applied only to the machines matching the selector.
+type SelectorPatch struct {
+	Selector Selector
+	Patch    Patch
+}
+
+// Target describes the machine the patches are applied to.
+type Target struct {
+	MachineType machine.Type
+	Board       string
+	Arch        string
+	Hostname    string
+	Labels      map[string]string
+}
+
+// ApplyOption sets the target facts which can't be derived from the patched config.
+type ApplyOption func(*Target)
+
+// WithBoard sets the board name of the target machine.
+func WithBoard(board string) ApplyOption {
+	return func(t *Target) {
+		t.Board = board
+	}
+}
+
+// WithArch sets the architecture of the target machine.
+func WithArch(arch string) ApplyOption {
+	return func(t *Target) {
+		t.Arch = arch
+	}
+}
+
+// WithHostname overrides the hostname of the target machine.
+func WithHostname(hostname string) ApplyOption {
+	return func(t *Target) {
+		t.Hostname = hostname
+	}
+}
+
+// WithLabels sets the labels of the target machine, the labels override the node labels in the config.
+func WithLabels(labels map[string]string) ApplyOption {
+	return func(t *Target) {
+		for key, value := range labels {
+			t.Labels[key] = value
+		}
+	}
+}
+
+// targetFor builds the target from the config being patched and the options.
+func targetFor(cfg config.Provider, opts []ApplyOption) Target {
+	target := Target{
+		Labels: map[string]string{},
+	}
+
+	if cfg.Machine() != nil {
+		target.MachineType = cfg.Machine().Type()
+		target.Hostname = cfg.Machine().Network().Hostname()
+
+		for key, value := range cfg.Machine().NodeLabels() {
+			target.Labels[key] = value
+		}
+	}
+
+	for _, opt := range opts {
+		opt(&target)
+	}
+
+	return target
+}
+
+// splitSelector splits the selector header document from the patch.
+//
+// If the patch has no header, nil selector is returned with the patch unmodified.
+func splitSelector(in []byte) (*Selector, []byte, error) {
+	docs := splitDocuments(in)
+
+	// skip empty documents (e.g. leading '---')
+	for len(docs) > 0 && isEmptyDocument(docs[0]) {
+		docs = docs[1:]
+	}
+
+	if len(docs) == 0 {
+		return nil, in, nil
+	}
+
+	var header map[string]yaml.Node
+
+	if err := yaml.Unmarshal(docs[0], &header); err != nil || len(header) != 1 {
+		return nil, in, nil //nolint:nilerr
+	}
+
+	if _, ok := header[selectorKey]; !ok {
+		return nil, in, nil
+	}
+
+	var envelope struct {
+		Selector Selector `yaml:"selector"`
+	}
+
+	dec := yaml.NewDecoder(bytes.NewReader(docs[0]))
+	dec.KnownFields(true)
+
+	if err := dec.Decode(&envelope); err != nil {
+		return nil, nil, fmt.Errorf("error decoding patch selector: %w", err)
+	}
+
+	if err := envelope.Selector.Validate(); err != nil {
+		return nil, nil, fmt.Errorf("invalid patch selector: %w", err)
+	}
+
+	rest := bytes.Join(docs[1:], []byte("---\n"))
+
+	if isEmptyDocument(rest) {
+		return nil, nil, errors.New("patch with the selector is empty")
+	}
+
+	return &envelope.Selector, rest, nil
+}
+
+// splitDocuments splits the YAML stream on the document separators.
+func splitDocuments(in []byte) [][]byte {
+	var (
+		docs    [][]byte
+		current []byte
+	)
+
+	for _, line := range bytes.SplitAfter(in, []byte("\n")) {
+		if string(bytes.TrimRight(line, " \t\r\n")) == "---" {
+			docs = append(docs, current)
+			current = nil
+
+			continue
+		}
+
+		current = append(current, line...)
+	}
+
+	return append(docs, current)
+}
+
+func isEmptyDocument(doc []byte) bool {
+	for _, line := range strings.Split(string(doc), "\n") {
+		line = strings.TrimSpace(line)
+
+		if line != "" && !strings.HasPrefix(line, "#") {
+			return false
+		}
+	}
+
+	return true
+}
@@ -0,0 +1,194 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package configpatcher_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/siderolabs/talos/pkg/machinery/config/configpatcher"
+	"github.com/siderolabs/talos/pkg/machinery/config/types/v1alpha1/machine"
+)
+
+func TestLoadSelector(t *testing.T) {
+	raw, err := configpatcher.LoadPatch([]byte(`---
+selector:
+  machineType: controlplane
+  hostname: "cp-*"
+---
+machine:
+  network:
+    hostname: foo.bar
+`))
+	require.NoError(t, err)
+
+	p, ok := raw.(configpatcher.SelectorPatch)
+	require.True(t, ok)
+
+	assert.Equal(t, "controlplane", p.Selector.MachineType)
+
+	strategic, ok := p.Patch.(configpatcher.StrategicMergePatch)
+	require.True(t, ok)
+
+	assert.Equal(t, "foo.bar", strategic.Machine().Network().Hostname())
+
+	for _, tt := range []struct {
+		name  string
+		patch string
+		err   string
+	}{
+		{
+			name:  "unknown field",
+			patch: "selector:\n  boards: rock_5b\n---\nmachine: {}\n",
+			err:   "field boards not found",
+		},
+		{
+			name:  "machine type",
+			patch: "selector:\n  machineType: master\n---\nmachine: {}\n",
+			err:   `invalid machine type: "master"`,
+		},
+		{
+			name:  "hostname",
+			patch: "selector:\n  hostname: \"[\"\n---\nmachine: {}\n",
+			err:   "invalid hostname pattern",
+		},
+		{
+			name:  "empty",
+			patch: "selector:\n  arch: arm64\n",
+			err:   "patch with the selector is empty",
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := configpatcher.LoadPatch([]byte(tt.patch))
+			assert.ErrorContains(t, err, tt.err)
+		})
+	}
+}
+
+func TestSelectorMatches(t *testing.T) {
+	target := configpatcher.Target{
+		MachineType: machine.TypeInit,
+		Board:       "rock_5b",
+		Arch:        "arm64",
+		Hostname:    "rock5-cp-1",
+		Labels: map[string]string{
+			"topology.kubernetes.io/zone": "rack1",
+		},
+	}
+
+	for _, tt := range []struct {
+		name     string
+		selector configpatcher.Selector
+		expected bool
+	}{
+		{
+			name:     "empty",
+			expected: true,
+		},
+		{
+			name:     "controlplane matches init",
+			selector: configpatcher.Selector{MachineType: "controlplane"},
+			expected: true,
+		},
+		{
+			name:     "worker",
+			selector: configpatcher.Selector{MachineType: "worker"},
+		},
+		{
+			name:     "all",
+			selector: configpatcher.Selector{Board: "rock_5b", Arch: "arm64", Hostname: "rock5-*", Labels: map[string]string{"topology.kubernetes.io/zone": "rack1"}},
+			expected: true,
+		},
+		{
+			name:     "board",
+			selector: configpatcher.Selector{Board: "rock_5a"},
+		},
+		{
+			name:     "hostname",
+			selector: configpatcher.Selector{Hostname: "amd64-*"},
+		},
+		{
+			name:     "label",
+			selector: configpatcher.Selector{Labels: map[string]string{"topology.kubernetes.io/zone": "rack2"}},
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, tt.selector.Matches(target))
+		})
+	}
+
+	assert.False(t, (&configpatcher.Selector{Hostname: "*"}).Matches(configpatcher.Target{}))
+}
+
+func TestApplySelector(t *testing.T) {
+	patches, err := configpatcher.LoadPatches([]string{"@testdata/selector"})
+	require.NoError(t, err)
+	require.Len(t, patches, 5)
+
+	for _, tt := range []struct {
+		name   string
+		config string
+		opts   []configpatcher.ApplyOption
+
+		expectedDisk        string
+		expectedNameservers []string
+		expectedLabels      map[string]string
+	}{
+		{
+			name: "rock5 worker",
+			config: `version: v1alpha1
+machine:
+  type: worker
+  network:
+    hostname: rock5-1
+`,
+			opts: []configpatcher.ApplyOption{configpatcher.WithBoard("rock_5b"), configpatcher.WithArch("arm64")},
+
+			expectedDisk:        "/dev/nvme0n1",
+			expectedNameservers: []string{"10.0.0.1"},
+			expectedLabels:      map[string]string{"topology.kubernetes.io/zone": "rack1"},
+		},
+		{
+			name: "amd64 controlplane",
+			config: `version: v1alpha1
+machine:
+  type: controlplane
+  network:
+    hostname: rock5-1
+`,
+			opts: []configpatcher.ApplyOption{configpatcher.WithArch("amd64")},
+
+			expectedDisk: "/dev/sda",
+		},
+		{
+			name: "labels option",
+			config: `version: v1alpha1
+machine:
+  type: controlplane
+`,
+			opts: []configpatcher.ApplyOption{configpatcher.WithLabels(map[string]string{"topology.kubernetes.io/zone": "rack1"})},
+
+			expectedNameservers: []string{"10.0.0.1"},
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			out, err := configpatcher.Apply(configpatcher.WithBytes([]byte(tt.config)), patches, tt.opts...)
+			require.NoError(t, err)
+
+			cfg, err := out.Config()
+			require.NoError(t, err)
+
+			assert.Equal(t, map[string]string{"rotate-server-certificates": "true"}, cfg.Machine().Kubelet().ExtraArgs())
+			assert.Equal(t, tt.expectedNameservers, cfg.Machine().Network().Resolvers())
+			assert.Equal(t, tt.expectedLabels, map[string]string(cfg.Machine().NodeLabels()))
+
+			disk, err := cfg.Machine().Install().Disk()
+			require.NoError(t, err)
+			assert.Equal(t, tt.expectedDisk, disk)
+		})
+	}
+}
@@ -9,6 +9,8 @@ import (
 
 	"github.com/siderolabs/talos/pkg/machinery/config"
 	"github.com/siderolabs/talos/pkg/machinery/config/merge"
+	"github.com/siderolabs/talos/pkg/machinery/config/secretref"
+	"github.com/siderolabs/talos/pkg/machinery/config/types/v1alpha1"
 )
 
 // StrategicMergePatch is a strategic merge config patch.
@@ -17,6 +19,10 @@ type StrategicMergePatch struct {
 }
 
 // StrategicMerge performs strategic merge config patching.
+//
+// The standalone documents of the patch replace the documents of the same kind and name,
+// other documents are appended. The secret references of both sides are kept, so that the
+// unchanged secret values are encoded back as references.
 func StrategicMerge(cfg config.Provider, patch StrategicMergePatch) (config.Provider, error) {
 	left := cfg.Raw()
 	right := patch.Raw()
@@ -25,10 +31,61 @@ func StrategicMerge(cfg config.Provider, patch StrategicMergePatch) (config.Prov
 		return nil, err
 	}
 
-	result, ok := left.(config.Provider)
+	result, ok := left.(*v1alpha1.Config)
 	if !ok {
-		return nil, fmt.Errorf("strategic left is not config.Provider %T", left)
+		return nil, fmt.Errorf("strategic left is not v1alpha1 config %T", left)
 	}
 
-	return result, nil
+	documents := mergeDocuments(documentsOf(cfg), documentsOf(patch.Provider))
+	references := append(append([]secretref.Reference(nil), referencesOf(cfg)...), referencesOf(patch.Provider)...)
+
+	if len(documents) == 0 && len(references) == 0 {
+		return result, nil
+	}
+
+	provider, err := v1alpha1.WrapReadonlyWithDocuments(result, documents, nil, references)
+	if err != nil {
+		return nil, err
+	}
+
+	return provider, nil
+}
+
+func documentsOf(cfg config.Provider) []v1alpha1.Document {
+	if provider, ok := cfg.(*v1alpha1.ReadonlyProvider); ok {
+		return provider.Documents()
+	}
+
+	return nil
+}
+
+func referencesOf(cfg config.Provider) []secretref.Reference {
+	if provider, ok := cfg.(*v1alpha1.ReadonlyProvider); ok {
+		return provider.References()
+	}
+
+	return nil
+}
+
+func mergeDocuments(left, right []v1alpha1.Document) []v1alpha1.Document {
+	result := append([]v1alpha1.Document(nil), left...)
+
+	for _, document := range right {
+		replaced := false
+
+		for i := range result {
+			if result[i].Kind() == document.Kind() && result[i].Name() == document.Name() {
+				result[i] = document
+				replaced = true
+
+				break
+			}
+		}
+
+		if !replaced {
+			result = append(result, document)
+		}
+	}
+
+	return result
 }
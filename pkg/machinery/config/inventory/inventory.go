@@ -0,0 +1,221 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+// Package inventory provides the node inventory used to render a machine config per node.
+//
+// The inventory is either a YAML document:
+//
+//	nodes:
+//	  - name: rock5-01
+//	    type: controlplane
+//	    variables:
+//	      address: 192.168.1.10/24
+//
+// or a CSV file with the header row, where the columns other than name and type are the variables:
+//
+//	name,type,address
+//	rock5-01,controlplane,192.168.1.10/24
+//
+// The config patches are Go templates rendered for each node, e.g. `{{ .Name }}` or `{{ .Variables.address }}`.
+package inventory
+
+import (
+	"bytes"
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+	"text/template"
+
+	"github.com/hashicorp/go-multierror"
+	"gopkg.in/yaml.v3"
+
+	"github.com/siderolabs/talos/pkg/machinery/config/configpatcher"
+	"github.com/siderolabs/talos/pkg/machinery/config/types/v1alpha1/machine"
+)
+
+// Inventory is a list of nodes.
+type Inventory struct {
+	Nodes []Node `yaml:"nodes"`
+}
+
+// Node describes a single node of the inventory.
+type Node struct {
+	// Name of the node, it is used as the name of the config file.
+	Name string `yaml:"name"`
+	// Type of the node: controlplane or worker.
+	Type string `yaml:"type"`
+	// Variables available to the patch templates.
+	Variables map[string]string `yaml:"variables,omitempty"`
+	// Patches applied to the node config only, either inline or @file.
+	Patches []string `yaml:"patches,omitempty"`
+}
+
+var nameRegexp = regexp.MustCompile(`^[a-zA-Z0-9]([a-zA-Z0-9._-]*[a-zA-Z0-9])?$`)
+
+// Load the inventory from the file, CSV is used for files with the .csv extension, YAML otherwise.
+func Load(path string) (*Inventory, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	if strings.EqualFold(filepath.Ext(path), ".csv") {
+		return ParseCSV(data)
+	}
+
+	return ParseYAML(data)
+}
+
+// ParseYAML parses and validates the YAML inventory.
+func ParseYAML(data []byte) (*Inventory, error) {
+	var inv Inventory
+
+	dec := yaml.NewDecoder(bytes.NewReader(data))
+	dec.KnownFields(true)
+
+	if err := dec.Decode(&inv); err != nil {
+		return nil, fmt.Errorf("error decoding inventory: %w", err)
+	}
+
+	if err := inv.Validate(); err != nil {
+		return nil, err
+	}
+
+	return &inv, nil
+}
+
+// ParseCSV parses and validates the CSV inventory.
+func ParseCSV(data []byte) (*Inventory, error) {
+	records, err := csv.NewReader(bytes.NewReader(data)).ReadAll()
+	if err != nil {
+		return nil, fmt.Errorf("error decoding inventory: %w", err)
+	}
+
+	if len(records) == 0 {
+		return nil, errors.New("inventory is empty")
+	}
+
+	header := records[0]
+	nameColumn, typeColumn := -1, -1
+
+	for i, column := range header {
+		switch column {
+		case "name":
+			nameColumn = i
+		case "type":
+			typeColumn = i
+		}
+	}
+
+	if nameColumn == -1 || typeColumn == -1 {
+		return nil, errors.New("inventory header should contain the name and type columns")
+	}
+
+	inv := &Inventory{
+		Nodes: make([]Node, 0, len(records)-1),
+	}
+
+	for _, record := range records[1:] {
+		node := Node{
+			Name:      record[nameColumn],
+			Type:      record[typeColumn],
+			Variables: make(map[string]string, len(record)-2),
+		}
+
+		for i, value := range record {
+			if i != nameColumn && i != typeColumn {
+				node.Variables[header[i]] = value
+			}
+		}
+
+		inv.Nodes = append(inv.Nodes, node)
+	}
+
+	if err = inv.Validate(); err != nil {
+		return nil, err
+	}
+
+	return inv, nil
+}
+
+// Validate the inventory.
+func (inv *Inventory) Validate() error {
+	var result *multierror.Error
+
+	if len(inv.Nodes) == 0 {
+		result = multierror.Append(result, errors.New("inventory doesn't contain any nodes"))
+	}
+
+	names := make(map[string]struct{}, len(inv.Nodes))
+
+	for _, node := range inv.Nodes {
+		if !nameRegexp.MatchString(node.Name) {
+			result = multierror.Append(result, fmt.Errorf("invalid node name %q", node.Name))
+		}
+
+		if _, ok := names[node.Name]; ok {
+			result = multierror.Append(result, fmt.Errorf("duplicate node name %q", node.Name))
+		}
+
+		names[node.Name] = struct{}{}
+
+		if _, err := node.MachineType(); err != nil {
+			result = multierror.Append(result, fmt.Errorf("node %q: %w", node.Name, err))
+		}
+	}
+
+	return result.ErrorOrNil()
+}
+
+// MachineType returns the machine type of the node.
+func (n Node) MachineType() (machine.Type, error) {
+	switch n.Type {
+	case machine.TypeControlPlane.String():
+		return machine.TypeControlPlane, nil
+	case machine.TypeWorker.String():
+		return machine.TypeWorker, nil
+	default:
+		return machine.TypeUnknown, fmt.Errorf("unsupported node type %q, supported types: %s|%s", n.Type, machine.TypeControlPlane, machine.TypeWorker)
+	}
+}
+
+// RenderPatches renders the patch templates (inline, @file or @dir) followed by the node patches.
+//
+// Referencing a variable which is not defined for the node is an error.
+func (n Node) RenderPatches(templates []string) ([]configpatcher.Patch, error) {
+	templates = append(append([]string(nil), templates...), n.Patches...)
+
+	contents, err := configpatcher.ReadPatches(templates)
+	if err != nil {
+		return nil, err
+	}
+
+	rendered := make([]string, 0, len(contents))
+
+	for i, tmpl := range contents {
+		t, err := template.New(fmt.Sprintf("patch%d", i)).Option("missingkey=error").Parse(string(tmpl))
+		if err != nil {
+			return nil, fmt.Errorf("error parsing patch template: %w", err)
+		}
+
+		var buf strings.Builder
+
+		if err = t.Execute(&buf, n); err != nil {
+			return nil, fmt.Errorf("error rendering patch for node %q: %w", n.Name, err)
+		}
+
+		rendered = append(rendered, buf.String())
+	}
+
+	patches, err := configpatcher.LoadPatches(rendered)
+	if err != nil {
+		return nil, fmt.Errorf("error loading patch for node %q: %w", n.Name, err)
+	}
+
+	return patches, nil
+}
@@ -0,0 +1,120 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package inventory_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/siderolabs/talos/pkg/machinery/config/configpatcher"
+	"github.com/siderolabs/talos/pkg/machinery/config/inventory"
+	"github.com/siderolabs/talos/pkg/machinery/config/types/v1alpha1"
+	"github.com/siderolabs/talos/pkg/machinery/config/types/v1alpha1/machine"
+)
+
+func TestParse(t *testing.T) {
+	t.Parallel()
+
+	expected := &inventory.Inventory{
+		Nodes: []inventory.Node{
+			{
+				Name:      "rock5-01",
+				Type:      "controlplane",
+				Variables: map[string]string{"address": "192.168.1.10/24", "disk": "/dev/nvme0n1"},
+			},
+			{
+				Name:      "rock5-02",
+				Type:      "worker",
+				Variables: map[string]string{"address": "192.168.1.11/24", "disk": "/dev/mmcblk0"},
+			},
+		},
+	}
+
+	inv, err := inventory.ParseYAML([]byte(`nodes:
+  - name: rock5-01
+    type: controlplane
+    variables:
+      address: 192.168.1.10/24
+      disk: /dev/nvme0n1
+  - name: rock5-02
+    type: worker
+    variables:
+      address: 192.168.1.11/24
+      disk: /dev/mmcblk0
+`))
+	require.NoError(t, err)
+	assert.Equal(t, expected, inv)
+
+	inv, err = inventory.ParseCSV([]byte(`address,name,type,disk
+192.168.1.10/24,rock5-01,controlplane,/dev/nvme0n1
+192.168.1.11/24,rock5-02,worker,/dev/mmcblk0
+`))
+	require.NoError(t, err)
+	assert.Equal(t, expected, inv)
+
+	_, err = inventory.ParseCSV([]byte(`name,type
+rock5-01,controlplane
+rock5-01,init
+../rock5-03,worker
+`))
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), `duplicate node name "rock5-01"`)
+	assert.Contains(t, err.Error(), `node "rock5-01": unsupported node type "init"`)
+	assert.Contains(t, err.Error(), `invalid node name "../rock5-03"`)
+
+	_, err = inventory.ParseCSV([]byte("name,address\n"))
+	assert.EqualError(t, err, "inventory header should contain the name and type columns")
+}
+
+func TestRenderPatches(t *testing.T) {
+	t.Parallel()
+
+	node := inventory.Node{
+		Name:      "rock5-01",
+		Type:      "controlplane",
+		Variables: map[string]string{"address": "192.168.1.10/24"},
+		Patches: []string{
+			`[{"op": "add", "path": "/machine/install/disk", "value": "/dev/nvme0n1"}]`,
+		},
+	}
+
+	machineType, err := node.MachineType()
+	require.NoError(t, err)
+	assert.Equal(t, machine.TypeControlPlane, machineType)
+
+	patches, err := node.RenderPatches([]string{`machine:
+  network:
+    hostname: {{ .Name }}
+    interfaces:
+      - interface: eth0
+        addresses:
+          - {{ .Variables.address }}
+`})
+	require.NoError(t, err)
+
+	out, err := configpatcher.Apply(configpatcher.WithConfig(&v1alpha1.Config{
+		ConfigVersion: "v1alpha1",
+		MachineConfig: &v1alpha1.MachineConfig{
+			MachineInstall: &v1alpha1.InstallConfig{},
+		},
+	}), patches)
+	require.NoError(t, err)
+
+	cfg, err := out.Config()
+	require.NoError(t, err)
+
+	assert.Equal(t, "rock5-01", cfg.Machine().Network().Hostname())
+	assert.Equal(t, []string{"192.168.1.10/24"}, cfg.Machine().Network().Devices()[0].Addresses())
+
+	disk, err := cfg.Machine().Install().Disk()
+	require.NoError(t, err)
+	assert.Equal(t, "/dev/nvme0n1", disk)
+
+	_, err = node.RenderPatches([]string{`machine: {network: {hostname: "{{ .Variables.hostname }}"}}`})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), `error rendering patch for node "rock5-01"`)
+}
@@ -13,9 +13,11 @@ import (
 
 	specs "github.com/opencontainers/runtime-spec/specs-go"
 	"github.com/siderolabs/crypto/x509"
+	"github.com/siderolabs/go-blockdevice/blockdevice/util/disk"
 
 	"github.com/siderolabs/talos/pkg/machinery/config/encoder"
 	"github.com/siderolabs/talos/pkg/machinery/config/types/v1alpha1/machine"
+	"github.com/siderolabs/talos/pkg/machinery/nethelpers"
 )
 
 // Provider defines the configuration consumption interface.
@@ -72,6 +74,9 @@ type MachineConfig interface {
 	Kernel() Kernel
 	SeccompProfiles() []SeccompProfile
 	NodeLabels() NodeLabels
+	Fan() Fan
+	Watchdog() Watchdog
+	LEDs() []LED
 }
 
 // SeccompProfile defines the requirements for a config that pertains to seccomp
@@ -114,10 +119,13 @@ type Install interface {
 	Image() string
 	Extensions() []Extension
 	Disk() (string, error)
+	DiskMatchers() []disk.Matcher
 	ExtraKernelArgs() []string
 	Zero() bool
 	LegacyBIOSSupport() bool
 	WithBootloader() bool
+	DeviceTreeOverlays() []string
+	BootloaderTarget() string
 }
 
 // Extension defines the system extension.
@@ -161,6 +169,9 @@ type MachineNetwork interface {
 	ExtraHosts() []ExtraHost
 	KubeSpan() KubeSpan
 	DisableSearchDomain() bool
+	Firewall() NetworkFirewall
+	Ethernet() []EthernetConfig
+	RoutingRules() []RoutingRule
 }
 
 // ExtraHost represents a host entry in /etc/hosts.
@@ -179,6 +190,10 @@ type Device interface {
 	Bond() Bond
 	Bridge() Bridge
 	Vlans() []Vlan
+	VXLAN() VXLAN
+	MACVLAN() MACVLAN
+	MACVTAP() MACVLAN
+	IPVLAN() IPVLAN
 	MTU() int
 	DHCP() bool
 	Ignore() bool
@@ -276,6 +291,28 @@ type Bridge interface {
 	STP() STP
 }
 
+// VXLAN contains the options for configuring a VXLAN interface.
+type VXLAN interface {
+	VNI() uint32
+	Remote() string
+	Group() string
+	Local() string
+	Port() uint16
+	Parent() string
+}
+
+// MACVLAN contains the options for configuring a macvlan or macvtap interface.
+type MACVLAN interface {
+	Parent() string
+	Mode() string
+}
+
+// IPVLAN contains the options for configuring an ipvlan interface.
+type IPVLAN interface {
+	Parent() string
+	Mode() string
+}
+
 // Vlan represents vlan settings for a device.
 type Vlan interface {
 	Addresses() []string
@@ -294,6 +331,7 @@ type Route interface {
 	Source() string
 	Metric() uint32
 	MTU() uint32
+	Table() nethelpers.RoutingTable
 }
 
 // KubeSpan configures KubeSpan feature.
@@ -562,6 +600,7 @@ type Features interface {
 	StableHostnameEnabled() bool
 	KubernetesTalosAPIAccess() KubernetesTalosAPIAccess
 	ApidCheckExtKeyUsageEnabled() bool
+	HostDNS() HostDNS
 }
 
 // KubernetesTalosAPIAccess describes the Kubernetes Talos API access features.
@@ -571,6 +610,11 @@ type KubernetesTalosAPIAccess interface {
 	AllowedKubernetesNamespaces() []string
 }
 
+// HostDNS describes the host DNS configuration.
+type HostDNS interface {
+	Enabled() bool
+}
+
 // VolumeMount describes extra volume mount for the static pods.
 type VolumeMount interface {
 	Name() string
@@ -634,3 +678,102 @@ type KernelModule interface {
 	Name() string
 	Parameters() []string
 }
+
+// Fan describes PWM fan control configuration.
+type Fan interface {
+	Enabled() bool
+	Hwmon() string
+	ThermalZone() string
+	Curve() []FanCurvePoint
+}
+
+// Watchdog describes the hardware watchdog timer configuration.
+type Watchdog interface {
+	Enabled() bool
+	Device() string
+	Timeout() time.Duration
+}
+
+// LED describes the LED indicating the machine stage.
+type LED interface {
+	Name() string
+	Stages() map[string]string
+}
+
+// FanCurvePoint describes a single point of the fan curve.
+type FanCurvePoint interface {
+	Temperature() int
+	Speed() int
+}
+
+// NetworkFirewall configures the host ingress firewall.
+type NetworkFirewall interface {
+	Enabled() bool
+	DefaultAction() nethelpers.DefaultAction
+	DefaultRules() bool
+	Rules() []NetworkRule
+}
+
+// NetworkRule describes an ingress rule of the host firewall.
+type NetworkRule interface {
+	Name() string
+	Protocol() nethelpers.Protocol
+	Ports() []nethelpers.PortRange
+	Ingress() []NetworkRuleIngress
+}
+
+// NetworkRuleIngress describes a subnet the traffic is accepted from.
+type NetworkRuleIngress interface {
+	Subnet() netip.Prefix
+	Except() netip.Prefix
+}
+
+// RoutingRule describes a policy routing rule.
+//
+// Zero Source and Destination match any address, such rules are created for both address families.
+type RoutingRule interface {
+	Source() netip.Prefix
+	Destination() netip.Prefix
+	IIF() string
+	OIF() string
+	FwMark() uint32
+	FwMask() uint32
+	Table() nethelpers.RoutingTable
+	Priority() uint32
+	Families() []nethelpers.Family
+}
+
+// EthernetConfig configures the Ethernet settings of the links.
+//
+// Nil values are not changed.
+type EthernetConfig interface {
+	Interface() string
+	Selector() NetworkDeviceSelector
+	Rings() EthernetRings
+	Channels() EthernetChannels
+	Features() map[string]bool
+	Pause() EthernetPause
+}
+
+// EthernetRings configures the ring buffer sizes.
+type EthernetRings interface {
+	RX() *uint32
+	RXMini() *uint32
+	RXJumbo() *uint32
+	TX() *uint32
+}
+
+// EthernetChannels configures the number of channels.
+type EthernetChannels interface {
+	RX() *uint32
+	TX() *uint32
+	Other() *uint32
+	Combined() *uint32
+}
+
+// EthernetPause configures the pause frames.
+type EthernetPause interface {
+	Autonegotiate() *bool
+	RX() *bool
+	TX() *bool
+}
@@ -7,7 +7,10 @@ package config
 import (
 	"errors"
 	"fmt"
+	"sort"
 	"sync"
+
+	"github.com/siderolabs/gen/maps"
 )
 
 var (
@@ -37,6 +40,11 @@ func New(kind, version string) (interface{}, error) {
 	return registry.new(kind, version)
 }
 
+// Kinds returns the sorted list of the registered manifest kinds.
+func Kinds() []string {
+	return registry.kinds()
+}
+
 func (r *Registry) register(kind string, f func(version string) interface{}) {
 	r.m.Lock()
 	defer r.m.Unlock()
@@ -54,8 +62,21 @@ func (r *Registry) new(kind, version string) (interface{}, error) {
 
 	f, ok := r.registered[kind]
 	if ok {
-		return f(version), nil
+		// the kind might not support the requested version
+		if target := f(version); target != nil {
+			return target, nil
+		}
 	}
 
 	return nil, fmt.Errorf("%q %q: %w", kind, version, ErrNotRegistered)
 }
+
+func (r *Registry) kinds() []string {
+	r.m.Lock()
+	defer r.m.Unlock()
+
+	kinds := maps.Keys(r.registered)
+	sort.Strings(kinds)
+
+	return kinds
+}
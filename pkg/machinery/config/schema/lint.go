@@ -0,0 +1,362 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package schema
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/go-multierror"
+	"github.com/santhosh-tekuri/jsonschema/v5"
+	"gopkg.in/yaml.v3"
+
+	"github.com/siderolabs/talos/pkg/machinery/config"
+	"github.com/siderolabs/talos/pkg/machinery/config/configloader"
+	"github.com/siderolabs/talos/pkg/machinery/config/decoder"
+)
+
+// Finding is a single lint finding.
+type Finding struct {
+	// Line and Column of the field, zero if the finding has no position.
+	Line   int
+	Column int
+	// Path to the field, e.g. .machine.install.disk.
+	Path    string
+	Message string
+	Warning bool
+}
+
+// String implements fmt.Stringer.
+func (f Finding) String() string {
+	var sb strings.Builder
+
+	if f.Line > 0 {
+		fmt.Fprintf(&sb, "%d:%d: ", f.Line, f.Column)
+	}
+
+	if f.Warning {
+		sb.WriteString("warning: ")
+	}
+
+	if f.Path != "" {
+		fmt.Fprintf(&sb, "%s: ", f.Path)
+	}
+
+	sb.WriteString(f.Message)
+
+	return sb.String()
+}
+
+// Lint the configuration documents against the schemas.
+//
+// The talosconfig is detected by the contexts key, the standalone documents by the kind, everything else is
+// validated as the machine configuration. If the documents match the schemas, the machine configuration
+// is loaded and validated for the runtime mode.
+func Lint(data []byte, mode config.RuntimeMode) ([]Finding, error) {
+	dec := yaml.NewDecoder(bytes.NewReader(data))
+
+	var (
+		findings      []Finding
+		docs          []*yaml.Node
+		machineConfig bool
+	)
+
+	for {
+		var doc yaml.Node
+
+		err := dec.Decode(&doc)
+		if errors.Is(err, io.EOF) {
+			break
+		}
+
+		if err != nil {
+			return append(findings, Finding{Message: err.Error()}), nil
+		}
+
+		if len(doc.Content) == 0 {
+			continue
+		}
+
+		name := documentSchema(doc.Content[0])
+
+		if name != Talosconfig {
+			machineConfig = true
+
+			docs = append(docs, doc.Content[0])
+		}
+
+		docFindings, err := lintDocument(name, &doc)
+		if err != nil {
+			return nil, err
+		}
+
+		findings = append(findings, docFindings...)
+	}
+
+	if len(findings) > 0 || !machineConfig {
+		return findings, nil
+	}
+
+	return validate(data, docs, mode), nil
+}
+
+func documentSchema(root *yaml.Node) string {
+	if root.Kind != yaml.MappingNode {
+		return MachineConfig
+	}
+
+	for i := 0; i+1 < len(root.Content); i += 2 {
+		switch root.Content[i].Value {
+		case decoder.ManifestKindKey:
+			return root.Content[i+1].Value
+		case "contexts":
+			return Talosconfig
+		}
+	}
+
+	return MachineConfig
+}
+
+func lintDocument(name string, doc *yaml.Node) ([]Finding, error) {
+	schemaData, err := Get(name)
+	if err != nil {
+		line, column := doc.Content[0].Line, doc.Content[0].Column
+
+		return []Finding{{Line: line, Column: column, Message: err.Error()}}, nil
+	}
+
+	compiler := jsonschema.NewCompiler()
+
+	if err = compiler.AddResource(ID(name), bytes.NewReader(schemaData)); err != nil {
+		return nil, err
+	}
+
+	compiled, err := compiler.Compile(ID(name))
+	if err != nil {
+		return nil, fmt.Errorf("error compiling schema %q: %w", name, err)
+	}
+
+	value, err := jsonValue(doc)
+	if err != nil {
+		return []Finding{{Line: doc.Content[0].Line, Column: doc.Content[0].Column, Message: err.Error()}}, nil
+	}
+
+	err = compiled.Validate(value)
+	if err == nil {
+		return nil, nil
+	}
+
+	var validationErr *jsonschema.ValidationError
+
+	if !errors.As(err, &validationErr) {
+		return nil, err
+	}
+
+	var findings []Finding
+
+	for _, leaf := range leaves(validationErr) {
+		path := strings.Split(strings.TrimPrefix(leaf.InstanceLocation, "/"), "/")
+		if leaf.InstanceLocation == "" {
+			path = nil
+		}
+
+		for i := range path {
+			path[i] = strings.NewReplacer("~1", "/", "~0", "~").Replace(path[i])
+		}
+
+		node := lookup(doc.Content[0], path)
+
+		findings = append(findings, Finding{
+			Line:    node.Line,
+			Column:  node.Column,
+			Path:    "." + strings.Join(path, "."),
+			Message: leaf.Message,
+		})
+	}
+
+	// validation errors are not ordered
+	sort.SliceStable(findings, func(i, j int) bool {
+		if findings[i].Line != findings[j].Line {
+			return findings[i].Line < findings[j].Line
+		}
+
+		return findings[i].Column < findings[j].Column
+	})
+
+	return findings, nil
+}
+
+// jsonValue converts the YAML document to the value as decoded from JSON.
+func jsonValue(doc *yaml.Node) (interface{}, error) {
+	var raw interface{}
+
+	if err := doc.Decode(&raw); err != nil {
+		return nil, err
+	}
+
+	encoded, err := json.Marshal(raw)
+	if err != nil {
+		return nil, err
+	}
+
+	dec := json.NewDecoder(bytes.NewReader(encoded))
+	dec.UseNumber()
+
+	var value interface{}
+
+	return value, dec.Decode(&value)
+}
+
+func leaves(err *jsonschema.ValidationError) []*jsonschema.ValidationError {
+	if len(err.Causes) == 0 {
+		return []*jsonschema.ValidationError{err}
+	}
+
+	var result []*jsonschema.ValidationError
+
+	for _, cause := range err.Causes {
+		result = append(result, leaves(cause)...)
+	}
+
+	return result
+}
+
+// lookup the node by path, the closest parent node is returned if the path doesn't exist.
+func lookup(node *yaml.Node, path []string) *yaml.Node {
+	for _, elem := range path {
+		switch node.Kind { //nolint:exhaustive
+		case yaml.MappingNode:
+			found := false
+
+			for i := 0; i+1 < len(node.Content); i += 2 {
+				if node.Content[i].Value == elem {
+					node = node.Content[i+1]
+					found = true
+
+					break
+				}
+			}
+
+			if !found {
+				return node
+			}
+		case yaml.SequenceNode:
+			idx, err := strconv.Atoi(elem)
+			if err != nil || idx < 0 || idx >= len(node.Content) {
+				return node
+			}
+
+			node = node.Content[idx]
+		default:
+			return node
+		}
+	}
+
+	return node
+}
+
+var (
+	// .machine.network.kubespan
+	messagePathRe = regexp.MustCompile(`(?:^|[\s\[(])((?:\.[A-Za-z0-9]+)+)`)
+	// "/dev/sda"
+	messageValueRe = regexp.MustCompile(`"(?:[^"\\]|\\.)*"`)
+)
+
+// position of the validation message in the documents.
+//
+// The paths (e.g. .cluster.id) are looked up first, then the quoted values (e.g. the interface name).
+func position(docs []*yaml.Node, message string) (line, column int) {
+	for _, match := range messagePathRe.FindAllStringSubmatch(message, -1) {
+		path := strings.Split(strings.TrimPrefix(match[1], "."), ".")
+
+		for _, doc := range docs {
+			if node := lookup(doc, path); node != doc {
+				return node.Line, node.Column
+			}
+		}
+	}
+
+	for _, match := range messageValueRe.FindAllString(message, -1) {
+		value, err := strconv.Unquote(match)
+		if err != nil || value == "" {
+			continue
+		}
+
+		for _, doc := range docs {
+			if node := lookupValue(doc, value); node != nil {
+				return node.Line, node.Column
+			}
+		}
+	}
+
+	return 0, 0
+}
+
+// lookupValue returns the first scalar value node (not a key) equal to the value.
+func lookupValue(node *yaml.Node, value string) *yaml.Node {
+	switch node.Kind { //nolint:exhaustive
+	case yaml.MappingNode:
+		for i := 1; i < len(node.Content); i += 2 {
+			if found := lookupValue(node.Content[i], value); found != nil {
+				return found
+			}
+		}
+	case yaml.SequenceNode:
+		for _, item := range node.Content {
+			if found := lookupValue(item, value); found != nil {
+				return found
+			}
+		}
+	case yaml.ScalarNode:
+		if node.Value == value {
+			return node
+		}
+	}
+
+	return nil
+}
+
+func validate(data []byte, docs []*yaml.Node, mode config.RuntimeMode) []Finding {
+	// the secret references are not resolved, the lint doesn't need the secret values
+	cfg, err := configloader.NewFromBytes(data)
+	if err != nil {
+		return []Finding{{Message: err.Error()}}
+	}
+
+	warnings, err := cfg.Validate(mode, config.WithLocal())
+
+	var findings []Finding
+
+	finding := func(message string, warning bool) Finding {
+		line, column := position(docs, message)
+
+		return Finding{Line: line, Column: column, Message: message, Warning: warning}
+	}
+
+	if err != nil {
+		var multiErr *multierror.Error
+
+		if errors.As(err, &multiErr) {
+			for _, e := range multiErr.Errors {
+				findings = append(findings, finding(e.Error(), false))
+			}
+		} else {
+			findings = append(findings, finding(err.Error(), false))
+		}
+	}
+
+	for _, w := range warnings {
+		findings = append(findings, finding(w, true))
+	}
+
+	return findings
+}
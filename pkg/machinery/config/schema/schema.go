@@ -0,0 +1,274 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+// Package schema provides the JSON Schemas of the configuration documents.
+//
+// The v1alpha1 machine configuration schema is generated by docgen, the schemas of the standalone documents
+// and the talosconfig are built from the Go types, the descriptions come from the generated docs.
+package schema
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+
+	"github.com/siderolabs/talos/pkg/machinery/client/config"
+	talosconfig "github.com/siderolabs/talos/pkg/machinery/config"
+	"github.com/siderolabs/talos/pkg/machinery/config/encoder"
+	"github.com/siderolabs/talos/pkg/machinery/config/types/v1alpha1"
+	"github.com/siderolabs/talos/pkg/machinery/gendata"
+)
+
+const (
+	// MachineConfig is the name of the v1alpha1 machine configuration schema.
+	MachineConfig = "v1alpha1"
+	// Talosconfig is the name of the client configuration schema.
+	Talosconfig = "talosconfig"
+
+	// documentVersion is the only version of the standalone documents.
+	documentVersion = "v1alpha1"
+
+	schemaDraft = "https://json-schema.org/draft/2020-12/schema"
+)
+
+// Names returns the names of all schemas: the machine configuration, the standalone document kinds and talosconfig.
+func Names() []string {
+	names := []string{MachineConfig}
+
+	for _, kind := range talosconfig.Kinds() {
+		// the machine configuration is registered with the version as the kind
+		if kind != MachineConfig {
+			names = append(names, kind)
+		}
+	}
+
+	return append(names, Talosconfig)
+}
+
+// ID returns the versioned $id of the schema.
+func ID(name string) string {
+	version := strings.TrimSpace(gendata.VersionTag)
+
+	if contract, err := talosconfig.ParseContractFromVersion(version); err == nil {
+		version = fmt.Sprintf("v%d.%d", contract.Major, contract.Minor)
+	}
+
+	if name == MachineConfig {
+		return fmt.Sprintf("https://talos.dev/%s/schemas/v1alpha1_config.schema.json", version)
+	}
+
+	return fmt.Sprintf("https://talos.dev/%s/schemas/%s.schema.json", version, strings.ToLower(name))
+}
+
+// Get returns the JSON Schema by name.
+func Get(name string) ([]byte, error) {
+	switch name {
+	case MachineConfig:
+		return v1alpha1.ConfigSchema, nil
+	case Talosconfig:
+		return build(name, reflect.TypeOf(config.Config{}), nil)
+	}
+
+	doc, err := talosconfig.New(name, documentVersion)
+	if err != nil {
+		return nil, fmt.Errorf("unknown schema %q, supported schemas: %s", name, strings.Join(Names(), ", "))
+	}
+
+	return build(name, reflect.TypeOf(doc), func(spec map[string]any) map[string]any {
+		return map[string]any{
+			"type": "object",
+			"properties": map[string]any{
+				"kind":    map[string]any{"const": name},
+				"version": map[string]any{"const": documentVersion},
+				"spec":    spec,
+			},
+			"required":             []string{"kind", "version", "spec"},
+			"additionalProperties": false,
+		}
+	})
+}
+
+// build the schema of the Go type, wrap is used to wrap the type schema into the document envelope.
+func build(name string, typ reflect.Type, wrap func(map[string]any) map[string]any) ([]byte, error) {
+	b := &builder{
+		defs: map[string]any{},
+	}
+
+	root := b.schema(typ, "")
+
+	if wrap != nil {
+		root = wrap(root)
+	}
+
+	root["$schema"] = schemaDraft
+	root["$id"] = ID(name)
+	root["$defs"] = b.defs
+
+	return json.MarshalIndent(root, "", "  ")
+}
+
+type documented interface {
+	Doc() *encoder.Doc
+}
+
+// obsoleteUnmarshaler is the yaml.v2 style unmarshaler still supported by yaml.v3.
+type obsoleteUnmarshaler interface {
+	UnmarshalYAML(unmarshal func(interface{}) error) error
+}
+
+type builder struct {
+	defs map[string]any
+}
+
+func (b *builder) schema(typ reflect.Type, description string) map[string]any {
+	for typ.Kind() == reflect.Pointer {
+		typ = typ.Elem()
+	}
+
+	var result map[string]any
+
+	switch {
+	case implementsUnmarshaler(typ):
+		// custom YAML format, accept any value
+		result = map[string]any{}
+	case typ.Kind() == reflect.Struct:
+		result = map[string]any{"$ref": "#/$defs/" + b.define(typ)}
+	case typ.Kind() == reflect.Slice && typ.Elem().Kind() == reflect.Uint8:
+		result = map[string]any{"type": "string"}
+	case typ.Kind() == reflect.Slice || typ.Kind() == reflect.Array:
+		result = map[string]any{
+			"type":  "array",
+			"items": b.schema(typ.Elem(), ""),
+		}
+	case typ.Kind() == reflect.Map:
+		result = map[string]any{
+			"type": "object",
+			"patternProperties": map[string]any{
+				".*": b.schema(typ.Elem(), ""),
+			},
+		}
+	default:
+		result = map[string]any{}
+
+		if jsonType := scalarType(typ.Kind()); jsonType != "" {
+			result["type"] = jsonType
+		}
+	}
+
+	if description != "" {
+		result["description"] = description
+	}
+
+	return result
+}
+
+// define the struct type in the $defs and return its name.
+func (b *builder) define(typ reflect.Type) string {
+	name := typ.Name()
+
+	if _, ok := b.defs[name]; ok {
+		return name
+	}
+
+	// reserve the name first to break the cycles
+	b.defs[name] = nil
+
+	descriptions := map[string]string{}
+
+	def := map[string]any{
+		"type":                 "object",
+		"additionalProperties": false,
+	}
+
+	if doc := typeDoc(typ); doc != nil {
+		if doc.Description != "" {
+			def["description"] = doc.Description
+		}
+
+		for _, field := range doc.Fields {
+			descriptions[field.Name] = field.Description
+		}
+	}
+
+	properties := map[string]any{}
+
+	b.properties(typ, descriptions, properties)
+
+	def["properties"] = properties
+	b.defs[name] = def
+
+	return name
+}
+
+func (b *builder) properties(typ reflect.Type, descriptions map[string]string, properties map[string]any) {
+	for i := 0; i < typ.NumField(); i++ {
+		field := typ.Field(i)
+
+		if !field.IsExported() {
+			continue
+		}
+
+		name, opts, _ := strings.Cut(field.Tag.Get("yaml"), ",")
+
+		switch {
+		case name == "-":
+			continue
+		case name == "" && strings.Contains(opts, "inline"):
+			fieldType := field.Type
+
+			for fieldType.Kind() == reflect.Pointer {
+				fieldType = fieldType.Elem()
+			}
+
+			b.properties(fieldType, descriptions, properties)
+
+			continue
+		case name == "":
+			name = strings.ToLower(field.Name)
+		}
+
+		properties[name] = b.schema(field.Type, descriptions[name])
+	}
+}
+
+// typeDoc returns the generated docs of the type, docs are implemented on the value receivers.
+func typeDoc(typ reflect.Type) *encoder.Doc {
+	if !typ.Implements(reflect.TypeOf((*documented)(nil)).Elem()) {
+		return nil
+	}
+
+	return reflect.Zero(typ).Interface().(documented).Doc() //nolint:forcetypeassert
+}
+
+func implementsUnmarshaler(typ reflect.Type) bool {
+	for _, unmarshaler := range []reflect.Type{
+		reflect.TypeOf((*yaml.Unmarshaler)(nil)).Elem(),
+		reflect.TypeOf((*obsoleteUnmarshaler)(nil)).Elem(),
+	} {
+		if typ.Implements(unmarshaler) || reflect.PointerTo(typ).Implements(unmarshaler) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func scalarType(kind reflect.Kind) string {
+	switch kind { //nolint:exhaustive
+	case reflect.String:
+		return "string"
+	case reflect.Bool:
+		return "boolean"
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return "integer"
+	case reflect.Float32, reflect.Float64:
+		return "number"
+	default:
+		return ""
+	}
+}
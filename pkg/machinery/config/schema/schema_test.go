@@ -0,0 +1,150 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package schema_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/siderolabs/talos/pkg/machinery/config/encoder"
+	"github.com/siderolabs/talos/pkg/machinery/config/schema"
+	"github.com/siderolabs/talos/pkg/machinery/config/types/v1alpha1/generate"
+	"github.com/siderolabs/talos/pkg/machinery/config/types/v1alpha1/machine"
+	"github.com/siderolabs/talos/pkg/machinery/constants"
+)
+
+type metalMode struct{}
+
+func (metalMode) String() string        { return "metal" }
+func (metalMode) RequiresInstall() bool { return true }
+
+func TestGet(t *testing.T) {
+	t.Parallel()
+
+	names := schema.Names()
+	assert.Equal(t, []string{"v1alpha1", "KubeSpanConfig", "NetworkLinkConfig", "NetworkRuleConfig", "RegistryMirrorConfig", "talosconfig"}, names)
+
+	for _, name := range names {
+		data, err := schema.Get(name)
+		require.NoError(t, err)
+
+		var parsed map[string]any
+
+		require.NoError(t, json.Unmarshal(data, &parsed))
+		assert.Equal(t, schema.ID(name), parsed["$id"])
+	}
+
+	data, err := schema.Get("NetworkLinkConfig")
+	require.NoError(t, err)
+	assert.Contains(t, string(data), "The name of the link (interface).")
+
+	_, err = schema.Get("FooConfig")
+	assert.ErrorContains(t, err, `unknown schema "FooConfig"`)
+}
+
+func TestLint(t *testing.T) {
+	t.Parallel()
+
+	secrets, err := generate.NewSecretsBundle(generate.NewClock())
+	require.NoError(t, err)
+
+	input, err := generate.NewInput("test", "https://10.5.0.2:6443", constants.DefaultKubernetesVersion, secrets, generate.WithInstallDisk("/dev/nvme0n1"))
+	require.NoError(t, err)
+
+	cfg, err := generate.Config(machine.TypeControlPlane, input)
+	require.NoError(t, err)
+
+	valid, err := cfg.EncodeBytes(encoder.WithComments(encoder.CommentsDisabled))
+	require.NoError(t, err)
+
+	findings, err := schema.Lint(valid, metalMode{})
+	require.NoError(t, err)
+	assert.Empty(t, findings)
+
+	findings, err = schema.Lint([]byte(`version: v1alpha1
+machine:
+  type: worker
+  install:
+    disk: /dev/nvme0n1
+    wipe: "yes"
+  kubelet:
+    extraArgz: {}
+---
+kind: NetworkLinkConfig
+version: v1alpha1
+spec:
+  name: eth1
+  mtu: large
+`), metalMode{})
+	require.NoError(t, err)
+
+	require.Len(t, findings, 3)
+
+	assert.Equal(t, 6, findings[0].Line)
+	assert.Equal(t, 11, findings[0].Column)
+	assert.Equal(t, ".machine.install.wipe", findings[0].Path)
+
+	assert.Equal(t, 8, findings[1].Line)
+	assert.Equal(t, ".machine.kubelet", findings[1].Path)
+	assert.Contains(t, findings[1].Message, "extraArgz")
+
+	assert.Equal(t, 14, findings[2].Line)
+	assert.Equal(t, 8, findings[2].Column)
+	assert.Equal(t, ".spec.mtu", findings[2].Path)
+
+	findings, err = schema.Lint([]byte(`version: v1alpha1
+machine:
+  type: worker
+cluster:
+  controlPlane:
+    endpoint: https://10.5.0.2:6443
+`), metalMode{})
+	require.NoError(t, err)
+	require.NotEmpty(t, findings)
+	assert.Equal(t, `install instructions are required in "metal" mode`, findings[0].Message)
+	assert.Zero(t, findings[0].Line)
+
+	findings, err = schema.Lint([]byte(`version: v1alpha1
+machine:
+  type: worker
+  install:
+    disk: /dev/nvme0n1
+    extensions:
+      - image: ghcr.io/siderolabs/iscsi-tools:v0.1.4
+      - image: ghcr.io/siderolabs/iscsi-tools:v0.1.4
+  network:
+    kubespan:
+      enabled: true
+cluster:
+  controlPlane:
+    endpoint: https://10.5.0.2:6443
+  discovery:
+    enabled: false
+`), metalMode{})
+	require.NoError(t, err)
+
+	positions := map[string][2]int{}
+
+	for _, finding := range findings {
+		positions[finding.Message] = [2]int{finding.Line, finding.Column}
+	}
+
+	assert.Equal(t, [2]int{7, 16}, positions[`duplicate system extension "ghcr.io/siderolabs/iscsi-tools:v0.1.4"`])
+	assert.Equal(t, [2]int{16, 5}, positions[".cluster.discovery should be enabled when .machine.network.kubespan is enabled"])
+
+	findings, err = schema.Lint([]byte(`context: rock5
+contexts:
+  rock5:
+    endpoints:
+      - 10.5.0.2
+    nodez: []
+`), metalMode{})
+	require.NoError(t, err)
+	require.Len(t, findings, 1)
+	assert.Equal(t, ".contexts.rock5", findings[0].Path)
+}
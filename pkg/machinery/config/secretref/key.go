@@ -0,0 +1,65 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package secretref
+
+import (
+	"bytes"
+	"fmt"
+	"os"
+
+	"filippo.io/age"
+
+	"github.com/siderolabs/talos/pkg/machinery/constants"
+)
+
+// KeyFileEnvVar is the environment variable which overrides the path to the identities file.
+const KeyFileEnvVar = "TALOS_SECRET_KEY_FILE"
+
+// ParseIdentities parses the age identities file, one identity per line, empty lines and #-comments are ignored.
+func ParseIdentities(data []byte) ([]age.Identity, error) {
+	identities, err := age.ParseIdentities(bytes.NewReader(data))
+	if err != nil {
+		return nil, fmt.Errorf("error parsing secret key: %w", err)
+	}
+
+	return identities, nil
+}
+
+// ParseRecipients parses the age recipients (age1...).
+func ParseRecipients(recipients []string) ([]age.Recipient, error) {
+	parsed := make([]age.Recipient, 0, len(recipients))
+
+	for _, recipient := range recipients {
+		r, err := age.ParseX25519Recipient(recipient)
+		if err != nil {
+			return nil, err
+		}
+
+		parsed = append(parsed, r)
+	}
+
+	return parsed, nil
+}
+
+// DefaultIdentities loads the identities from the file set in the $TALOS_SECRET_KEY_FILE,
+// or from the constants.SecretKeyPath on the node.
+func DefaultIdentities() ([]age.Identity, error) {
+	path := os.Getenv(KeyFileEnvVar)
+	if path == "" {
+		path = constants.SecretKeyPath
+	}
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("error loading secret key: %w", err)
+	}
+
+	return ParseIdentities(data)
+}
+
+// FormatIdentity returns the identity in the age identities file format.
+func FormatIdentity(identity *age.X25519Identity) []byte {
+	return []byte(fmt.Sprintf("# public key: %s\n%s\n", identity.Recipient(), identity))
+}
@@ -0,0 +1,140 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+// Package secretref implements references to the secret values in the machine configuration.
+//
+// A secret value (a string or a base64-encoded bytes field) can be replaced with a reference:
+//
+//   - ENC[AGE,<base64>] is the value encrypted with age (https://age-encryption.org) for one or more recipients,
+//     it is decrypted with any of the matching age identities;
+//   - FILE[/path/to/file] is the value read from the local file, trailing newline is trimmed.
+package secretref
+
+import (
+	"bytes"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"filippo.io/age"
+)
+
+const (
+	encryptedPrefix = "ENC[AGE,"
+	filePrefix      = "FILE["
+	referenceSuffix = "]"
+)
+
+// ErrNoIdentity is returned when none of the identities can decrypt the reference.
+var ErrNoIdentity = errors.New("no identity matched the encrypted secret")
+
+// IdentitiesFunc returns the identities to decrypt the references with.
+//
+// It is called only if the input contains encrypted references.
+type IdentitiesFunc func() ([]age.Identity, error)
+
+// IsReference returns true if the value is a secret reference.
+func IsReference(value string) bool {
+	return isEncrypted(value) || isFile(value)
+}
+
+func isEncrypted(value string) bool {
+	return strings.HasPrefix(value, encryptedPrefix) && strings.HasSuffix(value, referenceSuffix)
+}
+
+func isFile(value string) bool {
+	return strings.HasPrefix(value, filePrefix) && strings.HasSuffix(value, referenceSuffix)
+}
+
+// FileReference returns the reference to the value stored in the file.
+func FileReference(path string) string {
+	return filePrefix + path + referenceSuffix
+}
+
+// Encrypt the value for the recipients and return the reference.
+func Encrypt(value []byte, recipients ...age.Recipient) (string, error) {
+	if len(recipients) == 0 {
+		return "", errors.New("no recipients specified")
+	}
+
+	var buf bytes.Buffer
+
+	w, err := age.Encrypt(&buf, recipients...)
+	if err != nil {
+		return "", err
+	}
+
+	if _, err = w.Write(value); err != nil {
+		return "", err
+	}
+
+	if err = w.Close(); err != nil {
+		return "", err
+	}
+
+	return encryptedPrefix + base64.StdEncoding.EncodeToString(buf.Bytes()) + referenceSuffix, nil
+}
+
+// Resolve the reference and return the secret value.
+//
+// Values which are not references are returned as is.
+func Resolve(value string, identities IdentitiesFunc) (string, error) {
+	switch {
+	case isEncrypted(value):
+		ids, err := identities()
+		if err != nil {
+			return "", err
+		}
+
+		decrypted, err := decrypt(value, ids)
+		if err != nil {
+			return "", err
+		}
+
+		return string(decrypted), nil
+	case isFile(value):
+		path := strings.TrimSuffix(strings.TrimPrefix(value, filePrefix), referenceSuffix)
+
+		contents, err := os.ReadFile(path)
+		if err != nil {
+			return "", fmt.Errorf("error reading secret: %w", err)
+		}
+
+		return strings.TrimRight(string(contents), "\r\n"), nil
+	default:
+		return value, nil
+	}
+}
+
+func decrypt(value string, identities []age.Identity) ([]byte, error) {
+	if len(identities) == 0 {
+		return nil, ErrNoIdentity
+	}
+
+	payload, err := base64.StdEncoding.DecodeString(strings.TrimSuffix(strings.TrimPrefix(value, encryptedPrefix), referenceSuffix))
+	if err != nil {
+		return nil, fmt.Errorf("malformed encrypted secret: %w", err)
+	}
+
+	r, err := age.Decrypt(bytes.NewReader(payload), identities...)
+	if err != nil {
+		var noMatch *age.NoIdentityMatchError
+
+		if errors.As(err, &noMatch) {
+			return nil, ErrNoIdentity
+		}
+
+		return nil, fmt.Errorf("malformed encrypted secret: %w", err)
+	}
+
+	decrypted, err := io.ReadAll(r)
+	if err != nil {
+		return nil, fmt.Errorf("malformed encrypted secret: %w", err)
+	}
+
+	return decrypted, nil
+}
@@ -0,0 +1,198 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package secretref_test
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"filippo.io/age"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/siderolabs/talos/pkg/machinery/config/secretref"
+)
+
+func identities(ids ...age.Identity) secretref.IdentitiesFunc {
+	return func() ([]age.Identity, error) {
+		return ids, nil
+	}
+}
+
+func TestEncryptResolve(t *testing.T) {
+	t.Parallel()
+
+	alice, err := age.GenerateX25519Identity()
+	require.NoError(t, err)
+
+	bob, err := age.GenerateX25519Identity()
+	require.NoError(t, err)
+
+	eve, err := age.GenerateX25519Identity()
+	require.NoError(t, err)
+
+	parsed, err := secretref.ParseIdentities(secretref.FormatIdentity(alice))
+	require.NoError(t, err)
+	assert.Equal(t, []age.Identity{alice}, parsed)
+
+	recipients, err := secretref.ParseRecipients([]string{alice.Recipient().String(), bob.Recipient().String()})
+	require.NoError(t, err)
+
+	ref, err := secretref.Encrypt([]byte("s3cr3t"), recipients...)
+	require.NoError(t, err)
+	assert.True(t, secretref.IsReference(ref))
+
+	for _, id := range []age.Identity{alice, bob} {
+		value, err := secretref.Resolve(ref, identities(eve, id))
+		require.NoError(t, err)
+		assert.Equal(t, "s3cr3t", value)
+	}
+
+	_, err = secretref.Resolve(ref, identities(eve))
+	assert.ErrorIs(t, err, secretref.ErrNoIdentity)
+
+	value, err := secretref.Resolve("plain", identities())
+	require.NoError(t, err)
+	assert.Equal(t, "plain", value)
+
+	path := filepath.Join(t.TempDir(), "token")
+	require.NoError(t, os.WriteFile(path, []byte("t0ken\n"), 0o600))
+
+	value, err = secretref.Resolve(secretref.FileReference(path), identities())
+	require.NoError(t, err)
+	assert.Equal(t, "t0ken", value)
+
+	_, err = secretref.ParseRecipients([]string{alice.String()})
+	assert.Error(t, err)
+}
+
+func TestEncryptResolveYAML(t *testing.T) {
+	t.Parallel()
+
+	identity, err := age.GenerateX25519Identity()
+	require.NoError(t, err)
+
+	input := []byte(`secrets:
+    bootstraptoken: abcdef.0123456789abcdef
+certs:
+    etcd:
+        crt: Y2VydA==
+        key: a2V5
+`)
+
+	encrypted, err := secretref.EncryptYAML(input, []age.Recipient{identity.Recipient()}, func(path secretref.Path) bool {
+		return path[len(path)-1] != "crt"
+	})
+	require.NoError(t, err)
+	assert.NotContains(t, string(encrypted), "abcdef.0123456789abcdef")
+	assert.NotContains(t, string(encrypted), "a2V5")
+	assert.Contains(t, string(encrypted), "crt: Y2VydA==")
+
+	resolved, references, err := secretref.ResolveYAML(encrypted, identities(identity))
+	require.NoError(t, err)
+	assert.Equal(t, string(input), string(resolved))
+	require.Len(t, references, 2)
+	assert.Equal(t, secretref.Path{"secrets", "bootstraptoken"}, references[0].Path)
+	assert.Equal(t, "abcdef.0123456789abcdef", references[0].Value)
+	assert.Equal(t, secretref.Path{"certs", "etcd", "key"}, references[1].Path)
+	assert.Equal(t, "a2V5", references[1].Value)
+
+	restored, err := secretref.RestoreYAML(resolved, references)
+	require.NoError(t, err)
+	assert.Equal(t, string(encrypted), string(restored))
+
+	// the changed values are kept as is
+	restored, err = secretref.RestoreYAML(bytes.ReplaceAll(resolved, []byte("a2V5"), []byte("bmV3")), references)
+	require.NoError(t, err)
+	assert.Contains(t, string(restored), "key: bmV3")
+	assert.Contains(t, string(restored), "bootstraptoken: "+references[0].Reference)
+
+	_, _, err = secretref.ResolveYAML(encrypted, identities())
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "error resolving secret at .secrets.bootstraptoken")
+
+	resolved, references, err = secretref.ResolveYAML(input, nil)
+	require.NoError(t, err)
+	assert.Equal(t, input, resolved)
+	assert.Empty(t, references)
+}
+
+func TestRestoreYAMLDocuments(t *testing.T) {
+	t.Parallel()
+
+	input := []byte(`version: v1alpha1
+machine:
+    token: FILE[/token]
+---
+kind: RegistryMirrorConfig
+version: v1alpha1
+spec:
+    name: docker.io
+    endpoints:
+        - FILE[/endpoint]
+---
+kind: RegistryMirrorConfig
+version: v1alpha1
+spec:
+    name: ghcr.io
+    endpoints:
+        - https://secret@registry.local
+`)
+
+	references := []secretref.Reference{
+		{Path: secretref.Path{"machine", "token"}, Reference: "FILE[/token]", Value: "t0ken"},
+		{Kind: "RegistryMirrorConfig", Name: "docker.io", Path: secretref.Path{"spec", "endpoints", "0"}, Reference: "FILE[/endpoint]", Value: "https://secret@registry.local"},
+	}
+
+	resolved := bytes.ReplaceAll(bytes.ReplaceAll(input, []byte("FILE[/token]"), []byte("t0ken")), []byte("FILE[/endpoint]"), []byte("https://secret@registry.local"))
+
+	restored, err := secretref.RestoreYAML(resolved, references)
+	require.NoError(t, err)
+
+	// the document with another name keeps the value
+	assert.Equal(t, string(input), string(restored))
+}
+
+func TestMaskYAML(t *testing.T) {
+	t.Parallel()
+
+	input := []byte(`machine:
+    token: FILE[/missing]
+    key: ENC[AGE,bm90IGVuY3J5cHRlZA==]
+`)
+
+	masked, references, err := secretref.MaskYAML(input)
+	require.NoError(t, err)
+	assert.Equal(t, "machine:\n    token: "+secretref.MaskedValue+"\n    key: "+secretref.MaskedValue+"\n", string(masked))
+	require.Len(t, references, 2)
+	assert.Equal(t, "FILE[/missing]", references[0].Reference)
+	assert.Equal(t, secretref.MaskedValue, references[0].Value)
+
+	restored, err := secretref.RestoreYAML(masked, references)
+	require.NoError(t, err)
+	assert.Equal(t, string(input), string(restored))
+}
+
+func TestRestoreValuesYAML(t *testing.T) {
+	t.Parallel()
+
+	references := []secretref.Reference{
+		{Path: secretref.Path{"certs", "os", "key"}, Reference: "ENC[AGE,a2V5]", Value: "a2V5"},
+	}
+
+	restored, err := secretref.RestoreValuesYAML([]byte(`machine:
+    ca:
+        crt: Y2VydA==
+        key: a2V5
+`), references)
+	require.NoError(t, err)
+	assert.Equal(t, `machine:
+    ca:
+        crt: Y2VydA==
+        key: ENC[AGE,a2V5]
+`, string(restored))
+}
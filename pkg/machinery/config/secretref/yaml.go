@@ -0,0 +1,347 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package secretref
+
+import (
+	"bytes"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+
+	"filippo.io/age"
+	"gopkg.in/yaml.v3"
+)
+
+// Path is the path to the YAML field, map keys and list indexes.
+type Path []string
+
+// String implements fmt.Stringer.
+func (p Path) String() string {
+	return "." + strings.Join(p, ".")
+}
+
+func (p Path) equal(other Path) bool {
+	if len(p) != len(other) {
+		return false
+	}
+
+	for i := range p {
+		if p[i] != other[i] {
+			return false
+		}
+	}
+
+	return true
+}
+
+// Reference describes the resolved secret reference.
+type Reference struct {
+	// Kind of the document, empty for the v1alpha1 config document.
+	Kind string
+	// Name of the document (spec.name), empty for the documents without the name.
+	Name string
+	Path Path
+	// Reference is the original reference, e.g. ENC[AGE,...].
+	Reference string
+	// Value is the resolved secret value.
+	Value string
+}
+
+// MaskedValue replaces the references which are not resolved.
+//
+// It is the base64 encoding of 32 zero bytes, so that it is decoded by both the string and the bytes fields,
+// and passes the validation of the keys (e.g. WireGuard private key).
+const MaskedValue = "AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA="
+
+// ResolveYAML resolves all references in the (multi-document) YAML input.
+//
+// The input is returned as is if it doesn't contain any references.
+func ResolveYAML(data []byte, identities IdentitiesFunc) ([]byte, []Reference, error) {
+	// load the identities at most once
+	var (
+		loaded     []age.Identity
+		loadingErr error
+		loadedOnce bool
+	)
+
+	cachedIdentities := func() ([]age.Identity, error) {
+		if !loadedOnce {
+			loaded, loadingErr = identities()
+			loadedOnce = true
+		}
+
+		return loaded, loadingErr
+	}
+
+	return replaceYAML(data, func(reference string) (string, error) {
+		return Resolve(reference, cachedIdentities)
+	})
+}
+
+// MaskYAML replaces all references in the (multi-document) YAML input with the MaskedValue without resolving them,
+// so that the input can be decoded without the secret key, and the local files are not read.
+//
+// The input is returned as is if it doesn't contain any references.
+func MaskYAML(data []byte) ([]byte, []Reference, error) {
+	return replaceYAML(data, func(string) (string, error) {
+		return MaskedValue, nil
+	})
+}
+
+func replaceYAML(data []byte, replace func(reference string) (string, error)) ([]byte, []Reference, error) {
+	if !bytes.Contains(data, []byte(encryptedPrefix)) && !bytes.Contains(data, []byte(filePrefix)) {
+		return data, nil, nil
+	}
+
+	var references []Reference
+
+	out, err := transformYAML(data, func(doc *yaml.Node) error {
+		kind, name := documentKind(doc), documentName(doc)
+
+		return walk(doc, nil, func(path Path, node *yaml.Node) error {
+			if !IsReference(node.Value) {
+				return nil
+			}
+
+			value, err := replace(node.Value)
+			if err != nil {
+				return fmt.Errorf("error resolving secret at %s: %w", path, err)
+			}
+
+			references = append(references, Reference{
+				Kind:      kind,
+				Name:      name,
+				Path:      path,
+				Reference: node.Value,
+				Value:     value,
+			})
+
+			node.Value = value
+			node.Tag = "!!str"
+			node.Style = 0
+
+			return nil
+		})
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return out, references, nil
+}
+
+// RestoreYAML puts the references back in place of the resolved values in the (multi-document) YAML input,
+// so that the re-encoded configuration doesn't contain the plaintext secrets.
+//
+// The reference is restored only if the field still holds the resolved value, the changed values are kept as is.
+func RestoreYAML(data []byte, references []Reference) ([]byte, error) {
+	if len(references) == 0 {
+		return data, nil
+	}
+
+	return transformYAML(data, func(doc *yaml.Node) error {
+		kind, name := documentKind(doc), documentName(doc)
+
+		return walk(doc, nil, func(path Path, node *yaml.Node) error {
+			for _, ref := range references {
+				if ref.Kind != kind || ref.Name != name || !ref.Path.equal(path) || !sameValue(node.Value, ref.Value) {
+					continue
+				}
+
+				node.Value = ref.Reference
+				node.Tag = "!!str"
+				node.Style = 0
+
+				break
+			}
+
+			return nil
+		})
+	})
+}
+
+// RestoreValuesYAML puts the references back in place of the resolved values wherever they are found in the
+// (multi-document) YAML input, regardless of the path.
+//
+// It is used when the resolved values are copied to the other fields, e.g. from the secrets bundle to the generated configuration.
+func RestoreValuesYAML(data []byte, references []Reference) ([]byte, error) {
+	if len(references) == 0 {
+		return data, nil
+	}
+
+	return transformYAML(data, func(doc *yaml.Node) error {
+		return walk(doc, nil, func(_ Path, node *yaml.Node) error {
+			if node.Value == "" {
+				return nil
+			}
+
+			for _, ref := range references {
+				if !sameValue(node.Value, ref.Value) {
+					continue
+				}
+
+				node.Value = ref.Reference
+				node.Tag = "!!str"
+				node.Style = 0
+
+				break
+			}
+
+			return nil
+		})
+	})
+}
+
+// sameValue compares the values, the bytes fields might be encoded back to the different base64 form.
+func sameValue(encoded, resolved string) bool {
+	if encoded == resolved {
+		return true
+	}
+
+	a, err := base64.StdEncoding.DecodeString(encoded)
+	if err != nil {
+		return false
+	}
+
+	b, err := base64.StdEncoding.DecodeString(resolved)
+	if err != nil {
+		return false
+	}
+
+	return bytes.Equal(a, b)
+}
+
+// EncryptYAML encrypts the values of the fields which match the path for the recipients.
+//
+// Empty values and existing references are kept as is.
+func EncryptYAML(data []byte, recipients []age.Recipient, match func(Path) bool) ([]byte, error) {
+	return transformYAML(data, func(doc *yaml.Node) error {
+		return walk(doc, nil, func(path Path, node *yaml.Node) error {
+			if node.Value == "" || IsReference(node.Value) || !match(path) {
+				return nil
+			}
+
+			encrypted, err := Encrypt([]byte(node.Value), recipients...)
+			if err != nil {
+				return err
+			}
+
+			node.Value = encrypted
+			node.Tag = "!!str"
+			node.Style = 0
+
+			return nil
+		})
+	})
+}
+
+func transformYAML(data []byte, transform func(*yaml.Node) error) ([]byte, error) {
+	dec := yaml.NewDecoder(bytes.NewReader(data))
+
+	var buf bytes.Buffer
+
+	enc := yaml.NewEncoder(&buf)
+
+	for {
+		var doc yaml.Node
+
+		err := dec.Decode(&doc)
+		if errors.Is(err, io.EOF) {
+			break
+		}
+
+		if err != nil {
+			return nil, err
+		}
+
+		if err = transform(&doc); err != nil {
+			return nil, err
+		}
+
+		if err = enc.Encode(&doc); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := enc.Close(); err != nil {
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
+
+func documentKind(doc *yaml.Node) string {
+	if doc.Kind != yaml.DocumentNode || len(doc.Content) == 0 {
+		return ""
+	}
+
+	if kind := mappingValue(doc.Content[0], "kind"); kind != nil {
+		return kind.Value
+	}
+
+	return ""
+}
+
+func documentName(doc *yaml.Node) string {
+	if doc.Kind != yaml.DocumentNode || len(doc.Content) == 0 {
+		return ""
+	}
+
+	spec := mappingValue(doc.Content[0], "spec")
+	if spec == nil {
+		return ""
+	}
+
+	if name := mappingValue(spec, "name"); name != nil && name.Kind == yaml.ScalarNode {
+		return name.Value
+	}
+
+	return ""
+}
+
+func mappingValue(node *yaml.Node, key string) *yaml.Node {
+	if node.Kind != yaml.MappingNode {
+		return nil
+	}
+
+	for i := 0; i+1 < len(node.Content); i += 2 {
+		if node.Content[i].Value == key {
+			return node.Content[i+1]
+		}
+	}
+
+	return nil
+}
+
+// walk calls the function for each scalar value.
+func walk(node *yaml.Node, path Path, f func(Path, *yaml.Node) error) error {
+	switch node.Kind { //nolint:exhaustive
+	case yaml.DocumentNode:
+		for _, child := range node.Content {
+			if err := walk(child, path, f); err != nil {
+				return err
+			}
+		}
+	case yaml.MappingNode:
+		for i := 0; i+1 < len(node.Content); i += 2 {
+			if err := walk(node.Content[i+1], append(path[:len(path):len(path)], node.Content[i].Value), f); err != nil {
+				return err
+			}
+		}
+	case yaml.SequenceNode:
+		for i, child := range node.Content {
+			if err := walk(child, append(path[:len(path):len(path)], strconv.Itoa(i)), f); err != nil {
+				return err
+			}
+		}
+	case yaml.ScalarNode:
+		return f(path, node)
+	}
+
+	return nil
+}
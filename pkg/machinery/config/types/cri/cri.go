@@ -0,0 +1,118 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+/*
+Package cri provides the standalone container runtime configuration documents.
+
+The documents are loaded next to the v1alpha1 configuration:
+
+	kind: RegistryMirrorConfig
+	version: v1alpha1
+	spec:
+	  name: docker.io
+	  endpoints:
+	    - https://registry.local
+*/
+package cri
+
+//go:generate docgen ./cri.go ./cri_doc.go CRI
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/siderolabs/talos/pkg/machinery/config"
+	"github.com/siderolabs/talos/pkg/machinery/config/types/v1alpha1"
+)
+
+// RegistryMirrorConfigKind is the kind of the RegistryMirrorConfigV1Alpha1 document.
+const RegistryMirrorConfigKind = "RegistryMirrorConfig"
+
+func init() {
+	config.Register(RegistryMirrorConfigKind, func(version string) interface{} {
+		switch version {
+		case "v1alpha1":
+			return &RegistryMirrorConfigV1Alpha1{}
+		default:
+			return nil
+		}
+	})
+}
+
+// Check interfaces.
+var _ v1alpha1.Document = &RegistryMirrorConfigV1Alpha1{}
+
+var (
+	// Examples section.
+
+	registryMirrorConfigExample = &RegistryMirrorConfigV1Alpha1{
+		MirrorName:      "docker.io",
+		MirrorEndpoints: []string{"https://registry.local"},
+	}
+)
+
+// RegistryMirrorConfigV1Alpha1 configures an image registry mirror outside of the v1alpha1 configuration.
+//
+//	examples:
+//	  - value: registryMirrorConfigExample
+type RegistryMirrorConfigV1Alpha1 struct {
+	//   description: |
+	//     The registry host namespace to mirror, e.g. `docker.io`, or `*` for the fallback mirror.
+	//
+	//     The registry must not be configured in `.machine.registries.mirrors`.
+	//   examples:
+	//     - value: '"docker.io"'
+	MirrorName string `yaml:"name"`
+	//   description: |
+	//     List of endpoints (URLs) for registry mirrors to use.
+	//     Endpoint configures HTTP/HTTPS access mode, host name,
+	//     port and path (if path is not set, it defaults to `/v2`).
+	//   examples:
+	//     - value: '[]string{"https://registry.local"}'
+	MirrorEndpoints []string `yaml:"endpoints"`
+	//   description: |
+	//     Use the exact path specified for the endpoint (don't append /v2/).
+	MirrorOverridePath *bool `yaml:"overridePath,omitempty"`
+}
+
+// Kind implements the v1alpha1.Document interface.
+func (c *RegistryMirrorConfigV1Alpha1) Kind() string {
+	return RegistryMirrorConfigKind
+}
+
+// Version implements the v1alpha1.Document interface.
+func (c *RegistryMirrorConfigV1Alpha1) Version() string {
+	return "v1alpha1"
+}
+
+// Name implements the v1alpha1.Document interface.
+func (c *RegistryMirrorConfigV1Alpha1) Name() string {
+	return c.MirrorName
+}
+
+// MergeInto implements the v1alpha1.Document interface.
+func (c *RegistryMirrorConfigV1Alpha1) MergeInto(cfg *v1alpha1.Config) error {
+	if c.MirrorName == "" {
+		return errors.New("registry name is required")
+	}
+
+	if cfg.MachineConfig == nil {
+		cfg.MachineConfig = &v1alpha1.MachineConfig{}
+	}
+
+	if _, ok := cfg.MachineConfig.MachineRegistries.RegistryMirrors[c.MirrorName]; ok {
+		return fmt.Errorf("registry %q is already configured in .machine.registries.mirrors", c.MirrorName)
+	}
+
+	if cfg.MachineConfig.MachineRegistries.RegistryMirrors == nil {
+		cfg.MachineConfig.MachineRegistries.RegistryMirrors = map[string]*v1alpha1.RegistryMirrorConfig{}
+	}
+
+	cfg.MachineConfig.MachineRegistries.RegistryMirrors[c.MirrorName] = &v1alpha1.RegistryMirrorConfig{
+		MirrorEndpoints:    c.MirrorEndpoints,
+		MirrorOverridePath: c.MirrorOverridePath,
+	}
+
+	return nil
+}
@@ -0,0 +1,56 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+// Code generated by hack/docgen tool. DO NOT EDIT.
+
+package cri
+
+import (
+	"github.com/siderolabs/talos/pkg/machinery/config/encoder"
+)
+
+var RegistryMirrorConfigV1Alpha1Doc encoder.Doc
+
+func init() {
+	RegistryMirrorConfigV1Alpha1Doc.Type = "RegistryMirrorConfigV1Alpha1"
+	RegistryMirrorConfigV1Alpha1Doc.Comments[encoder.LineComment] = "RegistryMirrorConfigV1Alpha1 configures an image registry mirror outside of the v1alpha1 configuration."
+	RegistryMirrorConfigV1Alpha1Doc.Description = "RegistryMirrorConfigV1Alpha1 configures an image registry mirror outside of the v1alpha1 configuration."
+
+	RegistryMirrorConfigV1Alpha1Doc.AddExample("", registryMirrorConfigExample)
+	RegistryMirrorConfigV1Alpha1Doc.Fields = make([]encoder.Doc, 3)
+	RegistryMirrorConfigV1Alpha1Doc.Fields[0].Name = "name"
+	RegistryMirrorConfigV1Alpha1Doc.Fields[0].Type = "string"
+	RegistryMirrorConfigV1Alpha1Doc.Fields[0].Note = ""
+	RegistryMirrorConfigV1Alpha1Doc.Fields[0].Description = "The registry host namespace to mirror, e.g. `docker.io`, or `*` for the fallback mirror.\n\nThe registry must not be configured in `.machine.registries.mirrors`."
+	RegistryMirrorConfigV1Alpha1Doc.Fields[0].Comments[encoder.LineComment] = "The registry host namespace to mirror, e.g. `docker.io`, or `*` for the fallback mirror."
+
+	RegistryMirrorConfigV1Alpha1Doc.Fields[0].AddExample("", "docker.io")
+	RegistryMirrorConfigV1Alpha1Doc.Fields[1].Name = "endpoints"
+	RegistryMirrorConfigV1Alpha1Doc.Fields[1].Type = "[]string"
+	RegistryMirrorConfigV1Alpha1Doc.Fields[1].Note = ""
+	RegistryMirrorConfigV1Alpha1Doc.Fields[1].Description = "List of endpoints (URLs) for registry mirrors to use.\nEndpoint configures HTTP/HTTPS access mode, host name,\nport and path (if path is not set, it defaults to `/v2`)."
+	RegistryMirrorConfigV1Alpha1Doc.Fields[1].Comments[encoder.LineComment] = "List of endpoints (URLs) for registry mirrors to use."
+
+	RegistryMirrorConfigV1Alpha1Doc.Fields[1].AddExample("", []string{"https://registry.local"})
+	RegistryMirrorConfigV1Alpha1Doc.Fields[2].Name = "overridePath"
+	RegistryMirrorConfigV1Alpha1Doc.Fields[2].Type = "bool"
+	RegistryMirrorConfigV1Alpha1Doc.Fields[2].Note = ""
+	RegistryMirrorConfigV1Alpha1Doc.Fields[2].Description = "Use the exact path specified for the endpoint (don't append /v2/)."
+	RegistryMirrorConfigV1Alpha1Doc.Fields[2].Comments[encoder.LineComment] = "Use the exact path specified for the endpoint (don't append /v2/)."
+}
+
+func (_ RegistryMirrorConfigV1Alpha1) Doc() *encoder.Doc {
+	return &RegistryMirrorConfigV1Alpha1Doc
+}
+
+// GetCRIDoc returns documentation for the file ./cri_doc.go.
+func GetCRIDoc() *encoder.FileDoc {
+	return &encoder.FileDoc{
+		Name:        "CRI",
+		Description: "Package cri provides the standalone container runtime configuration documents.\n\nThe documents are loaded next to the v1alpha1 configuration:\n\n	kind: RegistryMirrorConfig\n	version: v1alpha1\n	spec:\n	  name: docker.io\n	  endpoints:\n	    - https://registry.local\n",
+		Structs: []*encoder.Doc{
+			&RegistryMirrorConfigV1Alpha1Doc,
+		},
+	}
+}
@@ -0,0 +1,116 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+/*
+Package kubespan provides the standalone KubeSpan configuration document.
+
+The document is loaded next to the v1alpha1 configuration:
+
+	kind: KubeSpanConfig
+	version: v1alpha1
+	spec:
+	  enabled: true
+*/
+package kubespan
+
+//go:generate docgen ./kubespan.go ./kubespan_doc.go KubeSpan
+
+import (
+	"errors"
+
+	"github.com/siderolabs/go-pointer"
+
+	"github.com/siderolabs/talos/pkg/machinery/config"
+	"github.com/siderolabs/talos/pkg/machinery/config/types/v1alpha1"
+)
+
+// ConfigKind is the kind of the ConfigV1Alpha1 document.
+const ConfigKind = "KubeSpanConfig"
+
+func init() {
+	config.Register(ConfigKind, func(version string) interface{} {
+		switch version {
+		case "v1alpha1":
+			return &ConfigV1Alpha1{}
+		default:
+			return nil
+		}
+	})
+}
+
+// Check interfaces.
+var _ v1alpha1.Document = &ConfigV1Alpha1{}
+
+var (
+	// Examples section.
+
+	configExample = &ConfigV1Alpha1{
+		ConfigEnabled:             pointer.To(true),
+		ConfigAllowDownPeerBypass: pointer.To(true),
+	}
+)
+
+// ConfigV1Alpha1 configures KubeSpan outside of the v1alpha1 configuration.
+//
+//	examples:
+//	  - value: configExample
+type ConfigV1Alpha1 struct {
+	//   description: |
+	//     Enable the KubeSpan feature.
+	//     Cluster discovery should be enabled with .cluster.discovery.enabled for KubeSpan to be enabled.
+	ConfigEnabled *bool `yaml:"enabled,omitempty"`
+	//   description: |
+	//     Control whether Kubernetes pod CIDRs are announced over KubeSpan from the node.
+	ConfigAdvertiseKubernetesNetworks *bool `yaml:"advertiseKubernetesNetworks,omitempty"`
+	//   description: |
+	//     Skip sending traffic via KubeSpan if the peer connection state is not up.
+	ConfigAllowDownPeerBypass *bool `yaml:"allowDownPeerBypass,omitempty"`
+	//   description: |
+	//     KubeSpan link MTU size.
+	//     Default value is 1420.
+	ConfigMTU *uint32 `yaml:"mtu,omitempty"`
+	//   description: |
+	//     KubeSpan advanced filtering of network addresses.
+	ConfigFilters *v1alpha1.KubeSpanFilters `yaml:"filters,omitempty"`
+}
+
+// Kind implements the v1alpha1.Document interface.
+func (c *ConfigV1Alpha1) Kind() string {
+	return ConfigKind
+}
+
+// Version implements the v1alpha1.Document interface.
+func (c *ConfigV1Alpha1) Version() string {
+	return "v1alpha1"
+}
+
+// Name implements the v1alpha1.Document interface.
+func (c *ConfigV1Alpha1) Name() string {
+	return ""
+}
+
+// MergeInto implements the v1alpha1.Document interface.
+func (c *ConfigV1Alpha1) MergeInto(cfg *v1alpha1.Config) error {
+	if cfg.MachineConfig == nil {
+		cfg.MachineConfig = &v1alpha1.MachineConfig{}
+	}
+
+	if cfg.MachineConfig.MachineNetwork == nil {
+		cfg.MachineConfig.MachineNetwork = &v1alpha1.NetworkConfig{}
+	}
+
+	if cfg.MachineConfig.MachineNetwork.NetworkKubeSpan != nil {
+		return errors.New("KubeSpan is already configured in .machine.network.kubespan")
+	}
+
+	cfg.MachineConfig.MachineNetwork.NetworkKubeSpan = &v1alpha1.NetworkKubeSpan{
+		KubeSpanEnabled:                     c.ConfigEnabled,
+		KubeSpanAdvertiseKubernetesNetworks: c.ConfigAdvertiseKubernetesNetworks,
+		KubeSpanAllowDownPeerBypass:         c.ConfigAllowDownPeerBypass,
+		KubeSpanMTU:                         c.ConfigMTU,
+		KubeSpanFilters:                     c.ConfigFilters,
+	}
+
+	return nil
+}
@@ -0,0 +1,242 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+/*
+Package network provides the standalone network configuration documents.
+
+The documents are loaded next to the v1alpha1 configuration:
+
+	kind: NetworkLinkConfig
+	version: v1alpha1
+	spec:
+	  name: eth1
+	  addresses:
+	    - 192.168.10.2/24
+*/
+package network
+
+//go:generate docgen ./network.go ./network_doc.go Network
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/siderolabs/talos/pkg/machinery/config"
+	"github.com/siderolabs/talos/pkg/machinery/config/types/v1alpha1"
+)
+
+// LinkConfigKind is the kind of the LinkConfigV1Alpha1 document.
+const LinkConfigKind = "NetworkLinkConfig"
+
+// RuleConfigKind is the kind of the RuleConfigV1Alpha1 document.
+const RuleConfigKind = "NetworkRuleConfig"
+
+func init() {
+	config.Register(LinkConfigKind, func(version string) interface{} {
+		switch version {
+		case "v1alpha1":
+			return &LinkConfigV1Alpha1{}
+		default:
+			return nil
+		}
+	})
+
+	config.Register(RuleConfigKind, func(version string) interface{} {
+		switch version {
+		case "v1alpha1":
+			return &RuleConfigV1Alpha1{}
+		default:
+			return nil
+		}
+	})
+}
+
+// Check interfaces.
+var (
+	_ v1alpha1.Document = &LinkConfigV1Alpha1{}
+	_ v1alpha1.Document = &RuleConfigV1Alpha1{}
+)
+
+var (
+	// Examples section.
+
+	linkConfigExample = &LinkConfigV1Alpha1{
+		LinkName:      "eth1",
+		LinkAddresses: []string{"192.168.10.2/24"},
+		LinkRoutes: []*v1alpha1.Route{
+			{
+				RouteNetwork: "10.0.0.0/8",
+				RouteGateway: "192.168.10.1",
+			},
+		},
+		LinkMTU: 9000,
+	}
+
+	ruleConfigExample = &RuleConfigV1Alpha1{
+		RuleName: "ingress-etcd",
+		RulePortSelector: v1alpha1.NetworkRulePortSelector{
+			PortSelectorPorts:    []string{"2379-2380"},
+			PortSelectorProtocol: "tcp",
+		},
+		RuleIngress: []v1alpha1.NetworkRuleIngress{
+			{
+				IngressSubnet: "192.168.10.0/24",
+			},
+		},
+	}
+)
+
+// LinkConfigV1Alpha1 configures a network link (interface) outside of the v1alpha1 configuration.
+//
+//	examples:
+//	  - value: linkConfigExample
+type LinkConfigV1Alpha1 struct {
+	//   description: |
+	//     The name of the link (interface).
+	//
+	//     The link must not be configured in `.machine.network.interfaces`.
+	//   examples:
+	//     - value: '"eth1"'
+	LinkName string `yaml:"name"`
+	//   description: |
+	//     The addresses in CIDR notation or as plain IPs to use.
+	//   examples:
+	//     - value: '[]string{"192.168.10.2/24"}'
+	LinkAddresses []string `yaml:"addresses,omitempty"`
+	//   description: |
+	//     A list of routes associated with the link.
+	LinkRoutes []*v1alpha1.Route `yaml:"routes,omitempty"`
+	//   description: |
+	//     The link's MTU.
+	//     If not specified, the system default is used.
+	LinkMTU int `yaml:"mtu,omitempty"`
+	//   description: |
+	//     Indicates if DHCP should be used to configure the link.
+	LinkDHCP *bool `yaml:"dhcp,omitempty"`
+	//   description: |
+	//     VLANs created on top of the link.
+	LinkVLANs v1alpha1.VlanList `yaml:"vlans,omitempty"`
+}
+
+// Kind implements the v1alpha1.Document interface.
+func (c *LinkConfigV1Alpha1) Kind() string {
+	return LinkConfigKind
+}
+
+// Version implements the v1alpha1.Document interface.
+func (c *LinkConfigV1Alpha1) Version() string {
+	return "v1alpha1"
+}
+
+// Name implements the v1alpha1.Document interface.
+func (c *LinkConfigV1Alpha1) Name() string {
+	return c.LinkName
+}
+
+// MergeInto implements the v1alpha1.Document interface.
+func (c *LinkConfigV1Alpha1) MergeInto(cfg *v1alpha1.Config) error {
+	if c.LinkName == "" {
+		return errors.New("link name is required")
+	}
+
+	if cfg.MachineConfig == nil {
+		cfg.MachineConfig = &v1alpha1.MachineConfig{}
+	}
+
+	if cfg.MachineConfig.MachineNetwork == nil {
+		cfg.MachineConfig.MachineNetwork = &v1alpha1.NetworkConfig{}
+	}
+
+	for _, device := range cfg.MachineConfig.MachineNetwork.NetworkInterfaces {
+		if device.DeviceInterface == c.LinkName {
+			return fmt.Errorf("link %q is already configured in .machine.network.interfaces", c.LinkName)
+		}
+	}
+
+	device := &v1alpha1.Device{
+		DeviceInterface: c.LinkName,
+		DeviceAddresses: c.LinkAddresses,
+		DeviceRoutes:    c.LinkRoutes,
+		DeviceMTU:       c.LinkMTU,
+		DeviceDHCP:      c.LinkDHCP,
+		DeviceVlans:     c.LinkVLANs,
+	}
+
+	cfg.MachineConfig.MachineNetwork.NetworkInterfaces = append(cfg.MachineConfig.MachineNetwork.NetworkInterfaces, device)
+
+	return nil
+}
+
+// RuleConfigV1Alpha1 adds an ingress rule to the host firewall outside of the v1alpha1 configuration.
+//
+// The rule is appended to `.machine.network.firewall.rules`, so the firewall is enabled with the document.
+//
+//	examples:
+//	  - value: ruleConfigExample
+type RuleConfigV1Alpha1 struct {
+	//   description: |
+	//     Name of the rule.
+	//
+	//     The rule must not be configured in `.machine.network.firewall.rules`.
+	//   examples:
+	//     - value: '"ingress-etcd"'
+	RuleName string `yaml:"name"`
+	//   description: |
+	//     Ports and the protocol the rule applies to.
+	RulePortSelector v1alpha1.NetworkRulePortSelector `yaml:"portSelector"`
+	//   description: |
+	//     Subnets the traffic is accepted from.
+	//     If not set, the traffic is accepted from any address.
+	RuleIngress []v1alpha1.NetworkRuleIngress `yaml:"ingress,omitempty"`
+}
+
+// Kind implements the v1alpha1.Document interface.
+func (c *RuleConfigV1Alpha1) Kind() string {
+	return RuleConfigKind
+}
+
+// Version implements the v1alpha1.Document interface.
+func (c *RuleConfigV1Alpha1) Version() string {
+	return "v1alpha1"
+}
+
+// Name implements the v1alpha1.Document interface.
+func (c *RuleConfigV1Alpha1) Name() string {
+	return c.RuleName
+}
+
+// MergeInto implements the v1alpha1.Document interface.
+func (c *RuleConfigV1Alpha1) MergeInto(cfg *v1alpha1.Config) error {
+	if c.RuleName == "" {
+		return errors.New("rule name is required")
+	}
+
+	if cfg.MachineConfig == nil {
+		cfg.MachineConfig = &v1alpha1.MachineConfig{}
+	}
+
+	if cfg.MachineConfig.MachineNetwork == nil {
+		cfg.MachineConfig.MachineNetwork = &v1alpha1.NetworkConfig{}
+	}
+
+	if cfg.MachineConfig.MachineNetwork.NetworkFirewall == nil {
+		cfg.MachineConfig.MachineNetwork.NetworkFirewall = &v1alpha1.NetworkFirewallConfig{}
+	}
+
+	firewall := cfg.MachineConfig.MachineNetwork.NetworkFirewall
+
+	for _, rule := range firewall.FirewallRules {
+		if rule.RuleName == c.RuleName {
+			return fmt.Errorf("rule %q is already configured in .machine.network.firewall.rules", c.RuleName)
+		}
+	}
+
+	firewall.FirewallRules = append(firewall.FirewallRules, &v1alpha1.NetworkRule{
+		RuleName:         c.RuleName,
+		RulePortSelector: c.RulePortSelector,
+		RuleIngress:      c.RuleIngress,
+	})
+
+	return nil
+}
@@ -0,0 +1,101 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+// Code generated by hack/docgen tool. DO NOT EDIT.
+
+package network
+
+import (
+	"github.com/siderolabs/talos/pkg/machinery/config/encoder"
+)
+
+var (
+	LinkConfigV1Alpha1Doc encoder.Doc
+	RuleConfigV1Alpha1Doc encoder.Doc
+)
+
+func init() {
+	LinkConfigV1Alpha1Doc.Type = "LinkConfigV1Alpha1"
+	LinkConfigV1Alpha1Doc.Comments[encoder.LineComment] = "LinkConfigV1Alpha1 configures a network link (interface) outside of the v1alpha1 configuration."
+	LinkConfigV1Alpha1Doc.Description = "LinkConfigV1Alpha1 configures a network link (interface) outside of the v1alpha1 configuration."
+
+	LinkConfigV1Alpha1Doc.AddExample("", linkConfigExample)
+	LinkConfigV1Alpha1Doc.Fields = make([]encoder.Doc, 6)
+	LinkConfigV1Alpha1Doc.Fields[0].Name = "name"
+	LinkConfigV1Alpha1Doc.Fields[0].Type = "string"
+	LinkConfigV1Alpha1Doc.Fields[0].Note = ""
+	LinkConfigV1Alpha1Doc.Fields[0].Description = "The name of the link (interface).\n\nThe link must not be configured in `.machine.network.interfaces`."
+	LinkConfigV1Alpha1Doc.Fields[0].Comments[encoder.LineComment] = "The name of the link (interface)."
+
+	LinkConfigV1Alpha1Doc.Fields[0].AddExample("", "eth1")
+	LinkConfigV1Alpha1Doc.Fields[1].Name = "addresses"
+	LinkConfigV1Alpha1Doc.Fields[1].Type = "[]string"
+	LinkConfigV1Alpha1Doc.Fields[1].Note = ""
+	LinkConfigV1Alpha1Doc.Fields[1].Description = "The addresses in CIDR notation or as plain IPs to use."
+	LinkConfigV1Alpha1Doc.Fields[1].Comments[encoder.LineComment] = "The addresses in CIDR notation or as plain IPs to use."
+
+	LinkConfigV1Alpha1Doc.Fields[1].AddExample("", []string{"192.168.10.2/24"})
+	LinkConfigV1Alpha1Doc.Fields[2].Name = "routes"
+	LinkConfigV1Alpha1Doc.Fields[2].Type = "[]Route"
+	LinkConfigV1Alpha1Doc.Fields[2].Note = ""
+	LinkConfigV1Alpha1Doc.Fields[2].Description = "A list of routes associated with the link."
+	LinkConfigV1Alpha1Doc.Fields[2].Comments[encoder.LineComment] = "A list of routes associated with the link."
+	LinkConfigV1Alpha1Doc.Fields[3].Name = "mtu"
+	LinkConfigV1Alpha1Doc.Fields[3].Type = "int"
+	LinkConfigV1Alpha1Doc.Fields[3].Note = ""
+	LinkConfigV1Alpha1Doc.Fields[3].Description = "The link's MTU.\nIf not specified, the system default is used."
+	LinkConfigV1Alpha1Doc.Fields[3].Comments[encoder.LineComment] = "The link's MTU."
+	LinkConfigV1Alpha1Doc.Fields[4].Name = "dhcp"
+	LinkConfigV1Alpha1Doc.Fields[4].Type = "bool"
+	LinkConfigV1Alpha1Doc.Fields[4].Note = ""
+	LinkConfigV1Alpha1Doc.Fields[4].Description = "Indicates if DHCP should be used to configure the link."
+	LinkConfigV1Alpha1Doc.Fields[4].Comments[encoder.LineComment] = "Indicates if DHCP should be used to configure the link."
+	LinkConfigV1Alpha1Doc.Fields[5].Name = "vlans"
+	LinkConfigV1Alpha1Doc.Fields[5].Type = "VlanList"
+	LinkConfigV1Alpha1Doc.Fields[5].Note = ""
+	LinkConfigV1Alpha1Doc.Fields[5].Description = "VLANs created on top of the link."
+	LinkConfigV1Alpha1Doc.Fields[5].Comments[encoder.LineComment] = "VLANs created on top of the link."
+
+	RuleConfigV1Alpha1Doc.Type = "RuleConfigV1Alpha1"
+	RuleConfigV1Alpha1Doc.Comments[encoder.LineComment] = "RuleConfigV1Alpha1 adds an ingress rule to the host firewall outside of the v1alpha1 configuration."
+	RuleConfigV1Alpha1Doc.Description = "RuleConfigV1Alpha1 adds an ingress rule to the host firewall outside of the v1alpha1 configuration.\n\nThe rule is appended to `.machine.network.firewall.rules`, so the firewall is enabled with the document.\n\nexamples:\n  - value: ruleConfigExample\n"
+	RuleConfigV1Alpha1Doc.Fields = make([]encoder.Doc, 3)
+	RuleConfigV1Alpha1Doc.Fields[0].Name = "name"
+	RuleConfigV1Alpha1Doc.Fields[0].Type = "string"
+	RuleConfigV1Alpha1Doc.Fields[0].Note = ""
+	RuleConfigV1Alpha1Doc.Fields[0].Description = "Name of the rule.\n\nThe rule must not be configured in `.machine.network.firewall.rules`."
+	RuleConfigV1Alpha1Doc.Fields[0].Comments[encoder.LineComment] = "Name of the rule."
+
+	RuleConfigV1Alpha1Doc.Fields[0].AddExample("", "ingress-etcd")
+	RuleConfigV1Alpha1Doc.Fields[1].Name = "portSelector"
+	RuleConfigV1Alpha1Doc.Fields[1].Type = "NetworkRulePortSelector"
+	RuleConfigV1Alpha1Doc.Fields[1].Note = ""
+	RuleConfigV1Alpha1Doc.Fields[1].Description = "Ports and the protocol the rule applies to."
+	RuleConfigV1Alpha1Doc.Fields[1].Comments[encoder.LineComment] = "Ports and the protocol the rule applies to."
+	RuleConfigV1Alpha1Doc.Fields[2].Name = "ingress"
+	RuleConfigV1Alpha1Doc.Fields[2].Type = "[]NetworkRuleIngress"
+	RuleConfigV1Alpha1Doc.Fields[2].Note = ""
+	RuleConfigV1Alpha1Doc.Fields[2].Description = "Subnets the traffic is accepted from.\nIf not set, the traffic is accepted from any address."
+	RuleConfigV1Alpha1Doc.Fields[2].Comments[encoder.LineComment] = "Subnets the traffic is accepted from."
+}
+
+func (_ LinkConfigV1Alpha1) Doc() *encoder.Doc {
+	return &LinkConfigV1Alpha1Doc
+}
+
+func (_ RuleConfigV1Alpha1) Doc() *encoder.Doc {
+	return &RuleConfigV1Alpha1Doc
+}
+
+// GetNetworkDoc returns documentation for the file ./network_doc.go.
+func GetNetworkDoc() *encoder.FileDoc {
+	return &encoder.FileDoc{
+		Name:        "Network",
+		Description: "Package network provides the standalone network configuration documents.\n\nThe documents are loaded next to the v1alpha1 configuration:\n\n	kind: NetworkLinkConfig\n	version: v1alpha1\n	spec:\n	  name: eth1\n	  addresses:\n	    - 192.168.10.2/24\n",
+		Structs: []*encoder.Doc{
+			&LinkConfigV1Alpha1Doc,
+			&RuleConfigV1Alpha1Doc,
+		},
+	}
+}
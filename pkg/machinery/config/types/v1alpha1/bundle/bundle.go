@@ -101,6 +101,8 @@ func NewConfigBundle(opts ...Option) (*ConfigBundle, error) {
 		return bundle, err
 	}
 
+	bundle.SecretReferences = secrets.References
+
 	var input *generate.Input
 
 	input, err = generate.NewInput(
@@ -149,15 +151,15 @@ func NewConfigBundle(opts ...Option) (*ConfigBundle, error) {
 }
 
 func applyJSONPatches(bundle *ConfigBundle, options Options) error {
-	if err := bundle.ApplyPatches(options.Patches, true, true); err != nil {
+	if err := bundle.ApplyPatches(options.Patches, true, true, options.PatchOptions...); err != nil {
 		return fmt.Errorf("error patching configs: %w", err)
 	}
 
-	if err := bundle.ApplyPatches(options.PatchesControlPlane, true, false); err != nil {
+	if err := bundle.ApplyPatches(options.PatchesControlPlane, true, false, options.PatchOptions...); err != nil {
 		return fmt.Errorf("error patching control plane configs: %w", err)
 	}
 
-	if err := bundle.ApplyPatches(options.PatchesWorker, false, true); err != nil {
+	if err := bundle.ApplyPatches(options.PatchesWorker, false, true, options.PatchOptions...); err != nil {
 		return fmt.Errorf("error patching worker config: %w", err)
 	}
 
@@ -5,17 +5,22 @@
 package bundle_test
 
 import (
+	"os"
 	"path/filepath"
 	"testing"
 
+	"filippo.io/age"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"gopkg.in/yaml.v3"
 
 	"github.com/siderolabs/talos/pkg/machinery/config"
 	"github.com/siderolabs/talos/pkg/machinery/config/configloader"
 	"github.com/siderolabs/talos/pkg/machinery/config/configpatcher"
 	"github.com/siderolabs/talos/pkg/machinery/config/encoder"
+	"github.com/siderolabs/talos/pkg/machinery/config/secretref"
 	"github.com/siderolabs/talos/pkg/machinery/config/types/v1alpha1/bundle"
+	"github.com/siderolabs/talos/pkg/machinery/config/types/v1alpha1/generate"
 	"github.com/siderolabs/talos/pkg/machinery/config/types/v1alpha1/machine"
 )
 
@@ -59,3 +64,53 @@ func TestGenerateConfig(t *testing.T) {
 		assert.Equal(t, "foo", cfg.Cluster().Name())
 	}
 }
+
+func TestGenerateConfigWithEncryptedSecrets(t *testing.T) {
+	identity, err := age.GenerateX25519Identity()
+	require.NoError(t, err)
+
+	keyPath := filepath.Join(t.TempDir(), "secret.key")
+	require.NoError(t, os.WriteFile(keyPath, secretref.FormatIdentity(identity), 0o600))
+
+	t.Setenv(secretref.KeyFileEnvVar, keyPath)
+
+	secrets, err := generate.NewSecretsBundle(generate.NewClock())
+	require.NoError(t, err)
+
+	secretsBytes, err := yaml.Marshal(secrets)
+	require.NoError(t, err)
+
+	encrypted, err := secretref.EncryptYAML(secretsBytes, []age.Recipient{identity.Recipient()}, func(path secretref.Path) bool {
+		return path[len(path)-1] != "crt"
+	})
+	require.NoError(t, err)
+
+	secretsPath := filepath.Join(t.TempDir(), "secrets.yaml")
+	require.NoError(t, os.WriteFile(secretsPath, encrypted, 0o600))
+
+	configBundle, err := bundle.NewConfigBundle(bundle.WithInputOptions(
+		&bundle.InputOptions{
+			ClusterName: "test-cluster",
+			Endpoint:    "https://127.0.0.1:6443",
+			KubeVersion: "1.222.2",
+			GenOptions:  []generate.GenOption{generate.WithSecrets(secretsPath)},
+		},
+	))
+	require.NoError(t, err)
+
+	// the secrets are resolved to generate the configs
+	assert.Equal(t, secrets.Cluster.Secret, configBundle.ControlPlane().Cluster().Secret())
+
+	// ... but the serialized configs keep the references
+	data, err := configBundle.Serialize(encoder.CommentsDisabled, machine.TypeControlPlane)
+	require.NoError(t, err)
+
+	assert.NotContains(t, string(data), secrets.Cluster.Secret)
+	assert.NotContains(t, string(data), secrets.Secrets.BootstrapToken)
+	assert.Contains(t, string(data), "ENC[AGE,")
+
+	cfg, err := configloader.NewFromBytes(data, configloader.WithSecretReferences(secretref.DefaultIdentities))
+	require.NoError(t, err)
+
+	assert.Equal(t, secrets.Cluster.Secret, cfg.Cluster().Secret())
+}
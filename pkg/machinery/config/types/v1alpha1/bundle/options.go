@@ -31,6 +31,7 @@ type Options struct {
 	Patches             []configpatcher.Patch
 	PatchesControlPlane []configpatcher.Patch
 	PatchesWorker       []configpatcher.Patch
+	PatchOptions        []configpatcher.ApplyOption
 }
 
 // DefaultOptions returns default options.
@@ -126,3 +127,12 @@ func WithPatchWorker(patch []configpatcher.Patch) Option {
 		return nil
 	}
 }
+
+// WithPatchOptions sets the options used to match the selector patches.
+func WithPatchOptions(opts ...configpatcher.ApplyOption) Option {
+	return func(o *Options) error {
+		o.PatchOptions = append(o.PatchOptions, opts...)
+
+		return nil
+	}
+}
@@ -14,6 +14,7 @@ import (
 	"github.com/siderolabs/talos/pkg/machinery/config"
 	"github.com/siderolabs/talos/pkg/machinery/config/configpatcher"
 	"github.com/siderolabs/talos/pkg/machinery/config/encoder"
+	"github.com/siderolabs/talos/pkg/machinery/config/secretref"
 	"github.com/siderolabs/talos/pkg/machinery/config/types/v1alpha1"
 	"github.com/siderolabs/talos/pkg/machinery/config/types/v1alpha1/machine"
 )
@@ -26,6 +27,9 @@ type ConfigBundle struct {
 	ControlPlaneCfg *v1alpha1.Config
 	WorkerCfg       *v1alpha1.Config
 	TalosCfg        *clientconfig.Config
+
+	// SecretReferences are restored in place of the secrets in the serialized configs.
+	SecretReferences []secretref.Reference
 }
 
 // Init implements the ProviderBundle interface.
@@ -71,28 +75,39 @@ func (c *ConfigBundle) Write(outputDir string, commentsFlags encoder.CommentsFla
 
 // Serialize returns the config for the provided machine type as bytes.
 func (c *ConfigBundle) Serialize(commentsFlags encoder.CommentsFlags, machineType machine.Type) ([]byte, error) {
+	var cfg config.Provider
+
 	switch machineType {
 	case machine.TypeInit:
-		return c.Init().EncodeBytes(encoder.WithComments(commentsFlags))
+		cfg = c.Init()
 	case machine.TypeControlPlane:
-		return c.ControlPlane().EncodeBytes(encoder.WithComments(commentsFlags))
+		cfg = c.ControlPlane()
 	case machine.TypeWorker:
-		return c.Worker().EncodeBytes(encoder.WithComments(commentsFlags))
+		cfg = c.Worker()
 	case machine.TypeUnknown:
 		fallthrough
 	default:
 		return nil, fmt.Errorf("unexpected machine type %v", machineType)
 	}
+
+	data, err := cfg.EncodeBytes(encoder.WithComments(commentsFlags))
+	if err != nil {
+		return nil, err
+	}
+
+	return secretref.RestoreValuesYAML(data, c.SecretReferences)
 }
 
 // ApplyPatches patches every config type with a patch.
-func (c *ConfigBundle) ApplyPatches(patches []configpatcher.Patch, patchControlPlane, patchWorker bool) error {
+//
+// The options are used to match the selector patches.
+func (c *ConfigBundle) ApplyPatches(patches []configpatcher.Patch, patchControlPlane, patchWorker bool, opts ...configpatcher.ApplyOption) error {
 	if len(patches) == 0 {
 		return nil
 	}
 
 	apply := func(in *v1alpha1.Config) (*v1alpha1.Config, error) {
-		patched, err := configpatcher.Apply(configpatcher.WithConfig(in), patches)
+		patched, err := configpatcher.Apply(configpatcher.WithConfig(in), patches, opts...)
 		if err != nil {
 			return nil, err
 		}
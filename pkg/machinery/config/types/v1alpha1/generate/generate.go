@@ -27,6 +27,7 @@ import (
 
 	"github.com/siderolabs/talos/pkg/machinery/config"
 	"github.com/siderolabs/talos/pkg/machinery/config/internal/cis"
+	"github.com/siderolabs/talos/pkg/machinery/config/secretref"
 	v1alpha1 "github.com/siderolabs/talos/pkg/machinery/config/types/v1alpha1"
 	"github.com/siderolabs/talos/pkg/machinery/config/types/v1alpha1/machine"
 	"github.com/siderolabs/talos/pkg/machinery/constants"
@@ -165,6 +166,9 @@ type SecretsBundle struct {
 	Secrets    *Secrets    `json:"Secrets"`
 	TrustdInfo *TrustdInfo `json:"TrustdInfo"`
 	Certs      *Certs      `json:"Certs"`
+
+	// References are the secret references of the secrets bundle file.
+	References []secretref.Reference `yaml:"-" json:"-"`
 }
 
 // Clock system clock.
@@ -11,6 +11,7 @@ import (
 	"gopkg.in/yaml.v3"
 
 	"github.com/siderolabs/talos/pkg/machinery/config"
+	"github.com/siderolabs/talos/pkg/machinery/config/secretref"
 	v1alpha1 "github.com/siderolabs/talos/pkg/machinery/config/types/v1alpha1"
 	"github.com/siderolabs/talos/pkg/machinery/role"
 )
@@ -242,6 +243,9 @@ func WithSysctls(params map[string]string) GenOption {
 }
 
 // WithSecrets reads secrets from a provided file.
+//
+// The secret references in the file are resolved, and they are kept in the secrets bundle,
+// so that they are restored in place of the secrets in the generated configuration.
 func WithSecrets(file string) GenOption {
 	return func(o *GenOptions) error {
 		yamlBytes, err := os.ReadFile(file)
@@ -249,6 +253,13 @@ func WithSecrets(file string) GenOption {
 			return err
 		}
 
+		var references []secretref.Reference
+
+		yamlBytes, references, err = secretref.ResolveYAML(yamlBytes, secretref.DefaultIdentities)
+		if err != nil {
+			return err
+		}
+
 		var secrets SecretsBundle
 
 		err = yaml.Unmarshal(yamlBytes, &secrets)
@@ -257,6 +268,7 @@ func WithSecrets(file string) GenOption {
 		}
 
 		secrets.Clock = NewClock()
+		secrets.References = references
 
 		o.Secrets = &secrets
 
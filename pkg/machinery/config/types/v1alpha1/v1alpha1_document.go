@@ -0,0 +1,63 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package v1alpha1
+
+import (
+	"fmt"
+
+	"github.com/siderolabs/talos/pkg/machinery/config/encoder"
+)
+
+// Document is a standalone configuration document which is loaded next to the v1alpha1 configuration.
+//
+// Documents are kept separately from the v1alpha1 configuration, so that they survive encoding,
+// while the config.Provider accessors present a single view with the documents merged in.
+type Document interface {
+	// Kind returns the kind of the document, e.g. NetworkLinkConfig.
+	Kind() string
+	// Version returns the version of the document, e.g. v1alpha1.
+	Version() string
+	// Name returns the name of the document, empty for the documents which can appear only once.
+	Name() string
+	// MergeInto merges the document into the v1alpha1 configuration.
+	//
+	// MergeInto returns an error if the setting is already present in the configuration.
+	MergeInto(*Config) error
+}
+
+// documentManifest is the encoded form of the Document.
+//
+// +k8s:deepcopy-gen=false
+type documentManifest struct {
+	Kind    string   `yaml:"kind"`
+	Version string   `yaml:"version"`
+	Spec    Document `yaml:"spec"`
+}
+
+// EncodeDocument encodes the standalone document with the kind and version header.
+func EncodeDocument(document Document, options ...encoder.Option) ([]byte, error) {
+	return encoder.NewEncoder(&documentManifest{
+		Kind:    document.Kind(),
+		Version: document.Version(),
+		Spec:    document,
+	}, options...).Encode()
+}
+
+// MergeDocuments returns a copy of the v1alpha1 configuration with the documents merged in.
+func MergeDocuments(cfg *Config, documents []Document) (*Config, error) {
+	if len(documents) == 0 {
+		return cfg, nil
+	}
+
+	merged := cfg.DeepCopy()
+
+	for _, document := range documents {
+		if err := document.MergeInto(merged); err != nil {
+			return nil, fmt.Errorf("error merging %s document: %w", document.Kind(), err)
+		}
+	}
+
+	return merged, nil
+}
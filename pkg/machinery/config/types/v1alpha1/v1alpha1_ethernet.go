@@ -0,0 +1,126 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package v1alpha1
+
+import (
+	"errors"
+
+	"github.com/siderolabs/talos/pkg/machinery/config"
+)
+
+// Validate checks Ethernet configuration for errors.
+func (e *EthernetConfig) Validate() error {
+	switch {
+	case e.EthernetInterface == "" && e.EthernetDeviceSelector == nil:
+		return errors.New("either interface or deviceSelector should be set")
+	case e.EthernetInterface != "" && e.EthernetDeviceSelector != nil:
+		return errors.New("interface and deviceSelector are mutually exclusive")
+	case e.EthernetDeviceSelector != nil && *e.EthernetDeviceSelector == (NetworkDeviceSelector{}):
+		return errors.New("deviceSelector should have at least one field set")
+	}
+
+	return nil
+}
+
+// Interface implements config.EthernetConfig interface.
+func (e *EthernetConfig) Interface() string {
+	return e.EthernetInterface
+}
+
+// Selector implements config.EthernetConfig interface.
+func (e *EthernetConfig) Selector() config.NetworkDeviceSelector {
+	if e.EthernetDeviceSelector == nil {
+		return nil
+	}
+
+	return e.EthernetDeviceSelector
+}
+
+// Rings implements config.EthernetConfig interface.
+func (e *EthernetConfig) Rings() config.EthernetRings {
+	if e.EthernetRings == nil {
+		return &EthernetRingsConfig{}
+	}
+
+	return e.EthernetRings
+}
+
+// Channels implements config.EthernetConfig interface.
+func (e *EthernetConfig) Channels() config.EthernetChannels {
+	if e.EthernetChannels == nil {
+		return &EthernetChannelsConfig{}
+	}
+
+	return e.EthernetChannels
+}
+
+// Features implements config.EthernetConfig interface.
+func (e *EthernetConfig) Features() map[string]bool {
+	return e.EthernetFeatures
+}
+
+// Pause implements config.EthernetConfig interface.
+func (e *EthernetConfig) Pause() config.EthernetPause {
+	if e.EthernetPause == nil {
+		return &EthernetPauseConfig{}
+	}
+
+	return e.EthernetPause
+}
+
+// RX implements config.EthernetRings interface.
+func (r *EthernetRingsConfig) RX() *uint32 {
+	return r.RingsRX
+}
+
+// RXMini implements config.EthernetRings interface.
+func (r *EthernetRingsConfig) RXMini() *uint32 {
+	return r.RingsRXMini
+}
+
+// RXJumbo implements config.EthernetRings interface.
+func (r *EthernetRingsConfig) RXJumbo() *uint32 {
+	return r.RingsRXJumbo
+}
+
+// TX implements config.EthernetRings interface.
+func (r *EthernetRingsConfig) TX() *uint32 {
+	return r.RingsTX
+}
+
+// RX implements config.EthernetChannels interface.
+func (c *EthernetChannelsConfig) RX() *uint32 {
+	return c.ChannelsRX
+}
+
+// TX implements config.EthernetChannels interface.
+func (c *EthernetChannelsConfig) TX() *uint32 {
+	return c.ChannelsTX
+}
+
+// Other implements config.EthernetChannels interface.
+func (c *EthernetChannelsConfig) Other() *uint32 {
+	return c.ChannelsOther
+}
+
+// Combined implements config.EthernetChannels interface.
+func (c *EthernetChannelsConfig) Combined() *uint32 {
+	return c.ChannelsCombined
+}
+
+// Autonegotiate implements config.EthernetPause interface.
+func (p *EthernetPauseConfig) Autonegotiate() *bool {
+	return p.PauseAutonegotiate
+}
+
+// RX implements config.EthernetPause interface.
+func (p *EthernetPauseConfig) RX() *bool {
+	return p.PauseRX
+}
+
+// TX implements config.EthernetPause interface.
+func (p *EthernetPauseConfig) TX() *bool {
+	return p.PauseTX
+}
@@ -0,0 +1,69 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package v1alpha1
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/go-multierror"
+	"github.com/siderolabs/gen/slices"
+
+	"github.com/siderolabs/talos/pkg/machinery/config"
+)
+
+// Validate checks fan configuration for errors.
+func (fc *FanConfig) Validate() error {
+	var errs *multierror.Error
+
+	if fc.FanHwmon == "" {
+		errs = multierror.Append(errs, fmt.Errorf("fan hwmon device name is required"))
+	}
+
+	if len(fc.FanCurve) == 0 {
+		errs = multierror.Append(errs, fmt.Errorf("fan curve should have at least one point"))
+	}
+
+	for i, point := range fc.FanCurve {
+		if point.FanCurveSpeed < 0 || point.FanCurveSpeed > 100 {
+			errs = multierror.Append(errs, fmt.Errorf("fan curve speed %d should be in range 0-100", point.FanCurveSpeed))
+		}
+
+		if i > 0 && point.FanCurveTemperature <= fc.FanCurve[i-1].FanCurveTemperature {
+			errs = multierror.Append(errs, fmt.Errorf("fan curve temperatures should be strictly increasing: %d after %d", point.FanCurveTemperature, fc.FanCurve[i-1].FanCurveTemperature))
+		}
+	}
+
+	return errs.ErrorOrNil()
+}
+
+// Enabled implements config.Fan interface.
+func (fc *FanConfig) Enabled() bool {
+	return fc.FanHwmon != ""
+}
+
+// Hwmon implements config.Fan interface.
+func (fc *FanConfig) Hwmon() string {
+	return fc.FanHwmon
+}
+
+// ThermalZone implements config.Fan interface.
+func (fc *FanConfig) ThermalZone() string {
+	return fc.FanThermalZone
+}
+
+// Curve implements config.Fan interface.
+func (fc *FanConfig) Curve() []config.FanCurvePoint {
+	return slices.Map(fc.FanCurve, func(p FanCurvePoint) config.FanCurvePoint { return p })
+}
+
+// Temperature implements config.FanCurvePoint interface.
+func (p FanCurvePoint) Temperature() int {
+	return p.FanCurveTemperature
+}
+
+// Speed implements config.FanCurvePoint interface.
+func (p FanCurvePoint) Speed() int {
+	return p.FanCurveSpeed
+}
@@ -0,0 +1,162 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package v1alpha1
+
+import (
+	"fmt"
+	"net/netip"
+
+	"github.com/hashicorp/go-multierror"
+	"github.com/siderolabs/gen/slices"
+	"github.com/siderolabs/go-pointer"
+
+	"github.com/siderolabs/talos/pkg/machinery/config"
+	"github.com/siderolabs/talos/pkg/machinery/nethelpers"
+)
+
+// Validate checks firewall configuration for errors.
+func (fc *NetworkFirewallConfig) Validate() error {
+	var errs *multierror.Error
+
+	if fc.FirewallDefaultAction != "" {
+		if _, err := nethelpers.DefaultActionString(fc.FirewallDefaultAction); err != nil {
+			errs = multierror.Append(errs, fmt.Errorf("invalid firewall default action %q", fc.FirewallDefaultAction))
+		}
+	}
+
+	names := map[string]struct{}{}
+
+	for _, rule := range fc.FirewallRules {
+		if rule.RuleName == "" {
+			errs = multierror.Append(errs, fmt.Errorf("firewall rule name is required"))
+
+			continue
+		}
+
+		if _, exists := names[rule.RuleName]; exists {
+			errs = multierror.Append(errs, fmt.Errorf("duplicate firewall rule %q", rule.RuleName))
+		}
+
+		names[rule.RuleName] = struct{}{}
+
+		if err := rule.Validate(); err != nil {
+			errs = multierror.Append(errs, fmt.Errorf("firewall rule %q: %w", rule.RuleName, err))
+		}
+	}
+
+	return errs.ErrorOrNil()
+}
+
+// Validate checks firewall rule for errors.
+func (r *NetworkRule) Validate() error {
+	var errs *multierror.Error
+
+	protocol, err := nethelpers.ProtocolString(r.RulePortSelector.PortSelectorProtocol)
+	if err != nil {
+		errs = multierror.Append(errs, fmt.Errorf("invalid protocol %q", r.RulePortSelector.PortSelectorProtocol))
+	}
+
+	if (protocol == nethelpers.ProtocolICMP || protocol == nethelpers.ProtocolICMPv6) && len(r.RulePortSelector.PortSelectorPorts) > 0 {
+		errs = multierror.Append(errs, fmt.Errorf("ports can't be set for protocol %s", protocol))
+	}
+
+	for _, port := range r.RulePortSelector.PortSelectorPorts {
+		if _, err = nethelpers.ParsePortRange(port); err != nil {
+			errs = multierror.Append(errs, err)
+		}
+	}
+
+	for _, ingress := range r.RuleIngress {
+		subnet, err := netip.ParsePrefix(ingress.IngressSubnet)
+		if err != nil {
+			errs = multierror.Append(errs, fmt.Errorf("invalid subnet %q", ingress.IngressSubnet))
+
+			continue
+		}
+
+		if ingress.IngressExcept == "" {
+			continue
+		}
+
+		except, err := netip.ParsePrefix(ingress.IngressExcept)
+		if err != nil {
+			errs = multierror.Append(errs, fmt.Errorf("invalid except subnet %q", ingress.IngressExcept))
+
+			continue
+		}
+
+		if except.Addr().Is4() != subnet.Addr().Is4() {
+			errs = multierror.Append(errs, fmt.Errorf("except subnet %q should be of the same family as subnet %q", ingress.IngressExcept, ingress.IngressSubnet))
+		}
+	}
+
+	return errs.ErrorOrNil()
+}
+
+// Enabled implements config.NetworkFirewall interface.
+func (fc *NetworkFirewallConfig) Enabled() bool {
+	return fc.FirewallDefaultAction != "" || fc.FirewallDisableDefaultRules != nil || len(fc.FirewallRules) > 0
+}
+
+// DefaultAction implements config.NetworkFirewall interface.
+func (fc *NetworkFirewallConfig) DefaultAction() nethelpers.DefaultAction {
+	action, _ := nethelpers.DefaultActionString(fc.FirewallDefaultAction) //nolint:errcheck // validated, empty is accept
+
+	return action
+}
+
+// DefaultRules implements config.NetworkFirewall interface.
+func (fc *NetworkFirewallConfig) DefaultRules() bool {
+	return !pointer.SafeDeref(fc.FirewallDisableDefaultRules)
+}
+
+// Rules implements config.NetworkFirewall interface.
+func (fc *NetworkFirewallConfig) Rules() []config.NetworkRule {
+	return slices.Map(fc.FirewallRules, func(r *NetworkRule) config.NetworkRule { return r })
+}
+
+// Name implements config.NetworkRule interface.
+func (r *NetworkRule) Name() string {
+	return r.RuleName
+}
+
+// Protocol implements config.NetworkRule interface.
+func (r *NetworkRule) Protocol() nethelpers.Protocol {
+	protocol, _ := nethelpers.ProtocolString(r.RulePortSelector.PortSelectorProtocol) //nolint:errcheck // validated
+
+	return protocol
+}
+
+// Ports implements config.NetworkRule interface.
+func (r *NetworkRule) Ports() []nethelpers.PortRange {
+	ports := make([]nethelpers.PortRange, 0, len(r.RulePortSelector.PortSelectorPorts))
+
+	for _, port := range r.RulePortSelector.PortSelectorPorts {
+		if portRange, err := nethelpers.ParsePortRange(port); err == nil {
+			ports = append(ports, portRange)
+		}
+	}
+
+	return ports
+}
+
+// Ingress implements config.NetworkRule interface.
+func (r *NetworkRule) Ingress() []config.NetworkRuleIngress {
+	return slices.Map(r.RuleIngress, func(i NetworkRuleIngress) config.NetworkRuleIngress { return i })
+}
+
+// Subnet implements config.NetworkRuleIngress interface.
+func (i NetworkRuleIngress) Subnet() netip.Prefix {
+	subnet, _ := netip.ParsePrefix(i.IngressSubnet) //nolint:errcheck // validated
+
+	return subnet
+}
+
+// Except implements config.NetworkRuleIngress interface.
+func (i NetworkRuleIngress) Except() netip.Prefix {
+	except, _ := netip.ParsePrefix(i.IngressExcept) //nolint:errcheck // validated, empty is zero prefix
+
+	return except
+}
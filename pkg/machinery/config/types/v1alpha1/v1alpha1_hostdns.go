@@ -0,0 +1,16 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package v1alpha1
+
+import "github.com/siderolabs/go-pointer"
+
+// Enabled implements config.HostDNS.
+func (c *HostDNSConfig) Enabled() bool {
+	if c == nil {
+		return false
+	}
+
+	return pointer.SafeDeref(c.HostDNSEnabled)
+}
@@ -0,0 +1,59 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package v1alpha1
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/hashicorp/go-multierror"
+	"github.com/siderolabs/gen/maps"
+)
+
+// ledStages is the list of the machine stages which can be indicated with an LED.
+var ledStages = map[string]struct{}{
+	"booting":       {},
+	"installing":    {},
+	"maintenance":   {},
+	"running":       {},
+	"rebooting":     {},
+	"shutting down": {},
+	"resetting":     {},
+	"upgrading":     {},
+}
+
+// Validate checks LED configuration for errors.
+func (l *LEDConfig) Validate() error {
+	var errs *multierror.Error
+
+	if l.LEDName == "" {
+		errs = multierror.Append(errs, fmt.Errorf("LED name is required"))
+	}
+
+	stages := maps.Keys(l.LEDStages)
+	sort.Strings(stages)
+
+	for _, stage := range stages {
+		if _, ok := ledStages[stage]; !ok {
+			errs = multierror.Append(errs, fmt.Errorf("LED %q: unknown machine stage %q", l.LEDName, stage))
+		}
+
+		if l.LEDStages[stage] == "" {
+			errs = multierror.Append(errs, fmt.Errorf("LED %q: pattern for stage %q is empty", l.LEDName, stage))
+		}
+	}
+
+	return errs.ErrorOrNil()
+}
+
+// Name implements config.LED interface.
+func (l *LEDConfig) Name() string {
+	return l.LEDName
+}
+
+// Stages implements config.LED interface.
+func (l *LEDConfig) Stages() map[string]string {
+	return l.LEDStages
+}
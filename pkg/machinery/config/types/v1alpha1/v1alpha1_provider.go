@@ -22,6 +22,7 @@ import (
 	"github.com/siderolabs/talos/pkg/machinery/config/encoder"
 	"github.com/siderolabs/talos/pkg/machinery/config/types/v1alpha1/machine"
 	"github.com/siderolabs/talos/pkg/machinery/constants"
+	"github.com/siderolabs/talos/pkg/machinery/nethelpers"
 )
 
 const (
@@ -73,6 +74,29 @@ func (m *MachineConfig) NodeLabels() config.NodeLabels {
 	return m.MachineNodeLabels
 }
 
+// Fan implements the config.Provider interface.
+func (m *MachineConfig) Fan() config.Fan {
+	if m.MachineFan == nil {
+		return &FanConfig{}
+	}
+
+	return m.MachineFan
+}
+
+// LEDs implements the config.Provider interface.
+func (m *MachineConfig) LEDs() []config.LED {
+	return slices.Map(m.MachineLEDs, func(l *LEDConfig) config.LED { return l })
+}
+
+// Watchdog implements the config.Provider interface.
+func (m *MachineConfig) Watchdog() config.Watchdog {
+	if m.MachineWatchdog == nil {
+		return nil
+	}
+
+	return m.MachineWatchdog
+}
+
 // Cluster implements the config.Provider interface.
 func (c *Config) Cluster() config.ClusterConfig {
 	if c.ClusterConfig == nil {
@@ -581,6 +605,25 @@ func (n *NetworkConfig) KubeSpan() config.KubeSpan {
 	return n.NetworkKubeSpan
 }
 
+// Firewall implements the config.Provider interface.
+func (n *NetworkConfig) Firewall() config.NetworkFirewall {
+	if n.NetworkFirewall == nil {
+		return &NetworkFirewallConfig{}
+	}
+
+	return n.NetworkFirewall
+}
+
+// Ethernet implements the config.Provider interface.
+func (n *NetworkConfig) Ethernet() []config.EthernetConfig {
+	return slices.Map(n.NetworkEthernet, func(e *EthernetConfig) config.EthernetConfig { return e })
+}
+
+// RoutingRules implements the config.Provider interface.
+func (n *NetworkConfig) RoutingRules() []config.RoutingRule {
+	return slices.Map(n.NetworkRoutingRules, func(r *RoutingRuleConfig) config.RoutingRule { return r })
+}
+
 // IP implements the MachineNetwork interface.
 func (e *ExtraHost) IP() string {
 	return e.HostIP
@@ -631,6 +674,42 @@ func (d *Device) Bridge() config.Bridge {
 	return d.DeviceBridge
 }
 
+// VXLAN implements the MachineNetwork interface.
+func (d *Device) VXLAN() config.VXLAN {
+	if d.DeviceVXLAN == nil {
+		return nil
+	}
+
+	return d.DeviceVXLAN
+}
+
+// MACVLAN implements the MachineNetwork interface.
+func (d *Device) MACVLAN() config.MACVLAN {
+	if d.DeviceMACVLAN == nil {
+		return nil
+	}
+
+	return d.DeviceMACVLAN
+}
+
+// MACVTAP implements the MachineNetwork interface.
+func (d *Device) MACVTAP() config.MACVLAN {
+	if d.DeviceMACVTAP == nil {
+		return nil
+	}
+
+	return d.DeviceMACVTAP
+}
+
+// IPVLAN implements the MachineNetwork interface.
+func (d *Device) IPVLAN() config.IPVLAN {
+	if d.DeviceIPVLAN == nil {
+		return nil
+	}
+
+	return d.DeviceIPVLAN
+}
+
 // Vlans implements the MachineNetwork interface.
 func (d *Device) Vlans() []config.Vlan {
 	return slices.Map(d.DeviceVlans, func(v *Vlan) config.Vlan { return v })
@@ -841,6 +920,15 @@ func (r *Route) MTU() uint32 {
 	return r.RouteMTU
 }
 
+// Table implements the MachineNetwork interface.
+func (r *Route) Table() nethelpers.RoutingTable {
+	if r.RouteTable == 0 {
+		return nethelpers.TableMain
+	}
+
+	return nethelpers.RoutingTable(r.RouteTable)
+}
+
 // Interfaces implements the MachineNetwork interface.
 func (b *Bond) Interfaces() []string {
 	if b == nil {
@@ -1020,6 +1108,64 @@ func (b *Bridge) STP() config.STP {
 	return b.BridgeSTP
 }
 
+// VNI implements the config.VXLAN interface.
+func (v *VXLAN) VNI() uint32 {
+	return v.VXLANVNI
+}
+
+// Remote implements the config.VXLAN interface.
+func (v *VXLAN) Remote() string {
+	return v.VXLANRemote
+}
+
+// Group implements the config.VXLAN interface.
+func (v *VXLAN) Group() string {
+	return v.VXLANGroup
+}
+
+// Local implements the config.VXLAN interface.
+func (v *VXLAN) Local() string {
+	return v.VXLANLocal
+}
+
+// Port implements the config.VXLAN interface.
+func (v *VXLAN) Port() uint16 {
+	return v.VXLANPort
+}
+
+// Parent implements the config.VXLAN interface.
+func (v *VXLAN) Parent() string {
+	return v.VXLANParent
+}
+
+// Parent implements the config.MACVLAN interface.
+func (m *MACVLAN) Parent() string {
+	return m.MACVLANParent
+}
+
+// Mode implements the config.MACVLAN interface.
+func (m *MACVLAN) Mode() string {
+	if m.MACVLANMode == "" {
+		return nethelpers.MACVLANModeBridge.String()
+	}
+
+	return m.MACVLANMode
+}
+
+// Parent implements the config.IPVLAN interface.
+func (i *IPVLAN) Parent() string {
+	return i.IPVLANParent
+}
+
+// Mode implements the config.IPVLAN interface.
+func (i *IPVLAN) Mode() string {
+	if i.IPVLANMode == "" {
+		return nethelpers.IPVLANModeL2.String()
+	}
+
+	return i.IPVLANMode
+}
+
 // Addresses implements the MachineNetwork interface.
 func (v *Vlan) Addresses() []string {
 	switch {
@@ -1221,6 +1367,16 @@ func (i *InstallConfig) LegacyBIOSSupport() bool {
 	return pointer.SafeDeref(i.InstallLegacyBIOSSupport)
 }
 
+// DeviceTreeOverlays implements the config.Provider interface.
+func (i *InstallConfig) DeviceTreeOverlays() []string {
+	return i.InstallDeviceTreeOverlays
+}
+
+// BootloaderTarget implements the config.Provider interface.
+func (i *InstallConfig) BootloaderTarget() string {
+	return i.InstallBootloaderTarget
+}
+
 // WithBootloader implements the config.Provider interface.
 func (i *InstallConfig) WithBootloader() bool {
 	return pointer.SafeDeref(i.InstallBootloader)
@@ -5,62 +5,105 @@
 package v1alpha1
 
 import (
-	"errors"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+
+	"gopkg.in/yaml.v3"
 
 	"github.com/siderolabs/talos/pkg/machinery/config"
 	"github.com/siderolabs/talos/pkg/machinery/config/encoder"
+	"github.com/siderolabs/talos/pkg/machinery/config/secretref"
 )
 
 // ReadonlyProvider wraps the *v1alpha1.Config to make config read-only.
 //
+// The standalone documents are kept next to the v1alpha1 configuration, the accessors
+// return the configuration with the documents merged in.
+//
 // +k8s:deepcopy-gen=false
 type ReadonlyProvider struct {
-	cfg   *Config
-	bytes []byte
+	cfg        *Config
+	documents  []Document
+	merged     *Config
+	bytes      []byte
+	references []secretref.Reference
 }
 
 // WrapReadonly the v1alpha.Config providing read-only interface to it.
 func WrapReadonly(cfg *Config, bytes []byte) *ReadonlyProvider {
 	return &ReadonlyProvider{
-		cfg:   cfg,
-		bytes: bytes,
+		cfg:    cfg,
+		merged: cfg,
+		bytes:  bytes,
 	}
 }
 
+// WrapReadonlyWithDocuments wraps the v1alpha1.Config loaded with the standalone documents and the secret references.
+//
+// The documents are merged into the copy of the configuration, so that the conflicts are reported right away.
+// The fields holding the resolved secret references are redacted in addition to the well-known secrets,
+// and the references are put back in place when the configuration is encoded.
+func WrapReadonlyWithDocuments(cfg *Config, documents []Document, bytes []byte, references []secretref.Reference) (*ReadonlyProvider, error) {
+	merged, err := MergeDocuments(cfg, documents)
+	if err != nil {
+		return nil, err
+	}
+
+	return &ReadonlyProvider{
+		cfg:        cfg,
+		documents:  documents,
+		merged:     merged,
+		bytes:      bytes,
+		references: references,
+	}, nil
+}
+
+// Documents returns the standalone documents loaded next to the v1alpha1 configuration.
+func (r *ReadonlyProvider) Documents() []Document {
+	return r.documents
+}
+
+// References returns the secret references resolved when the configuration was loaded.
+func (r *ReadonlyProvider) References() []secretref.Reference {
+	return r.references
+}
+
 // Version implements the config.Provider interface.
 func (r *ReadonlyProvider) Version() string {
-	return r.cfg.Version()
+	return r.merged.Version()
 }
 
 // Debug implements the config.Provider interface.
 func (r *ReadonlyProvider) Debug() bool {
-	return r.cfg.Debug()
+	return r.merged.Debug()
 }
 
 // Persist implements the config.Provider interface.
 func (r *ReadonlyProvider) Persist() bool {
-	return r.cfg.Persist()
+	return r.merged.Persist()
 }
 
 // Machine implements the config.Provider interface.
 func (r *ReadonlyProvider) Machine() config.MachineConfig {
-	return r.cfg.Machine()
+	return r.merged.Machine()
 }
 
 // Cluster implements the config.Provider interface.
 func (r *ReadonlyProvider) Cluster() config.ClusterConfig {
-	return r.cfg.Cluster()
+	return r.merged.Cluster()
 }
 
 // Validate checks configuration and returns warnings and fatal errors (as multierror).
 func (r *ReadonlyProvider) Validate(mode config.RuntimeMode, opts ...config.ValidationOption) ([]string, error) {
-	return r.cfg.Validate(mode, opts...)
+	return r.merged.Validate(mode, opts...)
 }
 
 // Bytes returns source YAML representation (if available) or does default encoding.
 func (r *ReadonlyProvider) Bytes() ([]byte, error) {
 	if r.bytes == nil {
-		return r.bytes, errors.New("incorrect provider state: bytes is nil")
+		return r.EncodeBytes()
 	}
 
 	return r.bytes, nil
@@ -68,20 +111,185 @@ func (r *ReadonlyProvider) Bytes() ([]byte, error) {
 
 // RedactSecrets implements the config.Provider interface.
 func (r *ReadonlyProvider) RedactSecrets(replacement string) config.Provider {
-	return r.cfg.RedactSecrets(replacement)
+	redacted, ok := r.cfg.RedactSecrets(replacement).(*Config)
+	if !ok {
+		return nil
+	}
+
+	for _, ref := range r.references {
+		if ref.Kind == "" {
+			redactPath(reflect.ValueOf(redacted), ref.Path, replacement)
+		}
+	}
+
+	if len(r.documents) == 0 {
+		return redacted
+	}
+
+	documents := make([]Document, 0, len(r.documents))
+
+	for _, document := range r.documents {
+		documents = append(documents, r.redactDocument(document, replacement))
+	}
+
+	merged, err := MergeDocuments(redacted, documents)
+	if err != nil {
+		// the documents were merged into the same configuration on load, so this can't happen
+		return redacted
+	}
+
+	return &ReadonlyProvider{
+		cfg:       redacted,
+		documents: documents,
+		merged:    merged,
+	}
+}
+
+// redactDocument returns the copy of the document with the resolved secret references redacted.
+func (r *ReadonlyProvider) redactDocument(document Document, replacement string) Document {
+	var paths []secretref.Path
+
+	for _, ref := range r.references {
+		// the document fields are under the spec key
+		if ref.Kind == document.Kind() && ref.Name == document.Name() && len(ref.Path) > 1 && ref.Path[0] == "spec" {
+			paths = append(paths, ref.Path[1:])
+		}
+	}
+
+	if len(paths) == 0 {
+		return document
+	}
+
+	// documents are not deep-copyable, so copy the document via the YAML round-trip
+	data, err := yaml.Marshal(document)
+	if err != nil {
+		return document
+	}
+
+	copied := reflect.New(reflect.TypeOf(document).Elem())
+
+	if err = yaml.Unmarshal(data, copied.Interface()); err != nil {
+		return document
+	}
+
+	for _, path := range paths {
+		redactPath(copied, path, replacement)
+	}
+
+	redacted, ok := copied.Interface().(Document)
+	if !ok {
+		return document
+	}
+
+	return redacted
 }
 
 // EncodeString implements the config.Provider interface.
 func (r *ReadonlyProvider) EncodeString(encoderOptions ...encoder.Option) (string, error) {
-	return r.cfg.EncodeString(encoderOptions...)
+	b, err := r.EncodeBytes(encoderOptions...)
+	if err != nil {
+		return "", err
+	}
+
+	return string(b), nil
 }
 
 // EncodeBytes implements the config.Provider interface.
+//
+// The standalone documents are encoded as separate YAML documents after the v1alpha1 configuration.
+// The secret references resolved on load are put back in place of the secret values.
 func (r *ReadonlyProvider) EncodeBytes(encoderOptions ...encoder.Option) ([]byte, error) {
-	return r.cfg.EncodeBytes(encoderOptions...)
+	out, err := r.cfg.EncodeBytes(encoderOptions...)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, document := range r.documents {
+		encoded, err := EncodeDocument(document, encoderOptions...)
+		if err != nil {
+			return nil, fmt.Errorf("error encoding %s document: %w", document.Kind(), err)
+		}
+
+		out = append(out, "---\n"...)
+		out = append(out, encoded...)
+	}
+
+	return secretref.RestoreYAML(out, r.references)
 }
 
 // Raw implements the config.Provider interface.
+//
+// Raw returns the copy of the v1alpha1 configuration without the standalone documents.
 func (r *ReadonlyProvider) Raw() interface{} {
 	return r.cfg.DeepCopy()
 }
+
+// redactPath replaces the string or bytes value at the YAML path.
+//
+//nolint:gocyclo
+func redactPath(v reflect.Value, path []string, replacement string) {
+	for v.Kind() == reflect.Pointer || v.Kind() == reflect.Interface {
+		if v.IsNil() {
+			return
+		}
+
+		v = v.Elem()
+	}
+
+	if len(path) == 0 {
+		switch {
+		case v.Kind() == reflect.String && v.CanSet():
+			v.SetString(replacement)
+		case v.Kind() == reflect.Slice && v.Type().Elem().Kind() == reflect.Uint8 && v.CanSet():
+			v.SetBytes([]byte(replacement))
+		}
+
+		return
+	}
+
+	switch v.Kind() { //nolint:exhaustive
+	case reflect.Struct:
+		for i := 0; i < v.NumField(); i++ {
+			field := v.Type().Field(i)
+
+			if !field.IsExported() {
+				continue
+			}
+
+			name, opts, _ := strings.Cut(field.Tag.Get("yaml"), ",")
+
+			switch {
+			case name == path[0]:
+				redactPath(v.Field(i), path[1:], replacement)
+			case name == "" && strings.Contains(opts, "inline"):
+				redactPath(v.Field(i), path, replacement)
+			}
+		}
+	case reflect.Slice:
+		idx, err := strconv.Atoi(path[0])
+		if err != nil || idx < 0 || idx >= v.Len() {
+			return
+		}
+
+		redactPath(v.Index(idx), path[1:], replacement)
+	case reflect.Map:
+		if v.Type().Key().Kind() != reflect.String {
+			return
+		}
+
+		key := reflect.ValueOf(path[0]).Convert(v.Type().Key())
+
+		elem := v.MapIndex(key)
+		if !elem.IsValid() {
+			return
+		}
+
+		// map values are not addressable, so redact the copy and store it back
+		copied := reflect.New(elem.Type()).Elem()
+		copied.Set(elem)
+
+		redactPath(copied, path[1:], replacement)
+
+		v.SetMapIndex(key, copied)
+	}
+}
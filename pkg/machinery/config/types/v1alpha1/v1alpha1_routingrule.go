@@ -0,0 +1,146 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package v1alpha1
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"net/netip"
+
+	"github.com/hashicorp/go-multierror"
+
+	"github.com/siderolabs/talos/pkg/machinery/nethelpers"
+)
+
+// validateRoutingRules checks the routing rules for errors.
+//
+// The priority of the rule should be unique for each address family.
+func validateRoutingRules(rules []*RoutingRuleConfig) error {
+	var errs *multierror.Error
+
+	priorities := map[nethelpers.Family]map[uint32]struct{}{}
+
+	for i, rule := range rules {
+		if err := rule.Validate(); err != nil {
+			errs = multierror.Append(errs, fmt.Errorf("routing rule [%d]: %w", i, err))
+
+			continue
+		}
+
+		for _, family := range rule.Families() {
+			if priorities[family] == nil {
+				priorities[family] = map[uint32]struct{}{}
+			}
+
+			if _, exists := priorities[family][rule.RoutingRulePriority]; exists {
+				errs = multierror.Append(errs, fmt.Errorf("routing rule [%d]: duplicate %s rule priority %d", i, family, rule.RoutingRulePriority))
+			}
+
+			priorities[family][rule.RoutingRulePriority] = struct{}{}
+		}
+	}
+
+	return errs.ErrorOrNil()
+}
+
+// Validate checks routing rule for errors.
+func (r *RoutingRuleConfig) Validate() error {
+	var source, destination netip.Prefix
+
+	if r.RoutingRuleSource != "" {
+		var err error
+
+		if source, err = netip.ParsePrefix(r.RoutingRuleSource); err != nil {
+			return fmt.Errorf("invalid source %q", r.RoutingRuleSource)
+		}
+	}
+
+	if r.RoutingRuleDestination != "" {
+		var err error
+
+		if destination, err = netip.ParsePrefix(r.RoutingRuleDestination); err != nil {
+			return fmt.Errorf("invalid destination %q", r.RoutingRuleDestination)
+		}
+	}
+
+	switch {
+	case source.IsValid() && destination.IsValid() && source.Addr().Is4() != destination.Addr().Is4():
+		return fmt.Errorf("source %q and destination %q should be of the same family", r.RoutingRuleSource, r.RoutingRuleDestination)
+	case r.RoutingRuleFwMask != 0 && r.RoutingRuleFwMark == 0:
+		return errors.New("fwMask requires fwMark to be set")
+	case r.RoutingRuleTable == 0:
+		return errors.New("table is required")
+	case r.RoutingRulePriority == 0:
+		return errors.New("priority is required")
+	}
+
+	return nil
+}
+
+// Families implements config.RoutingRule interface.
+func (r *RoutingRuleConfig) Families() []nethelpers.Family {
+	for _, prefix := range []netip.Prefix{r.Source(), r.Destination()} {
+		switch {
+		case !prefix.IsValid():
+			continue
+		case prefix.Addr().Is4():
+			return []nethelpers.Family{nethelpers.FamilyInet4}
+		default:
+			return []nethelpers.Family{nethelpers.FamilyInet6}
+		}
+	}
+
+	return []nethelpers.Family{nethelpers.FamilyInet4, nethelpers.FamilyInet6}
+}
+
+// Source implements config.RoutingRule interface.
+func (r *RoutingRuleConfig) Source() netip.Prefix {
+	source, _ := netip.ParsePrefix(r.RoutingRuleSource) //nolint:errcheck // validated, empty is zero prefix
+
+	return source.Masked()
+}
+
+// Destination implements config.RoutingRule interface.
+func (r *RoutingRuleConfig) Destination() netip.Prefix {
+	destination, _ := netip.ParsePrefix(r.RoutingRuleDestination) //nolint:errcheck // validated, empty is zero prefix
+
+	return destination.Masked()
+}
+
+// IIF implements config.RoutingRule interface.
+func (r *RoutingRuleConfig) IIF() string {
+	return r.RoutingRuleIIF
+}
+
+// OIF implements config.RoutingRule interface.
+func (r *RoutingRuleConfig) OIF() string {
+	return r.RoutingRuleOIF
+}
+
+// FwMark implements config.RoutingRule interface.
+func (r *RoutingRuleConfig) FwMark() uint32 {
+	return r.RoutingRuleFwMark
+}
+
+// FwMask implements config.RoutingRule interface.
+func (r *RoutingRuleConfig) FwMask() uint32 {
+	if r.RoutingRuleFwMark != 0 && r.RoutingRuleFwMask == 0 {
+		// same as the kernel default
+		return math.MaxUint32
+	}
+
+	return r.RoutingRuleFwMask
+}
+
+// Table implements config.RoutingRule interface.
+func (r *RoutingRuleConfig) Table() nethelpers.RoutingTable {
+	return nethelpers.RoutingTable(r.RoutingRuleTable)
+}
+
+// Priority implements config.RoutingRule interface.
+func (r *RoutingRuleConfig) Priority() uint32 {
+	return r.RoutingRulePriority
+}
@@ -0,0 +1,14 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package v1alpha1
+
+import (
+	_ "embed"
+)
+
+// ConfigSchema is the JSON Schema of the v1alpha1 machine configuration generated from the docs.
+//
+//go:embed schemas/v1alpha1_config.schema.json
+var ConfigSchema []byte
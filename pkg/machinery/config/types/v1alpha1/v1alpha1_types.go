@@ -436,6 +436,23 @@ var (
 		},
 	}
 
+	networkConfigVXLANExample = &VXLAN{
+		VXLANVNI:    42,
+		VXLANRemote: "10.10.0.2",
+		VXLANLocal:  "10.10.0.1",
+		VXLANParent: "eth1",
+	}
+
+	networkConfigMACVLANExample = &MACVLAN{
+		MACVLANParent: "eth0",
+		MACVLANMode:   "bridge",
+	}
+
+	networkConfigIPVLANExample = &IPVLAN{
+		IPVLANParent: "eth0",
+		IPVLANMode:   "l3",
+	}
+
 	networkConfigDHCPOptionsExample = &DHCPOptions{
 		DHCPRouteMetric: 1024,
 	}
@@ -491,6 +508,53 @@ metadata:
 		KubeSpanEnabled: pointer.To(true),
 	}
 
+	networkFirewallExample = &NetworkFirewallConfig{
+		FirewallDefaultAction: "block",
+		FirewallRules: []*NetworkRule{
+			{
+				RuleName: "node-exporter",
+				RulePortSelector: NetworkRulePortSelector{
+					PortSelectorPorts:    []string{"9100"},
+					PortSelectorProtocol: "tcp",
+				},
+				RuleIngress: []NetworkRuleIngress{
+					{
+						IngressSubnet: "10.0.0.0/8",
+						IngressExcept: "10.10.0.0/16",
+					},
+				},
+			},
+		},
+	}
+
+	networkRoutingRulesExample = []*RoutingRuleConfig{
+		{
+			RoutingRuleSource:   "10.10.0.0/24",
+			RoutingRuleTable:    100,
+			RoutingRulePriority: 1000,
+		},
+	}
+
+	networkEthernetExample = []*EthernetConfig{
+		{
+			EthernetDeviceSelector: &NetworkDeviceSelector{
+				NetworkDeviceKernelDriver: "r8125",
+			},
+			EthernetRings: &EthernetRingsConfig{
+				RingsRX: pointer.To[uint32](1024),
+				RingsTX: pointer.To[uint32](1024),
+			},
+			EthernetFeatures: map[string]bool{
+				"tx-tcp-segmentation": false,
+			},
+			EthernetPause: &EthernetPauseConfig{
+				PauseAutonegotiate: pointer.To(false),
+				PauseRX:            pointer.To(true),
+				PauseTX:            pointer.To(true),
+			},
+		},
+	}
+
 	networkDeviceSelectorExamples = []NetworkDeviceSelector{
 		{
 			NetworkDeviceBus: "00:*",
@@ -549,6 +613,42 @@ metadata:
 		},
 	}
 
+	machineFanExample = &FanConfig{
+		FanHwmon:       "pwmfan",
+		FanThermalZone: "soc-thermal",
+		FanCurve: []FanCurvePoint{
+			{
+				FanCurveTemperature: 40,
+				FanCurveSpeed:       0,
+			},
+			{
+				FanCurveTemperature: 55,
+				FanCurveSpeed:       40,
+			},
+			{
+				FanCurveTemperature: 70,
+				FanCurveSpeed:       100,
+			},
+		},
+	}
+
+	machineWatchdogExample = &WatchdogConfig{
+		WatchdogDevice:  "/dev/watchdog0",
+		WatchdogTimeout: 2 * time.Minute,
+	}
+
+	machineLEDsExample = []*LEDConfig{
+		{
+			LEDName: "blue:status",
+			LEDStages: map[string]string{
+				"booting":     "blink-fast",
+				"maintenance": "blink",
+				"running":     "heartbeat",
+				"upgrading":   "on",
+			},
+		},
+	}
+
 	machinePodsExample = []Unstructured{
 		{
 			Object: map[string]interface{}{
@@ -609,6 +709,10 @@ metadata:
 			"kube-system",
 		},
 	}
+
+	hostDNSConfigExample = &HostDNSConfig{
+		HostDNSEnabled: pointer.To(true),
+	}
 )
 
 // Config defines the v1alpha1 configuration file.
@@ -856,6 +960,24 @@ type MachineConfig struct {
 	//    - name: node labels example.
 	//      value: 'map[string]string{"exampleLabel": "exampleLabelValue"}'
 	MachineNodeLabels map[string]string `yaml:"nodeLabels,omitempty"`
+	//   description: |
+	//     Configures the PWM fan control.
+	//     The fan speed is set according to the curve based on the thermal zone temperature.
+	//   examples:
+	//     - value: machineFanExample
+	MachineFan *FanConfig `yaml:"fan,omitempty"`
+	//   description: |
+	//     Configures the hardware watchdog timer.
+	//     The watchdog is fed by machined while the system services pass the health checks, so the machine is reset if machined stops responding.
+	//   examples:
+	//     - value: machineWatchdogExample
+	MachineWatchdog *WatchdogConfig `yaml:"watchdog,omitempty"`
+	//   description: |
+	//     Configures the LEDs to indicate the machine stage.
+	//     If not set, the board defaults are used (if the board has any).
+	//   examples:
+	//     - value: machineLEDsExample
+	MachineLEDs []*LEDConfig `yaml:"leds,omitempty"`
 }
 
 // MachineSeccompProfile defines seccomp profiles for the machine.
@@ -1230,6 +1352,33 @@ type NetworkConfig struct {
 	//     - false
 	//     - no
 	NetworkDisableSearchDomain *bool `yaml:"disableSearchDomain,omitempty"`
+	//   description: |
+	//     Configures the host ingress firewall.
+	//
+	//     The firewall is opt-in: it is disabled if none of the fields is set, and then all Talos and Kubernetes services
+	//     (including etcd on the control plane nodes) are reachable from any address.
+	//     `defaultAction: accept` enables the default rules only, which is recommended for the control plane nodes.
+	//   examples:
+	//     - value: networkFirewallExample
+	NetworkFirewall *NetworkFirewallConfig `yaml:"firewall,omitempty"`
+	//   description: |
+	//     Configures the Ethernet settings (ring sizes, channels, offloads and pause frames) of the links.
+	//
+	//     The settings are applied with the ethtool netlink interface,
+	//     so the settings which are not supported by the driver are reported as errors in the logs.
+	//   examples:
+	//     - value: networkEthernetExample
+	NetworkEthernet []*EthernetConfig `yaml:"ethernet,omitempty"`
+	//   description: |
+	//     Configures the policy routing rules (`ip rule`).
+	//
+	//     The rules select the routing table to look up based on the source and destination addresses,
+	//     the firewall mark and the interfaces of the packet.
+	//     If neither `source` nor `destination` is set, the rule is created both for IPv4 and IPv6.
+	//     The routes are put into a routing table with the `table` field of the interface routes.
+	//   examples:
+	//     - value: networkRoutingRulesExample
+	NetworkRoutingRules []*RoutingRuleConfig `yaml:"routingRules,omitempty"`
 }
 
 // NetworkDeviceList is a list of *Device structures with overridden merge process.
@@ -1336,6 +1485,21 @@ type InstallConfig struct {
 	//     Indicates if MBR partition should be marked as bootable (active).
 	//     Should be enabled only for the systems with legacy BIOS that doesn't support GPT partitioning scheme.
 	InstallLegacyBIOSSupport *bool `yaml:"legacyBIOSSupport,omitempty"`
+	//   description: |
+	//     Allows for supplying device tree overlays to be applied to the board device tree on install and upgrade.
+	//     Overlays are looked up by name (without the `.dtbo` extension) in the installer image and in the system extensions.
+	//     Only supported on single board computers.
+	//   examples:
+	//     - value: '[]string{"rk3588-spi1-m1-cs0-spidev", "rock-5b-pwm-fan"}'
+	InstallDeviceTreeOverlays []string `yaml:"deviceTreeOverlays,omitempty"`
+	//   description: |
+	//     The MTD block device of the onboard SPI flash to install the bootloader to.
+	//     When set, the bootloader is written to the SPI flash and the install disk is used only as the system disk,
+	//     which allows booting from a disk not supported by the SoC boot ROM (e.g. NVMe).
+	//     Only supported on single board computers which can boot from the SPI flash.
+	//   examples:
+	//     - value: '"/dev/mtdblock0"'
+	InstallBootloaderTarget string `yaml:"bootloaderTarget,omitempty"`
 }
 
 // InstallDiskSizeMatcher disk size condition parser.
@@ -2173,6 +2337,28 @@ type Device struct {
 	//   description: VLAN specific options.
 	DeviceVlans VlanList `yaml:"vlans,omitempty"`
 	//   description: |
+	//     VXLAN specific options.
+	//     The interface is created as a VXLAN link.
+	//   examples:
+	//     - value: networkConfigVXLANExample
+	DeviceVXLAN *VXLAN `yaml:"vxlan,omitempty"`
+	//   description: |
+	//     macvlan specific options.
+	//     The interface is created as a macvlan link on top of the parent interface.
+	//   examples:
+	//     - value: networkConfigMACVLANExample
+	DeviceMACVLAN *MACVLAN `yaml:"macvlan,omitempty"`
+	//   description: |
+	//     macvtap specific options.
+	//     The interface is created as a macvtap link on top of the parent interface.
+	DeviceMACVTAP *MACVLAN `yaml:"macvtap,omitempty"`
+	//   description: |
+	//     ipvlan specific options.
+	//     The interface is created as an ipvlan link on top of the parent interface.
+	//   examples:
+	//     - value: networkConfigIPVLANExample
+	DeviceIPVLAN *IPVLAN `yaml:"ipvlan,omitempty"`
+	//   description: |
 	//     The interface's MTU.
 	//     If used in combination with DHCP, this will override any MTU settings returned from DHCP server.
 	DeviceMTU int `yaml:"mtu,omitempty"`
@@ -2419,6 +2605,54 @@ type Bridge struct {
 	BridgeSTP *STP `yaml:"stp,omitempty"`
 }
 
+// VXLAN contains the various options for configuring a VXLAN interface.
+type VXLAN struct {
+	//   description: The VXLAN network identifier (1-16777215).
+	VXLANVNI uint32 `yaml:"vni"`
+	//   description: |
+	//     The unicast address of the remote VXLAN endpoint.
+	//     Mutually exclusive with `group`.
+	VXLANRemote string `yaml:"remote,omitempty"`
+	//   description: |
+	//     The multicast group address to join.
+	//     Requires `parent` to be set, mutually exclusive with `remote`.
+	VXLANGroup string `yaml:"group,omitempty"`
+	//   description: The source address of the encapsulated packets.
+	VXLANLocal string `yaml:"local,omitempty"`
+	//   description: |
+	//     The UDP destination port.
+	//     Defaults to the IANA assigned port 4789.
+	VXLANPort uint16 `yaml:"port,omitempty"`
+	//   description: The interface used to send the encapsulated packets.
+	VXLANParent string `yaml:"parent,omitempty"`
+}
+
+// MACVLAN contains the various options for configuring a macvlan or macvtap interface.
+type MACVLAN struct {
+	//   description: The parent interface.
+	MACVLANParent string `yaml:"parent"`
+	//   description: The macvlan mode (default is `bridge`).
+	//   values:
+	//     - private
+	//     - vepa
+	//     - bridge
+	//     - passthru
+	//     - source
+	MACVLANMode string `yaml:"mode,omitempty"`
+}
+
+// IPVLAN contains the various options for configuring an ipvlan interface.
+type IPVLAN struct {
+	//   description: The parent interface.
+	IPVLANParent string `yaml:"parent"`
+	//   description: The ipvlan mode (default is `l2`).
+	//   values:
+	//     - l2
+	//     - l3
+	//     - l3s
+	IPVLANMode string `yaml:"mode,omitempty"`
+}
+
 // VlanList is a list of *Vlan structures with overridden merge process.
 //
 //docgen:alias
@@ -2494,6 +2728,10 @@ type Route struct {
 	RouteMetric uint32 `yaml:"metric,omitempty"`
 	//   description: The optional MTU for the route.
 	RouteMTU uint32 `yaml:"mtu,omitempty"`
+	//   description: |
+	//     The optional routing table for the route, defaults to the main table.
+	//     The routes in the other tables are selected with the routing rules.
+	RouteTable uint32 `yaml:"table,omitempty"`
 }
 
 // RegistryMirrorConfig represents mirror configuration for a registry.
@@ -2603,6 +2841,11 @@ type FeaturesConfig struct {
 	//   description: |
 	//     Enable checks for extended key usage of client certificates in apid.
 	ApidCheckExtKeyUsage *bool `yaml:"apidCheckExtKeyUsage,omitempty"`
+	//   description: |
+	//     Configure the caching DNS resolver on the host.
+	//   examples:
+	//     - value: hostDNSConfigExample
+	HostDNSConfig *HostDNSConfig `yaml:"hostDNS,omitempty"`
 }
 
 // KubernetesTalosAPIAccessConfig describes the configuration for the Talos API access from Kubernetes pods.
@@ -2620,6 +2863,17 @@ type KubernetesTalosAPIAccessConfig struct {
 	AccessAllowedKubernetesNamespaces []string `yaml:"allowedKubernetesNamespaces,omitempty"`
 }
 
+// HostDNSConfig describes the configuration for the caching DNS resolver on the host.
+type HostDNSConfig struct {
+	//   description: |
+	//     Enable the caching DNS resolver on the host.
+	//
+	//     The resolver listens on `127.0.0.53`, forwards the queries to the configured nameservers
+	//     and resolves the node hostname and `extraHostEntries` locally.
+	//     The host `/etc/resolv.conf` points to the resolver, while the pods keep using the upstream nameservers.
+	HostDNSEnabled *bool `yaml:"enabled,omitempty"`
+}
+
 // VolumeMountConfig struct describes extra volume mount for the static pods.
 type VolumeMountConfig struct {
 	//   description: |
@@ -2800,3 +3054,252 @@ type KernelModuleConfig struct {
 	//   Module parameters, changes applied after reboot.
 	ModuleParameters []string `yaml:"parameters,omitempty"`
 }
+
+// FanConfig struct configures the PWM fan control.
+type FanConfig struct {
+	// description: |
+	//   Name of the hwmon device driving the fan (contents of `/sys/class/hwmon/hwmon*/name`).
+	// examples:
+	//   - value: '"pwmfan"'
+	FanHwmon string `yaml:"hwmon"`
+	// description: |
+	//   Type of the thermal zone to follow (contents of `/sys/class/thermal/thermal_zone*/type`).
+	//   If not set, the hottest thermal zone is used.
+	// examples:
+	//   - value: '"soc-thermal"'
+	FanThermalZone string `yaml:"thermalZone,omitempty"`
+	// description: |
+	//   Fan curve points, sorted by the temperature.
+	//   Fan speed is interpolated linearly between the points, below the first point the first point speed is used,
+	//   above the last point the last point speed is used.
+	FanCurve []FanCurvePoint `yaml:"curve"`
+}
+
+// WatchdogConfig struct configures the hardware watchdog timer.
+type WatchdogConfig struct {
+	// description: |
+	//   Path to the watchdog device.
+	//   Defaults to `/dev/watchdog0`.
+	// examples:
+	//   - value: '"/dev/watchdog0"'
+	WatchdogDevice string `yaml:"device,omitempty"`
+	// description: |
+	//   Timeout after which the machine is reset if the watchdog is not fed.
+	//   The watchdog is fed every quarter of the timeout.
+	//   Defaults to 1 minute, minimum value is 10 seconds.
+	// schema:
+	//   type: string
+	//   pattern: ^[-+]?(((\d+(\.\d*)?|\d*(\.\d+)+)([nuµm]?s|m|h))|0)+$
+	WatchdogTimeout time.Duration `yaml:"timeout,omitempty"`
+}
+
+// LEDConfig struct configures an LED to indicate the machine stage.
+type LEDConfig struct {
+	// description: |
+	//   Name of the LED (directory name in `/sys/class/leds`).
+	// examples:
+	//   - value: '"blue:status"'
+	LEDName string `yaml:"name"`
+	// description: |
+	//   LED pattern for each machine stage.
+	//
+	//   Stages are: `booting`, `installing`, `maintenance`, `running`, `rebooting`, `shutting down`, `resetting` and `upgrading`.
+	//   Patterns are: `on`, `off`, `blink`, `blink-fast`, or the name of a kernel LED trigger (e.g. `heartbeat`).
+	//   The LED is not changed in the stages which are not listed.
+	LEDStages map[string]string `yaml:"stages"`
+}
+
+// FanCurvePoint struct configures a point of the fan curve.
+type FanCurvePoint struct {
+	// description: |
+	//   Temperature in degrees Celsius.
+	FanCurveTemperature int `yaml:"temperature"`
+	// description: |
+	//   Fan speed in percent (0-100).
+	FanCurveSpeed int `yaml:"speed"`
+}
+
+// NetworkFirewallConfig struct configures the host ingress firewall.
+type NetworkFirewallConfig struct {
+	// description: |
+	//   Action for the ingress traffic which is not matched by any rule.
+	//
+	//   Loopback traffic, ICMP and the replies to the connections initiated by the node are always accepted.
+	//   Default value is `accept`.
+	// values:
+	//   - accept
+	//   - block
+	FirewallDefaultAction string `yaml:"defaultAction,omitempty"`
+	// description: |
+	//   Disable the default rules for the machine type.
+	//
+	//   By default, apid is allowed from any address, the kubelet API is allowed from the cluster members and pod subnets,
+	//   control plane nodes allow trustd and the Kubernetes API server from any address, and etcd from the control plane members only.
+	//   The cluster members are taken from the cluster discovery, if the discovery is disabled, the node subnets are used instead,
+	//   and etcd is not allowed by the default rules, it should be allowed with the `etcd` rule.
+	//   A rule with the same name as a default rule (`apid`, `trustd`, `kube-apiserver`, `etcd`, `kubelet`, `kubespan`, `cni-vxlan`) replaces it.
+	FirewallDisableDefaultRules *bool `yaml:"disableDefaultRules,omitempty"`
+	// description: |
+	//   Ingress rules.
+	//
+	//   The traffic matched by the rule port selector is accepted from the rule subnets, and dropped from other addresses.
+	FirewallRules []*NetworkRule `yaml:"rules,omitempty"`
+}
+
+// EthernetConfig struct configures the Ethernet settings of the links.
+type EthernetConfig struct {
+	// description: |
+	//   The interface name.
+	//   Mutually exclusive with `deviceSelector`.
+	// examples:
+	//   - value: '"enp1s0"'
+	EthernetInterface string `yaml:"interface,omitempty"`
+	// description: |
+	//   Picks the links using the selector.
+	//   The settings are applied to all the matching links.
+	//   Mutually exclusive with `interface`.
+	EthernetDeviceSelector *NetworkDeviceSelector `yaml:"deviceSelector,omitempty"`
+	// description: |
+	//   Ring buffer sizes (`ethtool -G`).
+	EthernetRings *EthernetRingsConfig `yaml:"rings,omitempty"`
+	// description: |
+	//   Number of channels (`ethtool -L`).
+	EthernetChannels *EthernetChannelsConfig `yaml:"channels,omitempty"`
+	// description: |
+	//   Features (offloads) to enable or disable (`ethtool -K`).
+	//
+	//   The feature names are the kernel names as reported by `ethtool -k`, e.g. `tx-tcp-segmentation`,
+	//   `generic-receive-offload` or `rx-checksum`.
+	// examples:
+	//   - value: 'map[string]bool{"tx-tcp-segmentation": false, "generic-receive-offload": true}'
+	EthernetFeatures map[string]bool `yaml:"features,omitempty"`
+	// description: |
+	//   Pause frames settings (`ethtool -A`).
+	EthernetPause *EthernetPauseConfig `yaml:"pause,omitempty"`
+}
+
+// EthernetRingsConfig struct configures the ring buffer sizes.
+type EthernetRingsConfig struct {
+	// description: |
+	//   Number of entries in the RX ring.
+	RingsRX *uint32 `yaml:"rx,omitempty"`
+	// description: |
+	//   Number of entries in the RX mini ring.
+	RingsRXMini *uint32 `yaml:"rxMini,omitempty"`
+	// description: |
+	//   Number of entries in the RX jumbo ring.
+	RingsRXJumbo *uint32 `yaml:"rxJumbo,omitempty"`
+	// description: |
+	//   Number of entries in the TX ring.
+	RingsTX *uint32 `yaml:"tx,omitempty"`
+}
+
+// EthernetChannelsConfig struct configures the number of channels.
+type EthernetChannelsConfig struct {
+	// description: |
+	//   Number of RX channels.
+	ChannelsRX *uint32 `yaml:"rx,omitempty"`
+	// description: |
+	//   Number of TX channels.
+	ChannelsTX *uint32 `yaml:"tx,omitempty"`
+	// description: |
+	//   Number of other channels.
+	ChannelsOther *uint32 `yaml:"other,omitempty"`
+	// description: |
+	//   Number of combined channels.
+	ChannelsCombined *uint32 `yaml:"combined,omitempty"`
+}
+
+// EthernetPauseConfig struct configures the pause frames.
+type EthernetPauseConfig struct {
+	// description: |
+	//   Negotiate the pause frames with the link partner.
+	PauseAutonegotiate *bool `yaml:"autonegotiate,omitempty"`
+	// description: |
+	//   Receive pause frames.
+	PauseRX *bool `yaml:"rx,omitempty"`
+	// description: |
+	//   Transmit pause frames.
+	PauseTX *bool `yaml:"tx,omitempty"`
+}
+
+// RoutingRuleConfig struct describes a policy routing rule.
+type RoutingRuleConfig struct {
+	// description: |
+	//   Matches the source address of the packets, in CIDR notation.
+	//   If not set, any source address is matched.
+	// examples:
+	//   - value: '"10.10.0.0/24"'
+	RoutingRuleSource string `yaml:"source,omitempty"`
+	// description: |
+	//   Matches the destination address of the packets, in CIDR notation.
+	//   If not set, any destination address is matched.
+	RoutingRuleDestination string `yaml:"destination,omitempty"`
+	// description: |
+	//   Matches the packets received on the interface.
+	//   The packets originated from the node are matched with `lo`.
+	RoutingRuleIIF string `yaml:"iif,omitempty"`
+	// description: |
+	//   Matches the packets sent out of the interface.
+	//   Only the packets from the sockets bound to the interface are matched.
+	RoutingRuleOIF string `yaml:"oif,omitempty"`
+	// description: |
+	//   Matches the packets with the firewall mark.
+	RoutingRuleFwMark uint32 `yaml:"fwMark,omitempty"`
+	// description: |
+	//   The mask applied to the firewall mark before matching, defaults to `0xffffffff`.
+	RoutingRuleFwMask uint32 `yaml:"fwMask,omitempty"`
+	// description: |
+	//   The routing table to look up.
+	RoutingRuleTable uint32 `yaml:"table"`
+	// description: |
+	//   The priority of the rule, the rules are evaluated from the lowest to the highest priority.
+	//   The priority should be unique, the main routing table is looked up at priority 32766.
+	RoutingRulePriority uint32 `yaml:"priority"`
+}
+
+// NetworkRule struct describes an ingress rule of the host firewall.
+type NetworkRule struct {
+	// description: |
+	//   Name of the rule.
+	RuleName string `yaml:"name"`
+	// description: |
+	//   Ports and the protocol the rule applies to.
+	RulePortSelector NetworkRulePortSelector `yaml:"portSelector"`
+	// description: |
+	//   Subnets the traffic is accepted from.
+	//   If not set, the traffic is accepted from any address.
+	RuleIngress []NetworkRuleIngress `yaml:"ingress,omitempty"`
+}
+
+// NetworkRulePortSelector struct selects the ports the rule applies to.
+type NetworkRulePortSelector struct {
+	// description: |
+	//   Ports or port ranges.
+	//   If not set, the rule applies to all the traffic of the protocol.
+	// examples:
+	//   - value: '[]string{"80", "30000-32767"}'
+	PortSelectorPorts []string `yaml:"ports,omitempty"`
+	// description: |
+	//   Protocol.
+	// values:
+	//   - tcp
+	//   - udp
+	//   - icmp
+	//   - icmpv6
+	PortSelectorProtocol string `yaml:"protocol"`
+}
+
+// NetworkRuleIngress struct describes a subnet the traffic is accepted from.
+type NetworkRuleIngress struct {
+	// description: |
+	//   Subnet in CIDR notation.
+	// examples:
+	//   - value: '"192.168.0.0/16"'
+	IngressSubnet string `yaml:"subnet"`
+	// description: |
+	//   Subnet excluded from the subnet in CIDR notation.
+	// examples:
+	//   - value: '"192.168.0.3/32"'
+	IngressExcept string `yaml:"except,omitempty"`
+}
@@ -62,6 +62,9 @@ var (
 	BondDoc                           encoder.Doc
 	STPDoc                            encoder.Doc
 	BridgeDoc                         encoder.Doc
+	VXLANDoc                          encoder.Doc
+	MACVLANDoc                        encoder.Doc
+	IPVLANDoc                         encoder.Doc
 	VlanDoc                           encoder.Doc
 	RouteDoc                          encoder.Doc
 	RegistryMirrorConfigDoc           encoder.Doc
@@ -71,6 +74,7 @@ var (
 	SystemDiskEncryptionConfigDoc     encoder.Doc
 	FeaturesConfigDoc                 encoder.Doc
 	KubernetesTalosAPIAccessConfigDoc encoder.Doc
+	HostDNSConfigDoc                  encoder.Doc
 	VolumeMountConfigDoc              encoder.Doc
 	ClusterInlineManifestDoc          encoder.Doc
 	NetworkKubeSpanDoc                encoder.Doc
@@ -85,6 +89,19 @@ var (
 	LoggingDestinationDoc             encoder.Doc
 	KernelConfigDoc                   encoder.Doc
 	KernelModuleConfigDoc             encoder.Doc
+	FanConfigDoc                      encoder.Doc
+	WatchdogConfigDoc                 encoder.Doc
+	LEDConfigDoc                      encoder.Doc
+	FanCurvePointDoc                  encoder.Doc
+	NetworkFirewallConfigDoc          encoder.Doc
+	EthernetConfigDoc                 encoder.Doc
+	EthernetRingsConfigDoc            encoder.Doc
+	EthernetChannelsConfigDoc         encoder.Doc
+	EthernetPauseConfigDoc            encoder.Doc
+	RoutingRuleConfigDoc              encoder.Doc
+	NetworkRuleDoc                    encoder.Doc
+	NetworkRulePortSelectorDoc        encoder.Doc
+	NetworkRuleIngressDoc             encoder.Doc
 )
 
 func init() {
@@ -146,7 +163,7 @@ func init() {
 			FieldName: "machine",
 		},
 	}
-	MachineConfigDoc.Fields = make([]encoder.Doc, 23)
+	MachineConfigDoc.Fields = make([]encoder.Doc, 26)
 	MachineConfigDoc.Fields[0].Name = "type"
 	MachineConfigDoc.Fields[0].Type = "string"
 	MachineConfigDoc.Fields[0].Note = ""
@@ -321,6 +338,27 @@ func init() {
 	MachineConfigDoc.Fields[22].Comments[encoder.LineComment] = "Configures the node labels for the machine."
 
 	MachineConfigDoc.Fields[22].AddExample("node labels example.", map[string]string{"exampleLabel": "exampleLabelValue"})
+	MachineConfigDoc.Fields[23].Name = "fan"
+	MachineConfigDoc.Fields[23].Type = "FanConfig"
+	MachineConfigDoc.Fields[23].Note = ""
+	MachineConfigDoc.Fields[23].Description = "Configures the PWM fan control.\nThe fan speed is set according to the curve based on the thermal zone temperature."
+	MachineConfigDoc.Fields[23].Comments[encoder.LineComment] = "Configures the PWM fan control."
+
+	MachineConfigDoc.Fields[23].AddExample("", machineFanExample)
+	MachineConfigDoc.Fields[24].Name = "watchdog"
+	MachineConfigDoc.Fields[24].Type = "WatchdogConfig"
+	MachineConfigDoc.Fields[24].Note = ""
+	MachineConfigDoc.Fields[24].Description = "Configures the hardware watchdog timer.\nThe watchdog is fed by machined while the system services pass the health checks, so the machine is reset if machined stops responding."
+	MachineConfigDoc.Fields[24].Comments[encoder.LineComment] = "Configures the hardware watchdog timer."
+
+	MachineConfigDoc.Fields[24].AddExample("", machineWatchdogExample)
+	MachineConfigDoc.Fields[25].Name = "leds"
+	MachineConfigDoc.Fields[25].Type = "[]LEDConfig"
+	MachineConfigDoc.Fields[25].Note = ""
+	MachineConfigDoc.Fields[25].Description = "Configures the LEDs to indicate the machine stage.\nIf not set, the board defaults are used (if the board has any)."
+	MachineConfigDoc.Fields[25].Comments[encoder.LineComment] = "Configures the LEDs to indicate the machine stage."
+
+	MachineConfigDoc.Fields[25].AddExample("", machineLEDsExample)
 
 	MachineSeccompProfileDoc.Type = "MachineSeccompProfile"
 	MachineSeccompProfileDoc.Comments[encoder.LineComment] = "MachineSeccompProfile defines seccomp profiles for the machine."
@@ -728,7 +766,7 @@ func init() {
 			FieldName: "network",
 		},
 	}
-	NetworkConfigDoc.Fields = make([]encoder.Doc, 6)
+	NetworkConfigDoc.Fields = make([]encoder.Doc, 9)
 	NetworkConfigDoc.Fields[0].Name = "hostname"
 	NetworkConfigDoc.Fields[0].Type = "string"
 	NetworkConfigDoc.Fields[0].Note = ""
@@ -773,6 +811,27 @@ func init() {
 		"false",
 		"no",
 	}
+	NetworkConfigDoc.Fields[6].Name = "firewall"
+	NetworkConfigDoc.Fields[6].Type = "NetworkFirewallConfig"
+	NetworkConfigDoc.Fields[6].Note = ""
+	NetworkConfigDoc.Fields[6].Description = "Configures the host ingress firewall.\n\nThe firewall is opt-in: it is disabled if none of the fields is set, and then all Talos and Kubernetes services\n(including etcd on the control plane nodes) are reachable from any address.\n`defaultAction: accept` enables the default rules only, which is recommended for the control plane nodes."
+	NetworkConfigDoc.Fields[6].Comments[encoder.LineComment] = "Configures the host ingress firewall."
+
+	NetworkConfigDoc.Fields[6].AddExample("", networkFirewallExample)
+	NetworkConfigDoc.Fields[7].Name = "ethernet"
+	NetworkConfigDoc.Fields[7].Type = "[]EthernetConfig"
+	NetworkConfigDoc.Fields[7].Note = ""
+	NetworkConfigDoc.Fields[7].Description = "Configures the Ethernet settings (ring sizes, channels, offloads and pause frames) of the links.\n\nThe settings are applied with the ethtool netlink interface,\nso the settings which are not supported by the driver are reported as errors in the logs."
+	NetworkConfigDoc.Fields[7].Comments[encoder.LineComment] = "Configures the Ethernet settings (ring sizes, channels, offloads and pause frames) of the links."
+
+	NetworkConfigDoc.Fields[7].AddExample("", networkEthernetExample)
+	NetworkConfigDoc.Fields[8].Name = "routingRules"
+	NetworkConfigDoc.Fields[8].Type = "[]RoutingRuleConfig"
+	NetworkConfigDoc.Fields[8].Note = ""
+	NetworkConfigDoc.Fields[8].Description = "Configures the policy routing rules (`ip rule`).\n\nThe rules select the routing table to look up based on the source and destination addresses,\nthe firewall mark and the interfaces of the packet.\nIf neither `source` nor `destination` is set, the rule is created both for IPv4 and IPv6.\nThe routes are put into a routing table with the `table` field of the interface routes."
+	NetworkConfigDoc.Fields[8].Comments[encoder.LineComment] = "Configures the policy routing rules (`ip rule`)."
+
+	NetworkConfigDoc.Fields[8].AddExample("", networkRoutingRulesExample)
 
 	InstallConfigDoc.Type = "InstallConfig"
 	InstallConfigDoc.Comments[encoder.LineComment] = "InstallConfig represents the installation options for preparing a node."
@@ -785,7 +844,7 @@ func init() {
 			FieldName: "install",
 		},
 	}
-	InstallConfigDoc.Fields = make([]encoder.Doc, 8)
+	InstallConfigDoc.Fields = make([]encoder.Doc, 10)
 	InstallConfigDoc.Fields[0].Name = "disk"
 	InstallConfigDoc.Fields[0].Type = "string"
 	InstallConfigDoc.Fields[0].Note = ""
@@ -850,6 +909,20 @@ func init() {
 	InstallConfigDoc.Fields[7].Note = ""
 	InstallConfigDoc.Fields[7].Description = "Indicates if MBR partition should be marked as bootable (active).\nShould be enabled only for the systems with legacy BIOS that doesn't support GPT partitioning scheme."
 	InstallConfigDoc.Fields[7].Comments[encoder.LineComment] = "Indicates if MBR partition should be marked as bootable (active)."
+	InstallConfigDoc.Fields[8].Name = "deviceTreeOverlays"
+	InstallConfigDoc.Fields[8].Type = "[]string"
+	InstallConfigDoc.Fields[8].Note = ""
+	InstallConfigDoc.Fields[8].Description = "Allows for supplying device tree overlays to be applied to the board device tree on install and upgrade.\nOverlays are looked up by name (without the `.dtbo` extension) in the installer image and in the system extensions.\nOnly supported on single board computers."
+	InstallConfigDoc.Fields[8].Comments[encoder.LineComment] = "Allows for supplying device tree overlays to be applied to the board device tree on install and upgrade."
+
+	InstallConfigDoc.Fields[8].AddExample("", []string{"rk3588-spi1-m1-cs0-spidev", "rock-5b-pwm-fan"})
+	InstallConfigDoc.Fields[9].Name = "bootloaderTarget"
+	InstallConfigDoc.Fields[9].Type = "string"
+	InstallConfigDoc.Fields[9].Note = ""
+	InstallConfigDoc.Fields[9].Description = "The MTD block device of the onboard SPI flash to install the bootloader to.\nWhen set, the bootloader is written to the SPI flash and the install disk is used only as the system disk,\nwhich allows booting from a disk not supported by the SoC boot ROM (e.g. NVMe).\nOnly supported on single board computers which can boot from the SPI flash."
+	InstallConfigDoc.Fields[9].Comments[encoder.LineComment] = "The MTD block device of the onboard SPI flash to install the bootloader to."
+
+	InstallConfigDoc.Fields[9].AddExample("", "/dev/mtdblock0")
 
 	InstallDiskSelectorDoc.Type = "InstallDiskSelector"
 	InstallDiskSelectorDoc.Comments[encoder.LineComment] = "InstallDiskSelector represents a disk query parameters for the install disk lookup."
@@ -1672,7 +1745,7 @@ func init() {
 			FieldName: "interfaces",
 		},
 	}
-	DeviceDoc.Fields = make([]encoder.Doc, 15)
+	DeviceDoc.Fields = make([]encoder.Doc, 19)
 	DeviceDoc.Fields[0].Name = "interface"
 	DeviceDoc.Fields[0].Type = "string"
 	DeviceDoc.Fields[0].Note = ""
@@ -1722,51 +1795,77 @@ func init() {
 	DeviceDoc.Fields[7].Note = ""
 	DeviceDoc.Fields[7].Description = "VLAN specific options."
 	DeviceDoc.Fields[7].Comments[encoder.LineComment] = "VLAN specific options."
-	DeviceDoc.Fields[8].Name = "mtu"
-	DeviceDoc.Fields[8].Type = "int"
+	DeviceDoc.Fields[8].Name = "vxlan"
+	DeviceDoc.Fields[8].Type = "VXLAN"
 	DeviceDoc.Fields[8].Note = ""
-	DeviceDoc.Fields[8].Description = "The interface's MTU.\nIf used in combination with DHCP, this will override any MTU settings returned from DHCP server."
-	DeviceDoc.Fields[8].Comments[encoder.LineComment] = "The interface's MTU."
-	DeviceDoc.Fields[9].Name = "dhcp"
-	DeviceDoc.Fields[9].Type = "bool"
+	DeviceDoc.Fields[8].Description = "VXLAN specific options.\nThe interface is created as a VXLAN link."
+	DeviceDoc.Fields[8].Comments[encoder.LineComment] = "VXLAN specific options."
+
+	DeviceDoc.Fields[8].AddExample("", networkConfigVXLANExample)
+	DeviceDoc.Fields[9].Name = "macvlan"
+	DeviceDoc.Fields[9].Type = "MACVLAN"
 	DeviceDoc.Fields[9].Note = ""
-	DeviceDoc.Fields[9].Description = "Indicates if DHCP should be used to configure the interface.\nThe following DHCP options are supported:\n\n- `OptionClasslessStaticRoute`\n- `OptionDomainNameServer`\n- `OptionDNSDomainSearchList`\n- `OptionHostName`"
-	DeviceDoc.Fields[9].Comments[encoder.LineComment] = "Indicates if DHCP should be used to configure the interface."
+	DeviceDoc.Fields[9].Description = "macvlan specific options.\nThe interface is created as a macvlan link on top of the parent interface."
+	DeviceDoc.Fields[9].Comments[encoder.LineComment] = "macvlan specific options."
 
-	DeviceDoc.Fields[9].AddExample("", true)
-	DeviceDoc.Fields[10].Name = "ignore"
-	DeviceDoc.Fields[10].Type = "bool"
+	DeviceDoc.Fields[9].AddExample("", networkConfigMACVLANExample)
+	DeviceDoc.Fields[10].Name = "macvtap"
+	DeviceDoc.Fields[10].Type = "MACVLAN"
 	DeviceDoc.Fields[10].Note = ""
-	DeviceDoc.Fields[10].Description = "Indicates if the interface should be ignored (skips configuration)."
-	DeviceDoc.Fields[10].Comments[encoder.LineComment] = "Indicates if the interface should be ignored (skips configuration)."
-	DeviceDoc.Fields[11].Name = "dummy"
-	DeviceDoc.Fields[11].Type = "bool"
+	DeviceDoc.Fields[10].Description = "macvtap specific options.\nThe interface is created as a macvtap link on top of the parent interface."
+	DeviceDoc.Fields[10].Comments[encoder.LineComment] = "macvtap specific options."
+	DeviceDoc.Fields[11].Name = "ipvlan"
+	DeviceDoc.Fields[11].Type = "IPVLAN"
 	DeviceDoc.Fields[11].Note = ""
-	DeviceDoc.Fields[11].Description = "Indicates if the interface is a dummy interface.\n`dummy` is used to specify that this interface should be a virtual-only, dummy interface."
-	DeviceDoc.Fields[11].Comments[encoder.LineComment] = "Indicates if the interface is a dummy interface."
-	DeviceDoc.Fields[12].Name = "dhcpOptions"
-	DeviceDoc.Fields[12].Type = "DHCPOptions"
-	DeviceDoc.Fields[12].Note = ""
-	DeviceDoc.Fields[12].Description = "DHCP specific options.\n`dhcp` *must* be set to true for these to take effect."
-	DeviceDoc.Fields[12].Comments[encoder.LineComment] = "DHCP specific options."
+	DeviceDoc.Fields[11].Description = "ipvlan specific options.\nThe interface is created as an ipvlan link on top of the parent interface."
+	DeviceDoc.Fields[11].Comments[encoder.LineComment] = "ipvlan specific options."
 
-	DeviceDoc.Fields[12].AddExample("", networkConfigDHCPOptionsExample)
-	DeviceDoc.Fields[13].Name = "wireguard"
-	DeviceDoc.Fields[13].Type = "DeviceWireguardConfig"
+	DeviceDoc.Fields[11].AddExample("", networkConfigIPVLANExample)
+	DeviceDoc.Fields[12].Name = "mtu"
+	DeviceDoc.Fields[12].Type = "int"
+	DeviceDoc.Fields[12].Note = ""
+	DeviceDoc.Fields[12].Description = "The interface's MTU.\nIf used in combination with DHCP, this will override any MTU settings returned from DHCP server."
+	DeviceDoc.Fields[12].Comments[encoder.LineComment] = "The interface's MTU."
+	DeviceDoc.Fields[13].Name = "dhcp"
+	DeviceDoc.Fields[13].Type = "bool"
 	DeviceDoc.Fields[13].Note = ""
-	DeviceDoc.Fields[13].Description = "Wireguard specific configuration.\nIncludes things like private key, listen port, peers."
-	DeviceDoc.Fields[13].Comments[encoder.LineComment] = "Wireguard specific configuration."
-
-	DeviceDoc.Fields[13].AddExample("wireguard server example", networkConfigWireguardHostExample)
+	DeviceDoc.Fields[13].Description = "Indicates if DHCP should be used to configure the interface.\nThe following DHCP options are supported:\n\n- `OptionClasslessStaticRoute`\n- `OptionDomainNameServer`\n- `OptionDNSDomainSearchList`\n- `OptionHostName`"
+	DeviceDoc.Fields[13].Comments[encoder.LineComment] = "Indicates if DHCP should be used to configure the interface."
 
-	DeviceDoc.Fields[13].AddExample("wireguard peer example", networkConfigWireguardPeerExample)
-	DeviceDoc.Fields[14].Name = "vip"
-	DeviceDoc.Fields[14].Type = "DeviceVIPConfig"
+	DeviceDoc.Fields[13].AddExample("", true)
+	DeviceDoc.Fields[14].Name = "ignore"
+	DeviceDoc.Fields[14].Type = "bool"
 	DeviceDoc.Fields[14].Note = ""
-	DeviceDoc.Fields[14].Description = "Virtual (shared) IP address configuration."
-	DeviceDoc.Fields[14].Comments[encoder.LineComment] = "Virtual (shared) IP address configuration."
-
-	DeviceDoc.Fields[14].AddExample("layer2 vip example", networkConfigVIPLayer2Example)
+	DeviceDoc.Fields[14].Description = "Indicates if the interface should be ignored (skips configuration)."
+	DeviceDoc.Fields[14].Comments[encoder.LineComment] = "Indicates if the interface should be ignored (skips configuration)."
+	DeviceDoc.Fields[15].Name = "dummy"
+	DeviceDoc.Fields[15].Type = "bool"
+	DeviceDoc.Fields[15].Note = ""
+	DeviceDoc.Fields[15].Description = "Indicates if the interface is a dummy interface.\n`dummy` is used to specify that this interface should be a virtual-only, dummy interface."
+	DeviceDoc.Fields[15].Comments[encoder.LineComment] = "Indicates if the interface is a dummy interface."
+	DeviceDoc.Fields[16].Name = "dhcpOptions"
+	DeviceDoc.Fields[16].Type = "DHCPOptions"
+	DeviceDoc.Fields[16].Note = ""
+	DeviceDoc.Fields[16].Description = "DHCP specific options.\n`dhcp` *must* be set to true for these to take effect."
+	DeviceDoc.Fields[16].Comments[encoder.LineComment] = "DHCP specific options."
+
+	DeviceDoc.Fields[16].AddExample("", networkConfigDHCPOptionsExample)
+	DeviceDoc.Fields[17].Name = "wireguard"
+	DeviceDoc.Fields[17].Type = "DeviceWireguardConfig"
+	DeviceDoc.Fields[17].Note = ""
+	DeviceDoc.Fields[17].Description = "Wireguard specific configuration.\nIncludes things like private key, listen port, peers."
+	DeviceDoc.Fields[17].Comments[encoder.LineComment] = "Wireguard specific configuration."
+
+	DeviceDoc.Fields[17].AddExample("wireguard server example", networkConfigWireguardHostExample)
+
+	DeviceDoc.Fields[17].AddExample("wireguard peer example", networkConfigWireguardPeerExample)
+	DeviceDoc.Fields[18].Name = "vip"
+	DeviceDoc.Fields[18].Type = "DeviceVIPConfig"
+	DeviceDoc.Fields[18].Note = ""
+	DeviceDoc.Fields[18].Description = "Virtual (shared) IP address configuration."
+	DeviceDoc.Fields[18].Comments[encoder.LineComment] = "Virtual (shared) IP address configuration."
+
+	DeviceDoc.Fields[18].AddExample("layer2 vip example", networkConfigVIPLayer2Example)
 
 	DHCPOptionsDoc.Type = "DHCPOptions"
 	DHCPOptionsDoc.Comments[encoder.LineComment] = "DHCPOptions contains options for configuring the DHCP settings for a given interface."
@@ -2129,6 +2228,111 @@ func init() {
 	BridgeDoc.Fields[1].Description = "A bridge option.\nPlease see the official kernel documentation."
 	BridgeDoc.Fields[1].Comments[encoder.LineComment] = "A bridge option."
 
+	VXLANDoc.Type = "VXLAN"
+	VXLANDoc.Comments[encoder.LineComment] = "VXLAN contains the various options for configuring a VXLAN interface."
+	VXLANDoc.Description = "VXLAN contains the various options for configuring a VXLAN interface."
+
+	VXLANDoc.AddExample("", networkConfigVXLANExample)
+	VXLANDoc.AppearsIn = []encoder.Appearance{
+		{
+			TypeName:  "Device",
+			FieldName: "vxlan",
+		},
+	}
+	VXLANDoc.Fields = make([]encoder.Doc, 6)
+	VXLANDoc.Fields[0].Name = "vni"
+	VXLANDoc.Fields[0].Type = "uint32"
+	VXLANDoc.Fields[0].Note = ""
+	VXLANDoc.Fields[0].Description = "The VXLAN network identifier (1-16777215)."
+	VXLANDoc.Fields[0].Comments[encoder.LineComment] = "The VXLAN network identifier (1-16777215)."
+	VXLANDoc.Fields[1].Name = "remote"
+	VXLANDoc.Fields[1].Type = "string"
+	VXLANDoc.Fields[1].Note = ""
+	VXLANDoc.Fields[1].Description = "The unicast address of the remote VXLAN endpoint.\nMutually exclusive with `group`."
+	VXLANDoc.Fields[1].Comments[encoder.LineComment] = "The unicast address of the remote VXLAN endpoint."
+	VXLANDoc.Fields[2].Name = "group"
+	VXLANDoc.Fields[2].Type = "string"
+	VXLANDoc.Fields[2].Note = ""
+	VXLANDoc.Fields[2].Description = "The multicast group address to join.\nRequires `parent` to be set, mutually exclusive with `remote`."
+	VXLANDoc.Fields[2].Comments[encoder.LineComment] = "The multicast group address to join."
+	VXLANDoc.Fields[3].Name = "local"
+	VXLANDoc.Fields[3].Type = "string"
+	VXLANDoc.Fields[3].Note = ""
+	VXLANDoc.Fields[3].Description = "The source address of the encapsulated packets."
+	VXLANDoc.Fields[3].Comments[encoder.LineComment] = "The source address of the encapsulated packets."
+	VXLANDoc.Fields[4].Name = "port"
+	VXLANDoc.Fields[4].Type = "uint16"
+	VXLANDoc.Fields[4].Note = ""
+	VXLANDoc.Fields[4].Description = "The UDP destination port.\nDefaults to the IANA assigned port 4789."
+	VXLANDoc.Fields[4].Comments[encoder.LineComment] = "The UDP destination port."
+	VXLANDoc.Fields[5].Name = "parent"
+	VXLANDoc.Fields[5].Type = "string"
+	VXLANDoc.Fields[5].Note = ""
+	VXLANDoc.Fields[5].Description = "The interface used to send the encapsulated packets."
+	VXLANDoc.Fields[5].Comments[encoder.LineComment] = "The interface used to send the encapsulated packets."
+
+	MACVLANDoc.Type = "MACVLAN"
+	MACVLANDoc.Comments[encoder.LineComment] = "MACVLAN contains the various options for configuring a macvlan or macvtap interface."
+	MACVLANDoc.Description = "MACVLAN contains the various options for configuring a macvlan or macvtap interface."
+
+	MACVLANDoc.AddExample("", networkConfigMACVLANExample)
+	MACVLANDoc.AppearsIn = []encoder.Appearance{
+		{
+			TypeName:  "Device",
+			FieldName: "macvlan",
+		},
+		{
+			TypeName:  "Device",
+			FieldName: "macvtap",
+		},
+	}
+	MACVLANDoc.Fields = make([]encoder.Doc, 2)
+	MACVLANDoc.Fields[0].Name = "parent"
+	MACVLANDoc.Fields[0].Type = "string"
+	MACVLANDoc.Fields[0].Note = ""
+	MACVLANDoc.Fields[0].Description = "The parent interface."
+	MACVLANDoc.Fields[0].Comments[encoder.LineComment] = "The parent interface."
+	MACVLANDoc.Fields[1].Name = "mode"
+	MACVLANDoc.Fields[1].Type = "string"
+	MACVLANDoc.Fields[1].Note = ""
+	MACVLANDoc.Fields[1].Description = "The macvlan mode (default is `bridge`)."
+	MACVLANDoc.Fields[1].Comments[encoder.LineComment] = "The macvlan mode (default is `bridge`)."
+	MACVLANDoc.Fields[1].Values = []string{
+		"private",
+		"vepa",
+		"bridge",
+		"passthru",
+		"source",
+	}
+
+	IPVLANDoc.Type = "IPVLAN"
+	IPVLANDoc.Comments[encoder.LineComment] = "IPVLAN contains the various options for configuring an ipvlan interface."
+	IPVLANDoc.Description = "IPVLAN contains the various options for configuring an ipvlan interface."
+
+	IPVLANDoc.AddExample("", networkConfigIPVLANExample)
+	IPVLANDoc.AppearsIn = []encoder.Appearance{
+		{
+			TypeName:  "Device",
+			FieldName: "ipvlan",
+		},
+	}
+	IPVLANDoc.Fields = make([]encoder.Doc, 2)
+	IPVLANDoc.Fields[0].Name = "parent"
+	IPVLANDoc.Fields[0].Type = "string"
+	IPVLANDoc.Fields[0].Note = ""
+	IPVLANDoc.Fields[0].Description = "The parent interface."
+	IPVLANDoc.Fields[0].Comments[encoder.LineComment] = "The parent interface."
+	IPVLANDoc.Fields[1].Name = "mode"
+	IPVLANDoc.Fields[1].Type = "string"
+	IPVLANDoc.Fields[1].Note = ""
+	IPVLANDoc.Fields[1].Description = "The ipvlan mode (default is `l2`)."
+	IPVLANDoc.Fields[1].Comments[encoder.LineComment] = "The ipvlan mode (default is `l2`)."
+	IPVLANDoc.Fields[1].Values = []string{
+		"l2",
+		"l3",
+		"l3s",
+	}
+
 	VlanDoc.Type = "Vlan"
 	VlanDoc.Comments[encoder.LineComment] = "Vlan represents vlan settings for a device."
 	VlanDoc.Description = "Vlan represents vlan settings for a device."
@@ -2190,7 +2394,7 @@ func init() {
 			FieldName: "routes",
 		},
 	}
-	RouteDoc.Fields = make([]encoder.Doc, 5)
+	RouteDoc.Fields = make([]encoder.Doc, 6)
 	RouteDoc.Fields[0].Name = "network"
 	RouteDoc.Fields[0].Type = "string"
 	RouteDoc.Fields[0].Note = ""
@@ -2216,6 +2420,11 @@ func init() {
 	RouteDoc.Fields[4].Note = ""
 	RouteDoc.Fields[4].Description = "The optional MTU for the route."
 	RouteDoc.Fields[4].Comments[encoder.LineComment] = "The optional MTU for the route."
+	RouteDoc.Fields[5].Name = "table"
+	RouteDoc.Fields[5].Type = "uint32"
+	RouteDoc.Fields[5].Note = ""
+	RouteDoc.Fields[5].Description = "The optional routing table for the route, defaults to the main table.\nThe routes in the other tables are selected with the routing rules."
+	RouteDoc.Fields[5].Comments[encoder.LineComment] = "The optional routing table for the route, defaults to the main table."
 
 	RegistryMirrorConfigDoc.Type = "RegistryMirrorConfig"
 	RegistryMirrorConfigDoc.Comments[encoder.LineComment] = "RegistryMirrorConfig represents mirror configuration for a registry."
@@ -2368,7 +2577,7 @@ func init() {
 			FieldName: "features",
 		},
 	}
-	FeaturesConfigDoc.Fields = make([]encoder.Doc, 4)
+	FeaturesConfigDoc.Fields = make([]encoder.Doc, 5)
 	FeaturesConfigDoc.Fields[0].Name = "rbac"
 	FeaturesConfigDoc.Fields[0].Type = "bool"
 	FeaturesConfigDoc.Fields[0].Note = ""
@@ -2391,6 +2600,13 @@ func init() {
 	FeaturesConfigDoc.Fields[3].Note = ""
 	FeaturesConfigDoc.Fields[3].Description = "Enable checks for extended key usage of client certificates in apid."
 	FeaturesConfigDoc.Fields[3].Comments[encoder.LineComment] = "Enable checks for extended key usage of client certificates in apid."
+	FeaturesConfigDoc.Fields[4].Name = "hostDNS"
+	FeaturesConfigDoc.Fields[4].Type = "HostDNSConfig"
+	FeaturesConfigDoc.Fields[4].Note = ""
+	FeaturesConfigDoc.Fields[4].Description = "Configure the caching DNS resolver on the host."
+	FeaturesConfigDoc.Fields[4].Comments[encoder.LineComment] = "Configure the caching DNS resolver on the host."
+
+	FeaturesConfigDoc.Fields[4].AddExample("", hostDNSConfigExample)
 
 	KubernetesTalosAPIAccessConfigDoc.Type = "KubernetesTalosAPIAccessConfig"
 	KubernetesTalosAPIAccessConfigDoc.Comments[encoder.LineComment] = "KubernetesTalosAPIAccessConfig describes the configuration for the Talos API access from Kubernetes pods."
@@ -2420,6 +2636,24 @@ func init() {
 	KubernetesTalosAPIAccessConfigDoc.Fields[2].Description = "The list of Kubernetes namespaces Talos API access is available from."
 	KubernetesTalosAPIAccessConfigDoc.Fields[2].Comments[encoder.LineComment] = "The list of Kubernetes namespaces Talos API access is available from."
 
+	HostDNSConfigDoc.Type = "HostDNSConfig"
+	HostDNSConfigDoc.Comments[encoder.LineComment] = "HostDNSConfig describes the configuration for the caching DNS resolver on the host."
+	HostDNSConfigDoc.Description = "HostDNSConfig describes the configuration for the caching DNS resolver on the host."
+
+	HostDNSConfigDoc.AddExample("", hostDNSConfigExample)
+	HostDNSConfigDoc.AppearsIn = []encoder.Appearance{
+		{
+			TypeName:  "FeaturesConfig",
+			FieldName: "hostDNS",
+		},
+	}
+	HostDNSConfigDoc.Fields = make([]encoder.Doc, 1)
+	HostDNSConfigDoc.Fields[0].Name = "enabled"
+	HostDNSConfigDoc.Fields[0].Type = "bool"
+	HostDNSConfigDoc.Fields[0].Note = ""
+	HostDNSConfigDoc.Fields[0].Description = "Enable the caching DNS resolver on the host.\n\nThe resolver listens on `127.0.0.53`, forwards the queries to the configured nameservers\nand resolves the node hostname and `extraHostEntries` locally.\nThe host `/etc/resolv.conf` points to the resolver, while the pods keep using the upstream nameservers."
+	HostDNSConfigDoc.Fields[0].Comments[encoder.LineComment] = "Enable the caching DNS resolver on the host."
+
 	VolumeMountConfigDoc.Type = "VolumeMountConfig"
 	VolumeMountConfigDoc.Comments[encoder.LineComment] = "VolumeMountConfig struct describes extra volume mount for the static pods."
 	VolumeMountConfigDoc.Description = "VolumeMountConfig struct describes extra volume mount for the static pods."
@@ -2553,6 +2787,10 @@ func init() {
 			TypeName:  "Bond",
 			FieldName: "deviceSelectors",
 		},
+		{
+			TypeName:  "EthernetConfig",
+			FieldName: "deviceSelector",
+		},
 	}
 	NetworkDeviceSelectorDoc.Fields = make([]encoder.Doc, 4)
 	NetworkDeviceSelectorDoc.Fields[0].Name = "busPath"
@@ -2761,6 +2999,411 @@ func init() {
 	KernelModuleConfigDoc.Fields[1].Note = ""
 	KernelModuleConfigDoc.Fields[1].Description = "Module parameters, changes applied after reboot."
 	KernelModuleConfigDoc.Fields[1].Comments[encoder.LineComment] = "Module parameters, changes applied after reboot."
+
+	FanConfigDoc.Type = "FanConfig"
+	FanConfigDoc.Comments[encoder.LineComment] = "FanConfig struct configures the PWM fan control."
+	FanConfigDoc.Description = "FanConfig struct configures the PWM fan control."
+
+	FanConfigDoc.AddExample("", machineFanExample)
+	FanConfigDoc.AppearsIn = []encoder.Appearance{
+		{
+			TypeName:  "MachineConfig",
+			FieldName: "fan",
+		},
+	}
+	FanConfigDoc.Fields = make([]encoder.Doc, 3)
+	FanConfigDoc.Fields[0].Name = "hwmon"
+	FanConfigDoc.Fields[0].Type = "string"
+	FanConfigDoc.Fields[0].Note = ""
+	FanConfigDoc.Fields[0].Description = "Name of the hwmon device driving the fan (contents of `/sys/class/hwmon/hwmon*/name`)."
+	FanConfigDoc.Fields[0].Comments[encoder.LineComment] = "Name of the hwmon device driving the fan (contents of `/sys/class/hwmon/hwmon*/name`)."
+
+	FanConfigDoc.Fields[0].AddExample("", "pwmfan")
+	FanConfigDoc.Fields[1].Name = "thermalZone"
+	FanConfigDoc.Fields[1].Type = "string"
+	FanConfigDoc.Fields[1].Note = ""
+	FanConfigDoc.Fields[1].Description = "Type of the thermal zone to follow (contents of `/sys/class/thermal/thermal_zone*/type`).\nIf not set, the hottest thermal zone is used."
+	FanConfigDoc.Fields[1].Comments[encoder.LineComment] = "Type of the thermal zone to follow (contents of `/sys/class/thermal/thermal_zone*/type`)."
+
+	FanConfigDoc.Fields[1].AddExample("", "soc-thermal")
+	FanConfigDoc.Fields[2].Name = "curve"
+	FanConfigDoc.Fields[2].Type = "[]FanCurvePoint"
+	FanConfigDoc.Fields[2].Note = ""
+	FanConfigDoc.Fields[2].Description = "Fan curve points, sorted by the temperature.\nFan speed is interpolated linearly between the points, below the first point the first point speed is used,\nabove the last point the last point speed is used."
+	FanConfigDoc.Fields[2].Comments[encoder.LineComment] = "Fan curve points, sorted by the temperature."
+
+	WatchdogConfigDoc.Type = "WatchdogConfig"
+	WatchdogConfigDoc.Comments[encoder.LineComment] = "WatchdogConfig struct configures the hardware watchdog timer."
+	WatchdogConfigDoc.Description = "WatchdogConfig struct configures the hardware watchdog timer."
+
+	WatchdogConfigDoc.AddExample("", machineWatchdogExample)
+	WatchdogConfigDoc.AppearsIn = []encoder.Appearance{
+		{
+			TypeName:  "MachineConfig",
+			FieldName: "watchdog",
+		},
+	}
+	WatchdogConfigDoc.Fields = make([]encoder.Doc, 2)
+	WatchdogConfigDoc.Fields[0].Name = "device"
+	WatchdogConfigDoc.Fields[0].Type = "string"
+	WatchdogConfigDoc.Fields[0].Note = ""
+	WatchdogConfigDoc.Fields[0].Description = "Path to the watchdog device.\nDefaults to `/dev/watchdog0`."
+	WatchdogConfigDoc.Fields[0].Comments[encoder.LineComment] = "Path to the watchdog device."
+
+	WatchdogConfigDoc.Fields[0].AddExample("", "/dev/watchdog0")
+	WatchdogConfigDoc.Fields[1].Name = "timeout"
+	WatchdogConfigDoc.Fields[1].Type = "Duration"
+	WatchdogConfigDoc.Fields[1].Note = ""
+	WatchdogConfigDoc.Fields[1].Description = "Timeout after which the machine is reset if the watchdog is not fed.\nThe watchdog is fed every quarter of the timeout.\nDefaults to 1 minute, minimum value is 10 seconds."
+	WatchdogConfigDoc.Fields[1].Comments[encoder.LineComment] = "Timeout after which the machine is reset if the watchdog is not fed."
+
+	LEDConfigDoc.Type = "LEDConfig"
+	LEDConfigDoc.Comments[encoder.LineComment] = "LEDConfig struct configures an LED to indicate the machine stage."
+	LEDConfigDoc.Description = "LEDConfig struct configures an LED to indicate the machine stage."
+
+	LEDConfigDoc.AddExample("", machineLEDsExample)
+	LEDConfigDoc.AppearsIn = []encoder.Appearance{
+		{
+			TypeName:  "MachineConfig",
+			FieldName: "leds",
+		},
+	}
+	LEDConfigDoc.Fields = make([]encoder.Doc, 2)
+	LEDConfigDoc.Fields[0].Name = "name"
+	LEDConfigDoc.Fields[0].Type = "string"
+	LEDConfigDoc.Fields[0].Note = ""
+	LEDConfigDoc.Fields[0].Description = "Name of the LED (directory name in `/sys/class/leds`)."
+	LEDConfigDoc.Fields[0].Comments[encoder.LineComment] = "Name of the LED (directory name in `/sys/class/leds`)."
+
+	LEDConfigDoc.Fields[0].AddExample("", "blue:status")
+	LEDConfigDoc.Fields[1].Name = "stages"
+	LEDConfigDoc.Fields[1].Type = "map[string]string"
+	LEDConfigDoc.Fields[1].Note = ""
+	LEDConfigDoc.Fields[1].Description = "LED pattern for each machine stage.\n\nStages are: `booting`, `installing`, `maintenance`, `running`, `rebooting`, `shutting down`, `resetting` and `upgrading`.\nPatterns are: `on`, `off`, `blink`, `blink-fast`, or the name of a kernel LED trigger (e.g. `heartbeat`).\nThe LED is not changed in the stages which are not listed."
+	LEDConfigDoc.Fields[1].Comments[encoder.LineComment] = "LED pattern for each machine stage."
+
+	FanCurvePointDoc.Type = "FanCurvePoint"
+	FanCurvePointDoc.Comments[encoder.LineComment] = "FanCurvePoint struct configures a point of the fan curve."
+	FanCurvePointDoc.Description = "FanCurvePoint struct configures a point of the fan curve."
+	FanCurvePointDoc.AppearsIn = []encoder.Appearance{
+		{
+			TypeName:  "FanConfig",
+			FieldName: "curve",
+		},
+	}
+	FanCurvePointDoc.Fields = make([]encoder.Doc, 2)
+	FanCurvePointDoc.Fields[0].Name = "temperature"
+	FanCurvePointDoc.Fields[0].Type = "int"
+	FanCurvePointDoc.Fields[0].Note = ""
+	FanCurvePointDoc.Fields[0].Description = "Temperature in degrees Celsius."
+	FanCurvePointDoc.Fields[0].Comments[encoder.LineComment] = "Temperature in degrees Celsius."
+	FanCurvePointDoc.Fields[1].Name = "speed"
+	FanCurvePointDoc.Fields[1].Type = "int"
+	FanCurvePointDoc.Fields[1].Note = ""
+	FanCurvePointDoc.Fields[1].Description = "Fan speed in percent (0-100)."
+	FanCurvePointDoc.Fields[1].Comments[encoder.LineComment] = "Fan speed in percent (0-100)."
+
+	NetworkFirewallConfigDoc.Type = "NetworkFirewallConfig"
+	NetworkFirewallConfigDoc.Comments[encoder.LineComment] = "NetworkFirewallConfig struct configures the host ingress firewall."
+	NetworkFirewallConfigDoc.Description = "NetworkFirewallConfig struct configures the host ingress firewall."
+
+	NetworkFirewallConfigDoc.AddExample("", networkFirewallExample)
+	NetworkFirewallConfigDoc.AppearsIn = []encoder.Appearance{
+		{
+			TypeName:  "NetworkConfig",
+			FieldName: "firewall",
+		},
+	}
+	NetworkFirewallConfigDoc.Fields = make([]encoder.Doc, 3)
+	NetworkFirewallConfigDoc.Fields[0].Name = "defaultAction"
+	NetworkFirewallConfigDoc.Fields[0].Type = "string"
+	NetworkFirewallConfigDoc.Fields[0].Note = ""
+	NetworkFirewallConfigDoc.Fields[0].Description = "Action for the ingress traffic which is not matched by any rule.\n\nLoopback traffic, ICMP and the replies to the connections initiated by the node are always accepted.\nDefault value is `accept`."
+	NetworkFirewallConfigDoc.Fields[0].Comments[encoder.LineComment] = "Action for the ingress traffic which is not matched by any rule."
+	NetworkFirewallConfigDoc.Fields[0].Values = []string{
+		"accept",
+		"block",
+	}
+	NetworkFirewallConfigDoc.Fields[1].Name = "disableDefaultRules"
+	NetworkFirewallConfigDoc.Fields[1].Type = "bool"
+	NetworkFirewallConfigDoc.Fields[1].Note = ""
+	NetworkFirewallConfigDoc.Fields[1].Description = "Disable the default rules for the machine type.\n\nBy default, apid is allowed from any address, the kubelet API is allowed from the cluster members and pod subnets,\ncontrol plane nodes allow trustd and the Kubernetes API server from any address, and etcd from the control plane members only.\nThe cluster members are taken from the cluster discovery, if the discovery is disabled, the node subnets are used instead,\nand etcd is not allowed by the default rules, it should be allowed with the `etcd` rule.\nA rule with the same name as a default rule (`apid`, `trustd`, `kube-apiserver`, `etcd`, `kubelet`, `kubespan`, `cni-vxlan`) replaces it."
+	NetworkFirewallConfigDoc.Fields[1].Comments[encoder.LineComment] = "Disable the default rules for the machine type."
+	NetworkFirewallConfigDoc.Fields[2].Name = "rules"
+	NetworkFirewallConfigDoc.Fields[2].Type = "[]NetworkRule"
+	NetworkFirewallConfigDoc.Fields[2].Note = ""
+	NetworkFirewallConfigDoc.Fields[2].Description = "Ingress rules.\n\nThe traffic matched by the rule port selector is accepted from the rule subnets, and dropped from other addresses."
+	NetworkFirewallConfigDoc.Fields[2].Comments[encoder.LineComment] = "Ingress rules."
+
+	EthernetConfigDoc.Type = "EthernetConfig"
+	EthernetConfigDoc.Comments[encoder.LineComment] = "EthernetConfig struct configures the Ethernet settings of the links."
+	EthernetConfigDoc.Description = "EthernetConfig struct configures the Ethernet settings of the links."
+
+	EthernetConfigDoc.AddExample("", networkEthernetExample)
+	EthernetConfigDoc.AppearsIn = []encoder.Appearance{
+		{
+			TypeName:  "NetworkConfig",
+			FieldName: "ethernet",
+		},
+	}
+	EthernetConfigDoc.Fields = make([]encoder.Doc, 6)
+	EthernetConfigDoc.Fields[0].Name = "interface"
+	EthernetConfigDoc.Fields[0].Type = "string"
+	EthernetConfigDoc.Fields[0].Note = ""
+	EthernetConfigDoc.Fields[0].Description = "The interface name.\nMutually exclusive with `deviceSelector`."
+	EthernetConfigDoc.Fields[0].Comments[encoder.LineComment] = "The interface name."
+
+	EthernetConfigDoc.Fields[0].AddExample("", "enp1s0")
+	EthernetConfigDoc.Fields[1].Name = "deviceSelector"
+	EthernetConfigDoc.Fields[1].Type = "NetworkDeviceSelector"
+	EthernetConfigDoc.Fields[1].Note = ""
+	EthernetConfigDoc.Fields[1].Description = "Picks the links using the selector.\nThe settings are applied to all the matching links.\nMutually exclusive with `interface`."
+	EthernetConfigDoc.Fields[1].Comments[encoder.LineComment] = "Picks the links using the selector."
+	EthernetConfigDoc.Fields[2].Name = "rings"
+	EthernetConfigDoc.Fields[2].Type = "EthernetRingsConfig"
+	EthernetConfigDoc.Fields[2].Note = ""
+	EthernetConfigDoc.Fields[2].Description = "Ring buffer sizes (`ethtool -G`)."
+	EthernetConfigDoc.Fields[2].Comments[encoder.LineComment] = "Ring buffer sizes (`ethtool -G`)."
+	EthernetConfigDoc.Fields[3].Name = "channels"
+	EthernetConfigDoc.Fields[3].Type = "EthernetChannelsConfig"
+	EthernetConfigDoc.Fields[3].Note = ""
+	EthernetConfigDoc.Fields[3].Description = "Number of channels (`ethtool -L`)."
+	EthernetConfigDoc.Fields[3].Comments[encoder.LineComment] = "Number of channels (`ethtool -L`)."
+	EthernetConfigDoc.Fields[4].Name = "features"
+	EthernetConfigDoc.Fields[4].Type = "map[string]bool"
+	EthernetConfigDoc.Fields[4].Note = ""
+	EthernetConfigDoc.Fields[4].Description = "Features (offloads) to enable or disable (`ethtool -K`).\n\nThe feature names are the kernel names as reported by `ethtool -k`, e.g. `tx-tcp-segmentation`,\n`generic-receive-offload` or `rx-checksum`."
+	EthernetConfigDoc.Fields[4].Comments[encoder.LineComment] = "Features (offloads) to enable or disable (`ethtool -K`)."
+
+	EthernetConfigDoc.Fields[4].AddExample("", map[string]bool{"tx-tcp-segmentation": false, "generic-receive-offload": true})
+	EthernetConfigDoc.Fields[5].Name = "pause"
+	EthernetConfigDoc.Fields[5].Type = "EthernetPauseConfig"
+	EthernetConfigDoc.Fields[5].Note = ""
+	EthernetConfigDoc.Fields[5].Description = "Pause frames settings (`ethtool -A`)."
+	EthernetConfigDoc.Fields[5].Comments[encoder.LineComment] = "Pause frames settings (`ethtool -A`)."
+
+	EthernetRingsConfigDoc.Type = "EthernetRingsConfig"
+	EthernetRingsConfigDoc.Comments[encoder.LineComment] = "EthernetRingsConfig struct configures the ring buffer sizes."
+	EthernetRingsConfigDoc.Description = "EthernetRingsConfig struct configures the ring buffer sizes."
+	EthernetRingsConfigDoc.AppearsIn = []encoder.Appearance{
+		{
+			TypeName:  "EthernetConfig",
+			FieldName: "rings",
+		},
+	}
+	EthernetRingsConfigDoc.Fields = make([]encoder.Doc, 4)
+	EthernetRingsConfigDoc.Fields[0].Name = "rx"
+	EthernetRingsConfigDoc.Fields[0].Type = "uint32"
+	EthernetRingsConfigDoc.Fields[0].Note = ""
+	EthernetRingsConfigDoc.Fields[0].Description = "Number of entries in the RX ring."
+	EthernetRingsConfigDoc.Fields[0].Comments[encoder.LineComment] = "Number of entries in the RX ring."
+	EthernetRingsConfigDoc.Fields[1].Name = "rxMini"
+	EthernetRingsConfigDoc.Fields[1].Type = "uint32"
+	EthernetRingsConfigDoc.Fields[1].Note = ""
+	EthernetRingsConfigDoc.Fields[1].Description = "Number of entries in the RX mini ring."
+	EthernetRingsConfigDoc.Fields[1].Comments[encoder.LineComment] = "Number of entries in the RX mini ring."
+	EthernetRingsConfigDoc.Fields[2].Name = "rxJumbo"
+	EthernetRingsConfigDoc.Fields[2].Type = "uint32"
+	EthernetRingsConfigDoc.Fields[2].Note = ""
+	EthernetRingsConfigDoc.Fields[2].Description = "Number of entries in the RX jumbo ring."
+	EthernetRingsConfigDoc.Fields[2].Comments[encoder.LineComment] = "Number of entries in the RX jumbo ring."
+	EthernetRingsConfigDoc.Fields[3].Name = "tx"
+	EthernetRingsConfigDoc.Fields[3].Type = "uint32"
+	EthernetRingsConfigDoc.Fields[3].Note = ""
+	EthernetRingsConfigDoc.Fields[3].Description = "Number of entries in the TX ring."
+	EthernetRingsConfigDoc.Fields[3].Comments[encoder.LineComment] = "Number of entries in the TX ring."
+
+	EthernetChannelsConfigDoc.Type = "EthernetChannelsConfig"
+	EthernetChannelsConfigDoc.Comments[encoder.LineComment] = "EthernetChannelsConfig struct configures the number of channels."
+	EthernetChannelsConfigDoc.Description = "EthernetChannelsConfig struct configures the number of channels."
+	EthernetChannelsConfigDoc.AppearsIn = []encoder.Appearance{
+		{
+			TypeName:  "EthernetConfig",
+			FieldName: "channels",
+		},
+	}
+	EthernetChannelsConfigDoc.Fields = make([]encoder.Doc, 4)
+	EthernetChannelsConfigDoc.Fields[0].Name = "rx"
+	EthernetChannelsConfigDoc.Fields[0].Type = "uint32"
+	EthernetChannelsConfigDoc.Fields[0].Note = ""
+	EthernetChannelsConfigDoc.Fields[0].Description = "Number of RX channels."
+	EthernetChannelsConfigDoc.Fields[0].Comments[encoder.LineComment] = "Number of RX channels."
+	EthernetChannelsConfigDoc.Fields[1].Name = "tx"
+	EthernetChannelsConfigDoc.Fields[1].Type = "uint32"
+	EthernetChannelsConfigDoc.Fields[1].Note = ""
+	EthernetChannelsConfigDoc.Fields[1].Description = "Number of TX channels."
+	EthernetChannelsConfigDoc.Fields[1].Comments[encoder.LineComment] = "Number of TX channels."
+	EthernetChannelsConfigDoc.Fields[2].Name = "other"
+	EthernetChannelsConfigDoc.Fields[2].Type = "uint32"
+	EthernetChannelsConfigDoc.Fields[2].Note = ""
+	EthernetChannelsConfigDoc.Fields[2].Description = "Number of other channels."
+	EthernetChannelsConfigDoc.Fields[2].Comments[encoder.LineComment] = "Number of other channels."
+	EthernetChannelsConfigDoc.Fields[3].Name = "combined"
+	EthernetChannelsConfigDoc.Fields[3].Type = "uint32"
+	EthernetChannelsConfigDoc.Fields[3].Note = ""
+	EthernetChannelsConfigDoc.Fields[3].Description = "Number of combined channels."
+	EthernetChannelsConfigDoc.Fields[3].Comments[encoder.LineComment] = "Number of combined channels."
+
+	EthernetPauseConfigDoc.Type = "EthernetPauseConfig"
+	EthernetPauseConfigDoc.Comments[encoder.LineComment] = "EthernetPauseConfig struct configures the pause frames."
+	EthernetPauseConfigDoc.Description = "EthernetPauseConfig struct configures the pause frames."
+	EthernetPauseConfigDoc.AppearsIn = []encoder.Appearance{
+		{
+			TypeName:  "EthernetConfig",
+			FieldName: "pause",
+		},
+	}
+	EthernetPauseConfigDoc.Fields = make([]encoder.Doc, 3)
+	EthernetPauseConfigDoc.Fields[0].Name = "autonegotiate"
+	EthernetPauseConfigDoc.Fields[0].Type = "bool"
+	EthernetPauseConfigDoc.Fields[0].Note = ""
+	EthernetPauseConfigDoc.Fields[0].Description = "Negotiate the pause frames with the link partner."
+	EthernetPauseConfigDoc.Fields[0].Comments[encoder.LineComment] = "Negotiate the pause frames with the link partner."
+	EthernetPauseConfigDoc.Fields[1].Name = "rx"
+	EthernetPauseConfigDoc.Fields[1].Type = "bool"
+	EthernetPauseConfigDoc.Fields[1].Note = ""
+	EthernetPauseConfigDoc.Fields[1].Description = "Receive pause frames."
+	EthernetPauseConfigDoc.Fields[1].Comments[encoder.LineComment] = "Receive pause frames."
+	EthernetPauseConfigDoc.Fields[2].Name = "tx"
+	EthernetPauseConfigDoc.Fields[2].Type = "bool"
+	EthernetPauseConfigDoc.Fields[2].Note = ""
+	EthernetPauseConfigDoc.Fields[2].Description = "Transmit pause frames."
+	EthernetPauseConfigDoc.Fields[2].Comments[encoder.LineComment] = "Transmit pause frames."
+
+	RoutingRuleConfigDoc.Type = "RoutingRuleConfig"
+	RoutingRuleConfigDoc.Comments[encoder.LineComment] = "RoutingRuleConfig struct describes a policy routing rule."
+	RoutingRuleConfigDoc.Description = "RoutingRuleConfig struct describes a policy routing rule."
+
+	RoutingRuleConfigDoc.AddExample("", networkRoutingRulesExample)
+	RoutingRuleConfigDoc.AppearsIn = []encoder.Appearance{
+		{
+			TypeName:  "NetworkConfig",
+			FieldName: "routingRules",
+		},
+	}
+	RoutingRuleConfigDoc.Fields = make([]encoder.Doc, 8)
+	RoutingRuleConfigDoc.Fields[0].Name = "source"
+	RoutingRuleConfigDoc.Fields[0].Type = "string"
+	RoutingRuleConfigDoc.Fields[0].Note = ""
+	RoutingRuleConfigDoc.Fields[0].Description = "Matches the source address of the packets, in CIDR notation.\nIf not set, any source address is matched."
+	RoutingRuleConfigDoc.Fields[0].Comments[encoder.LineComment] = "Matches the source address of the packets, in CIDR notation."
+
+	RoutingRuleConfigDoc.Fields[0].AddExample("", "10.10.0.0/24")
+	RoutingRuleConfigDoc.Fields[1].Name = "destination"
+	RoutingRuleConfigDoc.Fields[1].Type = "string"
+	RoutingRuleConfigDoc.Fields[1].Note = ""
+	RoutingRuleConfigDoc.Fields[1].Description = "Matches the destination address of the packets, in CIDR notation.\nIf not set, any destination address is matched."
+	RoutingRuleConfigDoc.Fields[1].Comments[encoder.LineComment] = "Matches the destination address of the packets, in CIDR notation."
+	RoutingRuleConfigDoc.Fields[2].Name = "iif"
+	RoutingRuleConfigDoc.Fields[2].Type = "string"
+	RoutingRuleConfigDoc.Fields[2].Note = ""
+	RoutingRuleConfigDoc.Fields[2].Description = "Matches the packets received on the interface.\nThe packets originated from the node are matched with `lo`."
+	RoutingRuleConfigDoc.Fields[2].Comments[encoder.LineComment] = "Matches the packets received on the interface."
+	RoutingRuleConfigDoc.Fields[3].Name = "oif"
+	RoutingRuleConfigDoc.Fields[3].Type = "string"
+	RoutingRuleConfigDoc.Fields[3].Note = ""
+	RoutingRuleConfigDoc.Fields[3].Description = "Matches the packets sent out of the interface.\nOnly the packets from the sockets bound to the interface are matched."
+	RoutingRuleConfigDoc.Fields[3].Comments[encoder.LineComment] = "Matches the packets sent out of the interface."
+	RoutingRuleConfigDoc.Fields[4].Name = "fwMark"
+	RoutingRuleConfigDoc.Fields[4].Type = "uint32"
+	RoutingRuleConfigDoc.Fields[4].Note = ""
+	RoutingRuleConfigDoc.Fields[4].Description = "Matches the packets with the firewall mark."
+	RoutingRuleConfigDoc.Fields[4].Comments[encoder.LineComment] = "Matches the packets with the firewall mark."
+	RoutingRuleConfigDoc.Fields[5].Name = "fwMask"
+	RoutingRuleConfigDoc.Fields[5].Type = "uint32"
+	RoutingRuleConfigDoc.Fields[5].Note = ""
+	RoutingRuleConfigDoc.Fields[5].Description = "The mask applied to the firewall mark before matching, defaults to `0xffffffff`."
+	RoutingRuleConfigDoc.Fields[5].Comments[encoder.LineComment] = "The mask applied to the firewall mark before matching, defaults to `0xffffffff`."
+	RoutingRuleConfigDoc.Fields[6].Name = "table"
+	RoutingRuleConfigDoc.Fields[6].Type = "uint32"
+	RoutingRuleConfigDoc.Fields[6].Note = ""
+	RoutingRuleConfigDoc.Fields[6].Description = "The routing table to look up."
+	RoutingRuleConfigDoc.Fields[6].Comments[encoder.LineComment] = "The routing table to look up."
+	RoutingRuleConfigDoc.Fields[7].Name = "priority"
+	RoutingRuleConfigDoc.Fields[7].Type = "uint32"
+	RoutingRuleConfigDoc.Fields[7].Note = ""
+	RoutingRuleConfigDoc.Fields[7].Description = "The priority of the rule, the rules are evaluated from the lowest to the highest priority.\nThe priority should be unique, the main routing table is looked up at priority 32766."
+	RoutingRuleConfigDoc.Fields[7].Comments[encoder.LineComment] = "The priority of the rule, the rules are evaluated from the lowest to the highest priority."
+
+	NetworkRuleDoc.Type = "NetworkRule"
+	NetworkRuleDoc.Comments[encoder.LineComment] = "NetworkRule struct describes an ingress rule of the host firewall."
+	NetworkRuleDoc.Description = "NetworkRule struct describes an ingress rule of the host firewall."
+	NetworkRuleDoc.AppearsIn = []encoder.Appearance{
+		{
+			TypeName:  "NetworkFirewallConfig",
+			FieldName: "rules",
+		},
+	}
+	NetworkRuleDoc.Fields = make([]encoder.Doc, 3)
+	NetworkRuleDoc.Fields[0].Name = "name"
+	NetworkRuleDoc.Fields[0].Type = "string"
+	NetworkRuleDoc.Fields[0].Note = ""
+	NetworkRuleDoc.Fields[0].Description = "Name of the rule."
+	NetworkRuleDoc.Fields[0].Comments[encoder.LineComment] = "Name of the rule."
+	NetworkRuleDoc.Fields[1].Name = "portSelector"
+	NetworkRuleDoc.Fields[1].Type = "NetworkRulePortSelector"
+	NetworkRuleDoc.Fields[1].Note = ""
+	NetworkRuleDoc.Fields[1].Description = "Ports and the protocol the rule applies to."
+	NetworkRuleDoc.Fields[1].Comments[encoder.LineComment] = "Ports and the protocol the rule applies to."
+	NetworkRuleDoc.Fields[2].Name = "ingress"
+	NetworkRuleDoc.Fields[2].Type = "[]NetworkRuleIngress"
+	NetworkRuleDoc.Fields[2].Note = ""
+	NetworkRuleDoc.Fields[2].Description = "Subnets the traffic is accepted from.\nIf not set, the traffic is accepted from any address."
+	NetworkRuleDoc.Fields[2].Comments[encoder.LineComment] = "Subnets the traffic is accepted from."
+
+	NetworkRulePortSelectorDoc.Type = "NetworkRulePortSelector"
+	NetworkRulePortSelectorDoc.Comments[encoder.LineComment] = "NetworkRulePortSelector struct selects the ports the rule applies to."
+	NetworkRulePortSelectorDoc.Description = "NetworkRulePortSelector struct selects the ports the rule applies to."
+	NetworkRulePortSelectorDoc.AppearsIn = []encoder.Appearance{
+		{
+			TypeName:  "NetworkRule",
+			FieldName: "portSelector",
+		},
+	}
+	NetworkRulePortSelectorDoc.Fields = make([]encoder.Doc, 2)
+	NetworkRulePortSelectorDoc.Fields[0].Name = "ports"
+	NetworkRulePortSelectorDoc.Fields[0].Type = "[]string"
+	NetworkRulePortSelectorDoc.Fields[0].Note = ""
+	NetworkRulePortSelectorDoc.Fields[0].Description = "Ports or port ranges.\nIf not set, the rule applies to all the traffic of the protocol."
+	NetworkRulePortSelectorDoc.Fields[0].Comments[encoder.LineComment] = "Ports or port ranges."
+
+	NetworkRulePortSelectorDoc.Fields[0].AddExample("", []string{"80", "30000-32767"})
+	NetworkRulePortSelectorDoc.Fields[1].Name = "protocol"
+	NetworkRulePortSelectorDoc.Fields[1].Type = "string"
+	NetworkRulePortSelectorDoc.Fields[1].Note = ""
+	NetworkRulePortSelectorDoc.Fields[1].Description = "Protocol."
+	NetworkRulePortSelectorDoc.Fields[1].Comments[encoder.LineComment] = "Protocol."
+	NetworkRulePortSelectorDoc.Fields[1].Values = []string{
+		"tcp",
+		"udp",
+		"icmp",
+		"icmpv6",
+	}
+
+	NetworkRuleIngressDoc.Type = "NetworkRuleIngress"
+	NetworkRuleIngressDoc.Comments[encoder.LineComment] = "NetworkRuleIngress struct describes a subnet the traffic is accepted from."
+	NetworkRuleIngressDoc.Description = "NetworkRuleIngress struct describes a subnet the traffic is accepted from."
+	NetworkRuleIngressDoc.AppearsIn = []encoder.Appearance{
+		{
+			TypeName:  "NetworkRule",
+			FieldName: "ingress",
+		},
+	}
+	NetworkRuleIngressDoc.Fields = make([]encoder.Doc, 2)
+	NetworkRuleIngressDoc.Fields[0].Name = "subnet"
+	NetworkRuleIngressDoc.Fields[0].Type = "string"
+	NetworkRuleIngressDoc.Fields[0].Note = ""
+	NetworkRuleIngressDoc.Fields[0].Description = "Subnet in CIDR notation."
+	NetworkRuleIngressDoc.Fields[0].Comments[encoder.LineComment] = "Subnet in CIDR notation."
+
+	NetworkRuleIngressDoc.Fields[0].AddExample("", "192.168.0.0/16")
+	NetworkRuleIngressDoc.Fields[1].Name = "except"
+	NetworkRuleIngressDoc.Fields[1].Type = "string"
+	NetworkRuleIngressDoc.Fields[1].Note = ""
+	NetworkRuleIngressDoc.Fields[1].Description = "Subnet excluded from the subnet in CIDR notation."
+	NetworkRuleIngressDoc.Fields[1].Comments[encoder.LineComment] = "Subnet excluded from the subnet in CIDR notation."
+
+	NetworkRuleIngressDoc.Fields[1].AddExample("", "192.168.0.3/32")
 }
 
 func (_ Config) Doc() *encoder.Doc {
@@ -2955,6 +3598,18 @@ func (_ Bridge) Doc() *encoder.Doc {
 	return &BridgeDoc
 }
 
+func (_ VXLAN) Doc() *encoder.Doc {
+	return &VXLANDoc
+}
+
+func (_ MACVLAN) Doc() *encoder.Doc {
+	return &MACVLANDoc
+}
+
+func (_ IPVLAN) Doc() *encoder.Doc {
+	return &IPVLANDoc
+}
+
 func (_ Vlan) Doc() *encoder.Doc {
 	return &VlanDoc
 }
@@ -2991,6 +3646,10 @@ func (_ KubernetesTalosAPIAccessConfig) Doc() *encoder.Doc {
 	return &KubernetesTalosAPIAccessConfigDoc
 }
 
+func (_ HostDNSConfig) Doc() *encoder.Doc {
+	return &HostDNSConfigDoc
+}
+
 func (_ VolumeMountConfig) Doc() *encoder.Doc {
 	return &VolumeMountConfigDoc
 }
@@ -3047,6 +3706,58 @@ func (_ KernelModuleConfig) Doc() *encoder.Doc {
 	return &KernelModuleConfigDoc
 }
 
+func (_ FanConfig) Doc() *encoder.Doc {
+	return &FanConfigDoc
+}
+
+func (_ WatchdogConfig) Doc() *encoder.Doc {
+	return &WatchdogConfigDoc
+}
+
+func (_ LEDConfig) Doc() *encoder.Doc {
+	return &LEDConfigDoc
+}
+
+func (_ FanCurvePoint) Doc() *encoder.Doc {
+	return &FanCurvePointDoc
+}
+
+func (_ NetworkFirewallConfig) Doc() *encoder.Doc {
+	return &NetworkFirewallConfigDoc
+}
+
+func (_ EthernetConfig) Doc() *encoder.Doc {
+	return &EthernetConfigDoc
+}
+
+func (_ EthernetRingsConfig) Doc() *encoder.Doc {
+	return &EthernetRingsConfigDoc
+}
+
+func (_ EthernetChannelsConfig) Doc() *encoder.Doc {
+	return &EthernetChannelsConfigDoc
+}
+
+func (_ EthernetPauseConfig) Doc() *encoder.Doc {
+	return &EthernetPauseConfigDoc
+}
+
+func (_ RoutingRuleConfig) Doc() *encoder.Doc {
+	return &RoutingRuleConfigDoc
+}
+
+func (_ NetworkRule) Doc() *encoder.Doc {
+	return &NetworkRuleDoc
+}
+
+func (_ NetworkRulePortSelector) Doc() *encoder.Doc {
+	return &NetworkRulePortSelectorDoc
+}
+
+func (_ NetworkRuleIngress) Doc() *encoder.Doc {
+	return &NetworkRuleIngressDoc
+}
+
 // GetConfigurationDoc returns documentation for the file ./v1alpha1_types_doc.go.
 func GetConfigurationDoc() *encoder.FileDoc {
 	return &encoder.FileDoc{
@@ -3101,6 +3812,9 @@ func GetConfigurationDoc() *encoder.FileDoc {
 			&BondDoc,
 			&STPDoc,
 			&BridgeDoc,
+			&VXLANDoc,
+			&MACVLANDoc,
+			&IPVLANDoc,
 			&VlanDoc,
 			&RouteDoc,
 			&RegistryMirrorConfigDoc,
@@ -3110,6 +3824,7 @@ func GetConfigurationDoc() *encoder.FileDoc {
 			&SystemDiskEncryptionConfigDoc,
 			&FeaturesConfigDoc,
 			&KubernetesTalosAPIAccessConfigDoc,
+			&HostDNSConfigDoc,
 			&VolumeMountConfigDoc,
 			&ClusterInlineManifestDoc,
 			&NetworkKubeSpanDoc,
@@ -3124,6 +3839,19 @@ func GetConfigurationDoc() *encoder.FileDoc {
 			&LoggingDestinationDoc,
 			&KernelConfigDoc,
 			&KernelModuleConfigDoc,
+			&FanConfigDoc,
+			&WatchdogConfigDoc,
+			&LEDConfigDoc,
+			&FanCurvePointDoc,
+			&NetworkFirewallConfigDoc,
+			&EthernetConfigDoc,
+			&EthernetRingsConfigDoc,
+			&EthernetChannelsConfigDoc,
+			&EthernetPauseConfigDoc,
+			&RoutingRuleConfigDoc,
+			&NetworkRuleDoc,
+			&NetworkRulePortSelectorDoc,
+			&NetworkRuleIngressDoc,
 		},
 	}
 }
@@ -9,6 +9,7 @@ import (
 	"errors"
 	"fmt"
 	"net"
+	"net/netip"
 	"net/url"
 	"os"
 	"reflect"
@@ -198,6 +199,20 @@ func (c *Config) Validate(mode config.RuntimeMode, options ...config.ValidationO
 				result = multierror.Append(result, fmt.Errorf("kubespan link MTU must be at least %d", constants.KubeSpanLinkMinimumMTU))
 			}
 		}
+
+		if c.MachineConfig.MachineNetwork.NetworkFirewall != nil {
+			result = multierror.Append(result, c.MachineConfig.MachineNetwork.NetworkFirewall.Validate())
+		}
+
+		for i, ethernet := range c.MachineConfig.MachineNetwork.NetworkEthernet {
+			if err := ethernet.Validate(); err != nil {
+				result = multierror.Append(result, fmt.Errorf("ethernet config [%d]: %w", i, err))
+			}
+		}
+
+		if err := validateRoutingRules(c.MachineConfig.MachineNetwork.NetworkRoutingRules); err != nil {
+			result = multierror.Append(result, err)
+		}
 	}
 
 	if c.MachineConfig.MachineDisks != nil {
@@ -265,6 +280,28 @@ func (c *Config) Validate(mode config.RuntimeMode, options ...config.ValidationO
 		result = multierror.Append(result, err)
 	}
 
+	if c.MachineConfig.MachineFan != nil {
+		err := c.MachineConfig.MachineFan.Validate()
+		result = multierror.Append(result, err)
+	}
+
+	if c.MachineConfig.MachineWatchdog != nil {
+		err := c.MachineConfig.MachineWatchdog.Validate()
+		result = multierror.Append(result, err)
+	}
+
+	ledNames := map[string]struct{}{}
+
+	for _, led := range c.MachineConfig.MachineLEDs {
+		if _, exists := ledNames[led.LEDName]; exists {
+			result = multierror.Append(result, fmt.Errorf("duplicate LED %q", led.LEDName))
+		}
+
+		ledNames[led.LEDName] = struct{}{}
+
+		result = multierror.Append(result, led.Validate())
+	}
+
 	if c.MachineConfig.MachineInstall != nil {
 		extensions := map[string]struct{}{}
 
@@ -275,6 +312,16 @@ func (c *Config) Validate(mode config.RuntimeMode, options ...config.ValidationO
 
 			extensions[ext.Image()] = struct{}{}
 		}
+
+		for _, overlay := range c.MachineConfig.MachineInstall.InstallDeviceTreeOverlays {
+			if overlay == "" || strings.ContainsRune(overlay, '/') {
+				result = multierror.Append(result, fmt.Errorf("invalid device tree overlay name %q", overlay))
+			}
+		}
+
+		if target := c.MachineConfig.MachineInstall.InstallBootloaderTarget; target != "" && !rxMTDBlockDevice.MatchString(target) {
+			result = multierror.Append(result, fmt.Errorf("bootloader target %q should be a MTD block device (/dev/mtdblockN)", target))
+		}
 	}
 
 	if err := labels.Validate(c.MachineConfig.MachineNodeLabels); err != nil {
@@ -310,6 +357,8 @@ func (c *Config) Validate(mode config.RuntimeMode, options ...config.ValidationO
 
 var rxDNSName = regexp.MustCompile(`^([a-zA-Z0-9_]{1}[a-zA-Z0-9_-]{0,62}){1}(\.[a-zA-Z0-9_]{1}[a-zA-Z0-9_-]{0,62})*[\._]?$`)
 
+var rxMTDBlockDevice = regexp.MustCompile(`^/dev/mtdblock[0-9]+$`)
+
 func isValidDNSName(name string) bool {
 	if name == "" || len(name)-strings.Count(name, ".") > 255 {
 		return false
@@ -523,9 +572,142 @@ func CheckDeviceInterface(d *Device, _ map[string]string) ([]string, error) {
 		result = multierror.Append(result, checkVlans(d))
 	}
 
+	result = multierror.Append(result, checkLinkKinds(d))
+
+	if d.DeviceVXLAN != nil {
+		result = multierror.Append(result, checkVXLAN(d.DeviceVXLAN))
+	}
+
+	if d.DeviceMACVLAN != nil {
+		result = multierror.Append(result, checkMACVLAN("macvlan", d.DeviceMACVLAN))
+	}
+
+	if d.DeviceMACVTAP != nil {
+		result = multierror.Append(result, checkMACVLAN("macvtap", d.DeviceMACVTAP))
+	}
+
+	if d.DeviceIPVLAN != nil {
+		result = multierror.Append(result, checkIPVLAN(d.DeviceIPVLAN))
+	}
+
 	return nil, result.ErrorOrNil()
 }
 
+// checkLinkKinds verifies that VXLAN, macvlan, macvtap and ipvlan sections are not combined with other link kinds.
+func checkLinkKinds(d *Device) error {
+	var kinds, exclusiveKinds []string
+
+	for _, kind := range []struct {
+		name      string
+		set       bool
+		exclusive bool
+	}{
+		{"bond", d.DeviceBond != nil, false},
+		{"bridge", d.DeviceBridge != nil, false},
+		{"wireguard", d.DeviceWireguardConfig != nil, false},
+		{"dummy", d.Dummy(), false},
+		{"vxlan", d.DeviceVXLAN != nil, true},
+		{"macvlan", d.DeviceMACVLAN != nil, true},
+		{"macvtap", d.DeviceMACVTAP != nil, true},
+		{"ipvlan", d.DeviceIPVLAN != nil, true},
+	} {
+		if !kind.set {
+			continue
+		}
+
+		kinds = append(kinds, kind.name)
+
+		if kind.exclusive {
+			exclusiveKinds = append(exclusiveKinds, kind.name)
+		}
+	}
+
+	if len(exclusiveKinds) == 0 {
+		return nil
+	}
+
+	var result *multierror.Error
+
+	if len(kinds) > 1 {
+		result = multierror.Append(result, fmt.Errorf("[%s] %s: %s: %w", "networking.os.device", d.DeviceInterface, strings.Join(kinds, ", "), ErrMutuallyExclusive))
+	}
+
+	if d.DeviceSelector != nil {
+		result = multierror.Append(result, fmt.Errorf("[%s] %s requires the interface name, deviceSelector is not supported", "networking.os.device", exclusiveKinds[0]))
+	}
+
+	return result.ErrorOrNil()
+}
+
+//nolint:gocyclo
+func checkVXLAN(v *VXLAN) error {
+	var result *multierror.Error
+
+	if v.VXLANVNI == 0 || v.VXLANVNI > 1<<24-1 {
+		result = multierror.Append(result, fmt.Errorf("vxlan.vni should be in range 1-%d", 1<<24-1))
+	}
+
+	if v.VXLANRemote != "" && v.VXLANGroup != "" {
+		result = multierror.Append(result, fmt.Errorf("vxlan.remote and vxlan.group: %w", ErrMutuallyExclusive))
+	}
+
+	if v.VXLANRemote != "" {
+		if addr, err := netip.ParseAddr(v.VXLANRemote); err != nil {
+			result = multierror.Append(result, fmt.Errorf("vxlan.remote %q is invalid: %w", v.VXLANRemote, err))
+		} else if addr.IsMulticast() {
+			result = multierror.Append(result, fmt.Errorf("vxlan.remote %q should be a unicast address, use vxlan.group for multicast", v.VXLANRemote))
+		}
+	}
+
+	if v.VXLANGroup != "" {
+		if addr, err := netip.ParseAddr(v.VXLANGroup); err != nil {
+			result = multierror.Append(result, fmt.Errorf("vxlan.group %q is invalid: %w", v.VXLANGroup, err))
+		} else if !addr.IsMulticast() {
+			result = multierror.Append(result, fmt.Errorf("vxlan.group %q should be a multicast address", v.VXLANGroup))
+		}
+
+		if v.VXLANParent == "" {
+			result = multierror.Append(result, fmt.Errorf("vxlan.group requires vxlan.parent to be set"))
+		}
+	}
+
+	if v.VXLANLocal != "" {
+		if _, err := netip.ParseAddr(v.VXLANLocal); err != nil {
+			result = multierror.Append(result, fmt.Errorf("vxlan.local %q is invalid: %w", v.VXLANLocal, err))
+		}
+	}
+
+	return result.ErrorOrNil()
+}
+
+func checkMACVLAN(section string, m *MACVLAN) error {
+	var result *multierror.Error
+
+	if m.MACVLANParent == "" {
+		result = multierror.Append(result, fmt.Errorf("%s.parent is required", section))
+	}
+
+	if _, err := nethelpers.MACVLANModeString(m.Mode()); err != nil {
+		result = multierror.Append(result, fmt.Errorf("%s.mode %q is invalid", section, m.MACVLANMode))
+	}
+
+	return result.ErrorOrNil()
+}
+
+func checkIPVLAN(i *IPVLAN) error {
+	var result *multierror.Error
+
+	if i.IPVLANParent == "" {
+		result = multierror.Append(result, fmt.Errorf("ipvlan.parent is required"))
+	}
+
+	if _, err := nethelpers.IPVLANModeString(i.Mode()); err != nil {
+		result = multierror.Append(result, fmt.Errorf("ipvlan.mode %q is invalid", i.IPVLANMode))
+	}
+
+	return result.ErrorOrNil()
+}
+
 //nolint:gocyclo,cyclop
 func checkBond(b *Bond) error {
 	var result *multierror.Error
@@ -9,6 +9,7 @@ import (
 	"net/url"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/siderolabs/crypto/x509"
 	"github.com/siderolabs/go-pointer"
@@ -188,6 +189,173 @@ func TestValidate(t *testing.T) {
 			requiresInstall: true,
 			expectedError:   "1 error occurred:\n\t* duplicate system extension \"ghcr.io/siderolabs/gvisor:v0.1.0\"\n\n",
 		},
+		{
+			name: "MachineInstallDeviceTreeOverlaysInvalid",
+			config: &v1alpha1.Config{
+				ConfigVersion: "v1alpha1",
+				MachineConfig: &v1alpha1.MachineConfig{
+					MachineType: "worker",
+					MachineInstall: &v1alpha1.InstallConfig{
+						InstallDisk:               "/dev/vda",
+						InstallDeviceTreeOverlays: []string{"rock-5b-pwm-fan", "../rk3588-spi1"},
+					},
+				},
+				ClusterConfig: &v1alpha1.ClusterConfig{
+					ControlPlane: &v1alpha1.ControlPlaneConfig{
+						Endpoint: &v1alpha1.Endpoint{
+							endpointURL,
+						},
+					},
+				},
+			},
+			requiresInstall: true,
+			expectedError:   "1 error occurred:\n\t* invalid device tree overlay name \"../rk3588-spi1\"\n\n",
+		},
+		{
+			name: "MachineInstallBootloaderTargetInvalid",
+			config: &v1alpha1.Config{
+				ConfigVersion: "v1alpha1",
+				MachineConfig: &v1alpha1.MachineConfig{
+					MachineType: "worker",
+					MachineInstall: &v1alpha1.InstallConfig{
+						InstallDisk:             "/dev/nvme0n1",
+						InstallBootloaderTarget: "/dev/mmcblk0",
+					},
+				},
+				ClusterConfig: &v1alpha1.ClusterConfig{
+					ControlPlane: &v1alpha1.ControlPlaneConfig{
+						Endpoint: &v1alpha1.Endpoint{
+							endpointURL,
+						},
+					},
+				},
+			},
+			requiresInstall: true,
+			expectedError:   "1 error occurred:\n\t* bootloader target \"/dev/mmcblk0\" should be a MTD block device (/dev/mtdblockN)\n\n",
+		},
+		{
+			name: "MachineFanValid",
+			config: &v1alpha1.Config{
+				ConfigVersion: "v1alpha1",
+				MachineConfig: &v1alpha1.MachineConfig{
+					MachineType: "worker",
+					MachineFan: &v1alpha1.FanConfig{
+						FanHwmon: "pwmfan",
+						FanCurve: []v1alpha1.FanCurvePoint{
+							{FanCurveTemperature: 40, FanCurveSpeed: 0},
+							{FanCurveTemperature: 70, FanCurveSpeed: 100},
+						},
+					},
+				},
+				ClusterConfig: &v1alpha1.ClusterConfig{
+					ControlPlane: &v1alpha1.ControlPlaneConfig{
+						Endpoint: &v1alpha1.Endpoint{
+							endpointURL,
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "MachineFanInvalid",
+			config: &v1alpha1.Config{
+				ConfigVersion: "v1alpha1",
+				MachineConfig: &v1alpha1.MachineConfig{
+					MachineType: "worker",
+					MachineFan: &v1alpha1.FanConfig{
+						FanCurve: []v1alpha1.FanCurvePoint{
+							{FanCurveTemperature: 70, FanCurveSpeed: 0},
+							{FanCurveTemperature: 40, FanCurveSpeed: 120},
+						},
+					},
+				},
+				ClusterConfig: &v1alpha1.ClusterConfig{
+					ControlPlane: &v1alpha1.ControlPlaneConfig{
+						Endpoint: &v1alpha1.Endpoint{
+							endpointURL,
+						},
+					},
+				},
+			},
+			expectedError: "3 errors occurred:\n\t* fan hwmon device name is required\n\t* fan curve speed 120 should be in range 0-100\n\t* fan curve temperatures should be strictly increasing: 40 after 70\n\n",
+		},
+		{
+			name: "MachineWatchdogInvalid",
+			config: &v1alpha1.Config{
+				ConfigVersion: "v1alpha1",
+				MachineConfig: &v1alpha1.MachineConfig{
+					MachineType: "worker",
+					MachineWatchdog: &v1alpha1.WatchdogConfig{
+						WatchdogTimeout: 5 * time.Second,
+					},
+				},
+				ClusterConfig: &v1alpha1.ClusterConfig{
+					ControlPlane: &v1alpha1.ControlPlaneConfig{
+						Endpoint: &v1alpha1.Endpoint{
+							endpointURL,
+						},
+					},
+				},
+			},
+			expectedError: "1 error occurred:\n\t* watchdog timeout 5s should be at least 10s\n\n",
+		},
+		{
+			name: "MachineLEDsValid",
+			config: &v1alpha1.Config{
+				ConfigVersion: "v1alpha1",
+				MachineConfig: &v1alpha1.MachineConfig{
+					MachineType: "worker",
+					MachineLEDs: []*v1alpha1.LEDConfig{
+						{
+							LEDName: "blue:status",
+							LEDStages: map[string]string{
+								"booting":       "blink-fast",
+								"running":       "heartbeat",
+								"shutting down": "off",
+							},
+						},
+					},
+				},
+				ClusterConfig: &v1alpha1.ClusterConfig{
+					ControlPlane: &v1alpha1.ControlPlaneConfig{
+						Endpoint: &v1alpha1.Endpoint{
+							endpointURL,
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "MachineLEDsInvalid",
+			config: &v1alpha1.Config{
+				ConfigVersion: "v1alpha1",
+				MachineConfig: &v1alpha1.MachineConfig{
+					MachineType: "worker",
+					MachineLEDs: []*v1alpha1.LEDConfig{
+						{
+							LEDName: "blue:status",
+							LEDStages: map[string]string{
+								"running": "",
+							},
+						},
+						{
+							LEDName: "blue:status",
+							LEDStages: map[string]string{
+								"sleeping": "on",
+							},
+						},
+					},
+				},
+				ClusterConfig: &v1alpha1.ClusterConfig{
+					ControlPlane: &v1alpha1.ControlPlaneConfig{
+						Endpoint: &v1alpha1.Endpoint{
+							endpointURL,
+						},
+					},
+				},
+			},
+			expectedError: "3 errors occurred:\n\t* LED \"blue:status\": pattern for stage \"running\" is empty\n\t* duplicate LED \"blue:status\"\n\t* LED \"blue:status\": unknown machine stage \"sleeping\"\n\n",
+		},
 		{
 			name: "ExternalCloudProviderEnabled",
 			config: &v1alpha1.Config{
@@ -871,6 +1039,98 @@ func TestValidate(t *testing.T) {
 			expectedError: "4 errors occurred:\n\t* public key invalid: wrong key \"\" length: 0\n\t* public key invalid: wrong key \"4A3rogGVHuVjeZz5cbqryWXGkGBdIGC0E6+5mX2Iz1==\" length: 31\n" +
 				"\t* peer endpoint \"12.3.4.5\" is invalid\n\t* peer allowed IP \"10.2.0\" is invalid: invalid CIDR address: 10.2.0\n\n",
 		},
+		{
+			name: "LogicalLinks",
+			config: &v1alpha1.Config{
+				ConfigVersion: "v1alpha1",
+				MachineConfig: &v1alpha1.MachineConfig{
+					MachineType: "controlplane",
+					MachineNetwork: &v1alpha1.NetworkConfig{
+						NetworkInterfaces: []*v1alpha1.Device{
+							{
+								DeviceInterface: "vxlan0",
+								DeviceVXLAN: &v1alpha1.VXLAN{
+									VXLANVNI:    1 << 24,
+									VXLANRemote: "239.1.1.1",
+									VXLANGroup:  "10.0.0.1",
+								},
+							},
+							{
+								DeviceInterface: "macvlan0",
+								DeviceMACVLAN: &v1alpha1.MACVLAN{
+									MACVLANMode: "nat",
+								},
+								DeviceDummy: pointer.To(true),
+							},
+							{
+								DeviceInterface: "ipvlan0",
+								DeviceIPVLAN: &v1alpha1.IPVLAN{
+									IPVLANParent: "eth0",
+									IPVLANMode:   "l3",
+								},
+							},
+						},
+					},
+				},
+				ClusterConfig: &v1alpha1.ClusterConfig{
+					ControlPlane: &v1alpha1.ControlPlaneConfig{
+						Endpoint: &v1alpha1.Endpoint{
+							endpointURL,
+						},
+					},
+				},
+			},
+			expectedError: "8 errors occurred:\n\t* vxlan.vni should be in range 1-16777215\n\t* vxlan.remote and vxlan.group: config sections are mutually exclusive\n" +
+				"\t* vxlan.remote \"239.1.1.1\" should be a unicast address, use vxlan.group for multicast\n\t* vxlan.group \"10.0.0.1\" should be a multicast address\n" +
+				"\t* vxlan.group requires vxlan.parent to be set\n\t* [networking.os.device] macvlan0: dummy, macvlan: config sections are mutually exclusive\n" +
+				"\t* macvlan.parent is required\n\t* macvlan.mode \"nat\" is invalid\n\n",
+		},
+		{
+			name: "RoutingRules",
+			config: &v1alpha1.Config{
+				ConfigVersion: "v1alpha1",
+				MachineConfig: &v1alpha1.MachineConfig{
+					MachineType: "controlplane",
+					MachineNetwork: &v1alpha1.NetworkConfig{
+						NetworkRoutingRules: []*v1alpha1.RoutingRuleConfig{
+							{
+								RoutingRuleSource:   "10.10.0.0/24",
+								RoutingRuleTable:    100,
+								RoutingRulePriority: 1000,
+							},
+							{
+								RoutingRuleSource: "10.10.0.x",
+							},
+							{
+								RoutingRuleSource:      "10.10.0.0/24",
+								RoutingRuleDestination: "2001:db8::/64",
+								RoutingRuleTable:       100,
+								RoutingRulePriority:    1010,
+							},
+							{
+								RoutingRuleIIF:      "eth1",
+								RoutingRulePriority: 1020,
+							},
+							{
+								RoutingRuleFwMark:   0x10,
+								RoutingRuleTable:    200,
+								RoutingRulePriority: 1000,
+							},
+						},
+					},
+				},
+				ClusterConfig: &v1alpha1.ClusterConfig{
+					ControlPlane: &v1alpha1.ControlPlaneConfig{
+						Endpoint: &v1alpha1.Endpoint{
+							endpointURL,
+						},
+					},
+				},
+			},
+			expectedError: "4 errors occurred:\n\t* routing rule [1]: invalid source \"10.10.0.x\"\n" +
+				"\t* routing rule [2]: source \"10.10.0.0/24\" and destination \"2001:db8::/64\" should be of the same family\n" +
+				"\t* routing rule [3]: table is required\n\t* routing rule [4]: duplicate inet4 rule priority 1000\n\n",
+		},
 		{
 			name: "StaticRoutes",
 			config: &v1alpha1.Config{
@@ -1206,6 +1466,38 @@ func TestValidate(t *testing.T) {
 			},
 			expectedError: "1 error occurred:\n\t* [networking.os.device.deviceSelector]: config section should contain at least one field\n\n",
 		},
+		{
+			name: "EthernetInterfaceAndSelectorSet",
+			config: &v1alpha1.Config{
+				ConfigVersion: "v1alpha1",
+				MachineConfig: &v1alpha1.MachineConfig{
+					MachineType: "controlplane",
+					MachineNetwork: &v1alpha1.NetworkConfig{
+						NetworkEthernet: []*v1alpha1.EthernetConfig{
+							{
+								EthernetDeviceSelector: &v1alpha1.NetworkDeviceSelector{
+									NetworkDeviceKernelDriver: "r8125",
+								},
+							},
+							{
+								EthernetInterface: "eth0",
+								EthernetDeviceSelector: &v1alpha1.NetworkDeviceSelector{
+									NetworkDeviceKernelDriver: "r8125",
+								},
+							},
+						},
+					},
+				},
+				ClusterConfig: &v1alpha1.ClusterConfig{
+					ControlPlane: &v1alpha1.ControlPlaneConfig{
+						Endpoint: &v1alpha1.Endpoint{
+							endpointURL,
+						},
+					},
+				},
+			},
+			expectedError: "1 error occurred:\n\t* ethernet config [1]: interface and deviceSelector are mutually exclusive\n\n",
+		},
 		{
 			name: "TalosAPIAccessRBAC",
 			config: &v1alpha1.Config{
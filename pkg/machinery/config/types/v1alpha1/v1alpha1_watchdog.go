@@ -0,0 +1,50 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package v1alpha1
+
+import (
+	"fmt"
+	"time"
+)
+
+const (
+	defaultWatchdogDevice  = "/dev/watchdog0"
+	defaultWatchdogTimeout = time.Minute
+	minWatchdogTimeout     = 10 * time.Second
+)
+
+// Validate checks watchdog configuration for errors.
+func (wc *WatchdogConfig) Validate() error {
+	if wc.WatchdogTimeout != 0 && wc.WatchdogTimeout < minWatchdogTimeout {
+		return fmt.Errorf("watchdog timeout %s should be at least %s", wc.WatchdogTimeout, minWatchdogTimeout)
+	}
+
+	return nil
+}
+
+// Enabled implements config.Watchdog interface.
+//
+// The watchdog is enabled if the `.machine.watchdog` section is present.
+func (wc *WatchdogConfig) Enabled() bool {
+	return wc != nil
+}
+
+// Device implements config.Watchdog interface.
+func (wc *WatchdogConfig) Device() string {
+	if wc == nil || wc.WatchdogDevice == "" {
+		return defaultWatchdogDevice
+	}
+
+	return wc.WatchdogDevice
+}
+
+// Timeout implements config.Watchdog interface.
+func (wc *WatchdogConfig) Timeout() time.Duration {
+	if wc == nil || wc.WatchdogTimeout == 0 {
+		return defaultWatchdogTimeout
+	}
+
+	return wc.WatchdogTimeout
+}
@@ -610,6 +610,26 @@ func (in *Device) DeepCopyInto(out *Device) {
 			}
 		}
 	}
+	if in.DeviceVXLAN != nil {
+		in, out := &in.DeviceVXLAN, &out.DeviceVXLAN
+		*out = new(VXLAN)
+		**out = **in
+	}
+	if in.DeviceMACVLAN != nil {
+		in, out := &in.DeviceMACVLAN, &out.DeviceMACVLAN
+		*out = new(MACVLAN)
+		**out = **in
+	}
+	if in.DeviceMACVTAP != nil {
+		in, out := &in.DeviceMACVTAP, &out.DeviceMACVTAP
+		*out = new(MACVLAN)
+		**out = **in
+	}
+	if in.DeviceIPVLAN != nil {
+		in, out := &in.DeviceIPVLAN, &out.DeviceIPVLAN
+		*out = new(IPVLAN)
+		**out = **in
+	}
 	if in.DeviceDHCP != nil {
 		in, out := &in.DeviceDHCP, &out.DeviceDHCP
 		*out = new(bool)
@@ -888,6 +908,152 @@ func (in *EtcdConfig) DeepCopy() *EtcdConfig {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EthernetChannelsConfig) DeepCopyInto(out *EthernetChannelsConfig) {
+	*out = *in
+	if in.ChannelsRX != nil {
+		in, out := &in.ChannelsRX, &out.ChannelsRX
+		*out = new(uint32)
+		**out = **in
+	}
+	if in.ChannelsTX != nil {
+		in, out := &in.ChannelsTX, &out.ChannelsTX
+		*out = new(uint32)
+		**out = **in
+	}
+	if in.ChannelsOther != nil {
+		in, out := &in.ChannelsOther, &out.ChannelsOther
+		*out = new(uint32)
+		**out = **in
+	}
+	if in.ChannelsCombined != nil {
+		in, out := &in.ChannelsCombined, &out.ChannelsCombined
+		*out = new(uint32)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EthernetChannelsConfig.
+func (in *EthernetChannelsConfig) DeepCopy() *EthernetChannelsConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(EthernetChannelsConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EthernetConfig) DeepCopyInto(out *EthernetConfig) {
+	*out = *in
+	if in.EthernetDeviceSelector != nil {
+		in, out := &in.EthernetDeviceSelector, &out.EthernetDeviceSelector
+		*out = new(NetworkDeviceSelector)
+		**out = **in
+	}
+	if in.EthernetRings != nil {
+		in, out := &in.EthernetRings, &out.EthernetRings
+		*out = new(EthernetRingsConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.EthernetChannels != nil {
+		in, out := &in.EthernetChannels, &out.EthernetChannels
+		*out = new(EthernetChannelsConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.EthernetFeatures != nil {
+		in, out := &in.EthernetFeatures, &out.EthernetFeatures
+		*out = make(map[string]bool, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.EthernetPause != nil {
+		in, out := &in.EthernetPause, &out.EthernetPause
+		*out = new(EthernetPauseConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EthernetConfig.
+func (in *EthernetConfig) DeepCopy() *EthernetConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(EthernetConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EthernetPauseConfig) DeepCopyInto(out *EthernetPauseConfig) {
+	*out = *in
+	if in.PauseAutonegotiate != nil {
+		in, out := &in.PauseAutonegotiate, &out.PauseAutonegotiate
+		*out = new(bool)
+		**out = **in
+	}
+	if in.PauseRX != nil {
+		in, out := &in.PauseRX, &out.PauseRX
+		*out = new(bool)
+		**out = **in
+	}
+	if in.PauseTX != nil {
+		in, out := &in.PauseTX, &out.PauseTX
+		*out = new(bool)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EthernetPauseConfig.
+func (in *EthernetPauseConfig) DeepCopy() *EthernetPauseConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(EthernetPauseConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EthernetRingsConfig) DeepCopyInto(out *EthernetRingsConfig) {
+	*out = *in
+	if in.RingsRX != nil {
+		in, out := &in.RingsRX, &out.RingsRX
+		*out = new(uint32)
+		**out = **in
+	}
+	if in.RingsRXMini != nil {
+		in, out := &in.RingsRXMini, &out.RingsRXMini
+		*out = new(uint32)
+		**out = **in
+	}
+	if in.RingsRXJumbo != nil {
+		in, out := &in.RingsRXJumbo, &out.RingsRXJumbo
+		*out = new(uint32)
+		**out = **in
+	}
+	if in.RingsTX != nil {
+		in, out := &in.RingsTX, &out.RingsTX
+		*out = new(uint32)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EthernetRingsConfig.
+func (in *EthernetRingsConfig) DeepCopy() *EthernetRingsConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(EthernetRingsConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ExternalCloudProviderConfig) DeepCopyInto(out *ExternalCloudProviderConfig) {
 	*out = *in
@@ -935,6 +1101,43 @@ func (in *ExtraHost) DeepCopy() *ExtraHost {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FanConfig) DeepCopyInto(out *FanConfig) {
+	*out = *in
+	if in.FanCurve != nil {
+		in, out := &in.FanCurve, &out.FanCurve
+		*out = make([]FanCurvePoint, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FanConfig.
+func (in *FanConfig) DeepCopy() *FanConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(FanConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FanCurvePoint) DeepCopyInto(out *FanCurvePoint) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FanCurvePoint.
+func (in *FanCurvePoint) DeepCopy() *FanCurvePoint {
+	if in == nil {
+		return nil
+	}
+	out := new(FanCurvePoint)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *FeaturesConfig) DeepCopyInto(out *FeaturesConfig) {
 	*out = *in
@@ -958,6 +1161,11 @@ func (in *FeaturesConfig) DeepCopyInto(out *FeaturesConfig) {
 		*out = new(bool)
 		**out = **in
 	}
+	if in.HostDNSConfig != nil {
+		in, out := &in.HostDNSConfig, &out.HostDNSConfig
+		*out = new(HostDNSConfig)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -971,6 +1179,43 @@ func (in *FeaturesConfig) DeepCopy() *FeaturesConfig {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HostDNSConfig) DeepCopyInto(out *HostDNSConfig) {
+	*out = *in
+	if in.HostDNSEnabled != nil {
+		in, out := &in.HostDNSEnabled, &out.HostDNSEnabled
+		*out = new(bool)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HostDNSConfig.
+func (in *HostDNSConfig) DeepCopy() *HostDNSConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(HostDNSConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *IPVLAN) DeepCopyInto(out *IPVLAN) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IPVLAN.
+func (in *IPVLAN) DeepCopy() *IPVLAN {
+	if in == nil {
+		return nil
+	}
+	out := new(IPVLAN)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *InstallConfig) DeepCopyInto(out *InstallConfig) {
 	*out = *in
@@ -1004,6 +1249,11 @@ func (in *InstallConfig) DeepCopyInto(out *InstallConfig) {
 		*out = new(bool)
 		**out = **in
 	}
+	if in.InstallDeviceTreeOverlays != nil {
+		in, out := &in.InstallDeviceTreeOverlays, &out.InstallDeviceTreeOverlays
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
 	return
 }
 
@@ -1269,6 +1519,29 @@ func (in *KubernetesTalosAPIAccessConfig) DeepCopy() *KubernetesTalosAPIAccessCo
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LEDConfig) DeepCopyInto(out *LEDConfig) {
+	*out = *in
+	if in.LEDStages != nil {
+		in, out := &in.LEDStages, &out.LEDStages
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LEDConfig.
+func (in *LEDConfig) DeepCopy() *LEDConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(LEDConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *LoggingConfig) DeepCopyInto(out *LoggingConfig) {
 	*out = *in
@@ -1312,6 +1585,22 @@ func (in *LoggingDestination) DeepCopy() *LoggingDestination {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MACVLAN) DeepCopyInto(out *MACVLAN) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MACVLAN.
+func (in *MACVLAN) DeepCopy() *MACVLAN {
+	if in == nil {
+		return nil
+	}
+	out := new(MACVLAN)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *MachineConfig) DeepCopyInto(out *MachineConfig) {
 	*out = *in
@@ -1443,6 +1732,27 @@ func (in *MachineConfig) DeepCopyInto(out *MachineConfig) {
 			(*out)[key] = val
 		}
 	}
+	if in.MachineFan != nil {
+		in, out := &in.MachineFan, &out.MachineFan
+		*out = new(FanConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.MachineWatchdog != nil {
+		in, out := &in.MachineWatchdog, &out.MachineWatchdog
+		*out = new(WatchdogConfig)
+		**out = **in
+	}
+	if in.MachineLEDs != nil {
+		in, out := &in.MachineLEDs, &out.MachineLEDs
+		*out = make([]*LEDConfig, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(LEDConfig)
+				(*in).DeepCopyInto(*out)
+			}
+		}
+	}
 	return
 }
 
@@ -1624,6 +1934,33 @@ func (in *NetworkConfig) DeepCopyInto(out *NetworkConfig) {
 		*out = new(bool)
 		**out = **in
 	}
+	if in.NetworkFirewall != nil {
+		in, out := &in.NetworkFirewall, &out.NetworkFirewall
+		*out = new(NetworkFirewallConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.NetworkEthernet != nil {
+		in, out := &in.NetworkEthernet, &out.NetworkEthernet
+		*out = make([]*EthernetConfig, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(EthernetConfig)
+				(*in).DeepCopyInto(*out)
+			}
+		}
+	}
+	if in.NetworkRoutingRules != nil {
+		in, out := &in.NetworkRoutingRules, &out.NetworkRoutingRules
+		*out = make([]*RoutingRuleConfig, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(RoutingRuleConfig)
+				**out = **in
+			}
+		}
+	}
 	return
 }
 
@@ -1679,6 +2016,38 @@ func (in *NetworkDeviceSelector) DeepCopy() *NetworkDeviceSelector {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NetworkFirewallConfig) DeepCopyInto(out *NetworkFirewallConfig) {
+	*out = *in
+	if in.FirewallDisableDefaultRules != nil {
+		in, out := &in.FirewallDisableDefaultRules, &out.FirewallDisableDefaultRules
+		*out = new(bool)
+		**out = **in
+	}
+	if in.FirewallRules != nil {
+		in, out := &in.FirewallRules, &out.FirewallRules
+		*out = make([]*NetworkRule, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(NetworkRule)
+				(*in).DeepCopyInto(*out)
+			}
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkFirewallConfig.
+func (in *NetworkFirewallConfig) DeepCopy() *NetworkFirewallConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(NetworkFirewallConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *NetworkKubeSpan) DeepCopyInto(out *NetworkKubeSpan) {
 	*out = *in
@@ -1720,6 +2089,65 @@ func (in *NetworkKubeSpan) DeepCopy() *NetworkKubeSpan {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NetworkRule) DeepCopyInto(out *NetworkRule) {
+	*out = *in
+	in.RulePortSelector.DeepCopyInto(&out.RulePortSelector)
+	if in.RuleIngress != nil {
+		in, out := &in.RuleIngress, &out.RuleIngress
+		*out = make([]NetworkRuleIngress, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkRule.
+func (in *NetworkRule) DeepCopy() *NetworkRule {
+	if in == nil {
+		return nil
+	}
+	out := new(NetworkRule)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NetworkRuleIngress) DeepCopyInto(out *NetworkRuleIngress) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkRuleIngress.
+func (in *NetworkRuleIngress) DeepCopy() *NetworkRuleIngress {
+	if in == nil {
+		return nil
+	}
+	out := new(NetworkRuleIngress)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NetworkRulePortSelector) DeepCopyInto(out *NetworkRulePortSelector) {
+	*out = *in
+	if in.PortSelectorPorts != nil {
+		in, out := &in.PortSelectorPorts, &out.PortSelectorPorts
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkRulePortSelector.
+func (in *NetworkRulePortSelector) DeepCopy() *NetworkRulePortSelector {
+	if in == nil {
+		return nil
+	}
+	out := new(NetworkRulePortSelector)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *PodCheckpointer) DeepCopyInto(out *PodCheckpointer) {
 	*out = *in
@@ -1966,6 +2394,22 @@ func (in *Route) DeepCopy() *Route {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RoutingRuleConfig) DeepCopyInto(out *RoutingRuleConfig) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RoutingRuleConfig.
+func (in *RoutingRuleConfig) DeepCopy() *RoutingRuleConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(RoutingRuleConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *STP) DeepCopyInto(out *STP) {
 	*out = *in
@@ -2134,6 +2578,22 @@ func (in *VIPHCloudConfig) DeepCopy() *VIPHCloudConfig {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VXLAN) DeepCopyInto(out *VXLAN) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VXLAN.
+func (in *VXLAN) DeepCopy() *VXLAN {
+	if in == nil {
+		return nil
+	}
+	out := new(VXLAN)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Vlan) DeepCopyInto(out *Vlan) {
 	*out = *in
@@ -2222,3 +2682,19 @@ func (in *VolumeMountConfig) DeepCopy() *VolumeMountConfig {
 	in.DeepCopyInto(out)
 	return out
 }
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *WatchdogConfig) DeepCopyInto(out *WatchdogConfig) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WatchdogConfig.
+func (in *WatchdogConfig) DeepCopy() *WatchdogConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(WatchdogConfig)
+	in.DeepCopyInto(out)
+	return out
+}
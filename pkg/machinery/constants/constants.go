@@ -94,6 +94,12 @@ const (
 	// BoardRock5b is the name of the Radxa Rock 5B.
 	BoardRock5b = "rock_5b"
 
+	// BoardOrangePi5 is the name of the Orange Pi 5.
+	BoardOrangePi5 = "orangepi_5"
+
+	// BoardNanoPCT6 is the name of the Friendlyelec NanoPC-T6.
+	BoardNanoPCT6 = "nanopc_t6"
+
 	// KernelParamHostname is the kernel parameter name for specifying the
 	// hostname.
 	KernelParamHostname = "talos.hostname"
@@ -401,9 +407,27 @@ const (
 	// ConfigPath is the path to the downloaded config.
 	ConfigPath = StateMountPoint + "/config.yaml"
 
+	// SecretKeyPath is the path to the key used to decrypt the secret references in the config.
+	SecretKeyPath = StateMountPoint + "/secret.key"
+
+	// ConfigHistoryPath is the directory keeping the previously applied configs.
+	ConfigHistoryPath = StateMountPoint + "/config-history"
+
+	// ConfigHistoryDepth is the number of the applied configs kept in the config history.
+	ConfigHistoryDepth = 10
+
 	// ConfigTryTimeout is the timeout of the config apply in try mode.
 	ConfigTryTimeout = time.Minute
 
+	// ConfigTryHealthyPeriod is the default period the try mode conditions should stay healthy for the config to be committed.
+	ConfigTryHealthyPeriod = 10 * time.Second
+
+	// ConfigTryGracePeriod is the default period after the config apply in try mode when the failed conditions are ignored.
+	ConfigTryGracePeriod = 10 * time.Second
+
+	// ConfigTryFailureThreshold is the default number of consecutive failed checks of the try mode conditions which roll the config back.
+	ConfigTryFailureThreshold = 3
+
 	// MetalConfigISOLabel is the volume label for ISO based configuration.
 	MetalConfigISOLabel = "metal-iso"
 
@@ -547,9 +571,18 @@ const (
 	// SystemExtensionsPath is the path to the system extensions directory.
 	SystemExtensionsPath = SystemPath + "/extensions"
 
+	// DeviceTreeOverlaysPath is the path to the device tree overlays provided by system extensions.
+	DeviceTreeOverlaysPath = "/usr/local/lib/dtb/overlays"
+
 	// SystemOverlaysPath is the path to the system overlay directory.
 	SystemOverlaysPath = SystemPath + "/overlays"
 
+	// SystemResolvedPath is the path to the directory with the resolver configuration for the pods.
+	SystemResolvedPath = SystemPath + "/resolved"
+
+	// PodResolvConfPath is the path to the resolv.conf with the upstream nameservers used by the pods.
+	PodResolvConfPath = SystemResolvedPath + "/resolv.conf"
+
 	// CgroupMountPath is the default mount path for unified cgroupsv2 setup.
 	CgroupMountPath = "/sys/fs/cgroup"
 
@@ -667,6 +700,9 @@ const (
 	// DefaultSecondaryResolver is the default secondary DNS server.
 	DefaultSecondaryResolver = "8.8.8.8"
 
+	// HostDNSAddress is the address the caching DNS resolver on the host listens on.
+	HostDNSAddress = "127.0.0.53"
+
 	// DefaultClusterIDSize is the default size in bytes for the cluster ID token.
 	DefaultClusterIDSize = 32
 
@@ -833,6 +869,9 @@ const (
 	// APIAuthzRoleMetadataKey is the gRPC metadata key used to submit a role with os:impersonator.
 	APIAuthzRoleMetadataKey = "talos-role"
 
+	// APIAuthzIdentityMetadataKey is the gRPC metadata key used to forward the client identity with os:impersonator.
+	APIAuthzIdentityMetadataKey = "talos-identity"
+
 	// DashboardTTY is the number of the TTY device (/dev/ttyN) for dashboard.
 	DashboardTTY = 2
 
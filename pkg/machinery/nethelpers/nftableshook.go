@@ -0,0 +1,21 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package nethelpers
+
+//go:generate enumer -type=NfTablesChainHook -linecomment -text
+
+// NfTablesChainHook is a hook of the nftables chain.
+type NfTablesChainHook uint32
+
+// NfTablesChainHook constants.
+//
+//structprotogen:gen_enum
+const (
+	ChainHookPrerouting  NfTablesChainHook = 0 // prerouting
+	ChainHookInput       NfTablesChainHook = 1 // input
+	ChainHookForward     NfTablesChainHook = 2 // forward
+	ChainHookOutput      NfTablesChainHook = 3 // output
+	ChainHookPostrouting NfTablesChainHook = 4 // postrouting
+)
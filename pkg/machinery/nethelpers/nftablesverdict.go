@@ -0,0 +1,18 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package nethelpers
+
+//go:generate enumer -type=NfTablesVerdict -linecomment -text
+
+// NfTablesVerdict is a verdict of the nftables rule.
+type NfTablesVerdict int32
+
+// NfTablesVerdict constants.
+//
+//structprotogen:gen_enum
+const (
+	VerdictDrop   NfTablesVerdict = 0 // drop
+	VerdictAccept NfTablesVerdict = 1 // accept
+)
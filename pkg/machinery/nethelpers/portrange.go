@@ -0,0 +1,47 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package nethelpers
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// PortRange is an inclusive range of ports.
+type PortRange struct {
+	Lo uint16
+	Hi uint16
+}
+
+// ParsePortRange parses the port (e.g. `80`) or the port range (e.g. `30000-32767`).
+func ParsePortRange(s string) (PortRange, error) {
+	lo, hi, isRange := strings.Cut(s, "-")
+
+	loPort, err := strconv.ParseUint(lo, 10, 16)
+	if err != nil || loPort == 0 {
+		return PortRange{}, fmt.Errorf("invalid port %q", s)
+	}
+
+	if !isRange {
+		return PortRange{Lo: uint16(loPort), Hi: uint16(loPort)}, nil
+	}
+
+	hiPort, err := strconv.ParseUint(hi, 10, 16)
+	if err != nil || hiPort < loPort {
+		return PortRange{}, fmt.Errorf("invalid port range %q", s)
+	}
+
+	return PortRange{Lo: uint16(loPort), Hi: uint16(hiPort)}, nil
+}
+
+// String implements fmt.Stringer.
+func (r PortRange) String() string {
+	if r.Lo == r.Hi {
+		return strconv.Itoa(int(r.Lo))
+	}
+
+	return fmt.Sprintf("%d-%d", r.Lo, r.Hi)
+}
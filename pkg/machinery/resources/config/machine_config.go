@@ -22,6 +22,9 @@ const MachineConfigType = resource.Type("MachineConfigs.config.talos.dev")
 // V1Alpha1ID is the ID of V1Alpha1 resource (singleton).
 const V1Alpha1ID = resource.ID("v1alpha1")
 
+// StagedID is the ID of the resource holding the configuration staged to be applied after the next reboot.
+const StagedID = resource.ID("staged")
+
 // MachineConfig resource holds v1alpha Talos configuration.
 type MachineConfig struct {
 	md   resource.Metadata
@@ -39,8 +42,13 @@ func (s *v1alpha1Spec) MarshalYAMLBytes() ([]byte, error) {
 
 // NewMachineConfig initializes a V1Alpha1 resource.
 func NewMachineConfig(spec config.Provider) *MachineConfig {
+	return NewMachineConfigWithID(spec, V1Alpha1ID)
+}
+
+// NewMachineConfigWithID initializes a MachineConfig resource with the specified ID.
+func NewMachineConfigWithID(spec config.Provider, id resource.ID) *MachineConfig {
 	r := &MachineConfig{
-		md: resource.NewMetadata(NamespaceName, MachineConfigType, V1Alpha1ID, resource.VersionUndefined),
+		md: resource.NewMetadata(NamespaceName, MachineConfigType, id, resource.VersionUndefined),
 		spec: &v1alpha1Spec{
 			cfg: spec,
 		},
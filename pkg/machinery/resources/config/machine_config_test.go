@@ -84,3 +84,14 @@ func TestMachineConfigProtobufMarshal(t *testing.T) {
 
 	assert.Equal(t, string(yaml1), string(yaml2))
 }
+
+func TestMachineConfigWithID(t *testing.T) {
+	cfg, err := configloader.NewFromBytes([]byte(sampleConfig))
+	require.NoError(t, err)
+
+	r := config.NewMachineConfigWithID(cfg, config.StagedID)
+
+	assert.Equal(t, config.StagedID, r.Metadata().ID())
+	assert.Equal(t, config.MachineConfigType, r.Metadata().Type())
+	assert.Equal(t, cfg, r.Config())
+}
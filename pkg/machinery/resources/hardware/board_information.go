@@ -0,0 +1,85 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package hardware
+
+import (
+	"github.com/cosi-project/runtime/pkg/resource"
+	"github.com/cosi-project/runtime/pkg/resource/meta"
+	"github.com/cosi-project/runtime/pkg/resource/protobuf"
+	"github.com/cosi-project/runtime/pkg/resource/typed"
+
+	"github.com/siderolabs/talos/pkg/machinery/proto"
+)
+
+// BoardInformationType is type of BoardInformation resource.
+const BoardInformationType = resource.Type("BoardInformations.hardware.talos.dev")
+
+// BoardInformationID is the ID of the BoardInformation resource.
+const BoardInformationID = resource.ID("board")
+
+// BoardInformation resource holds the SBC hardware information reported by the board implementation.
+type BoardInformation = typed.Resource[BoardInformationSpec, BoardInformationExtension]
+
+// BoardInformationSpec represents the board information obtained from the board implementation and the device tree.
+//
+//gotagsrewrite:gen
+type BoardInformationSpec struct {
+	Name       string   `yaml:"name" protobuf:"1"`
+	Model      string   `yaml:"model,omitempty" protobuf:"2"`
+	SOC        string   `yaml:"soc,omitempty" protobuf:"3"`
+	BootMedium string   `yaml:"bootMedium,omitempty" protobuf:"4"`
+	Compatible []string `yaml:"compatible,omitempty" protobuf:"5"`
+	Features   []string `yaml:"features,omitempty" protobuf:"6"`
+}
+
+// NewBoardInformation initializes a BoardInformation resource.
+func NewBoardInformation(id string) *BoardInformation {
+	return typed.NewResource[BoardInformationSpec, BoardInformationExtension](
+		resource.NewMetadata(NamespaceName, BoardInformationType, id, resource.VersionUndefined),
+		BoardInformationSpec{},
+	)
+}
+
+// BoardInformationExtension provides auxiliary methods for BoardInformation.
+type BoardInformationExtension struct{}
+
+// ResourceDefinition implements [typed.Extension] interface.
+func (BoardInformationExtension) ResourceDefinition() meta.ResourceDefinitionSpec {
+	return meta.ResourceDefinitionSpec{
+		Type: BoardInformationType,
+		Aliases: []resource.Type{
+			"board",
+			"boards",
+		},
+		DefaultNamespace: NamespaceName,
+		PrintColumns: []meta.PrintColumn{
+			{
+				Name:     "Name",
+				JSONPath: `{.name}`,
+			},
+			{
+				Name:     "Model",
+				JSONPath: `{.model}`,
+			},
+			{
+				Name:     "SoC",
+				JSONPath: `{.soc}`,
+			},
+			{
+				Name:     "BootMedium",
+				JSONPath: `{.bootMedium}`,
+			},
+		},
+	}
+}
+
+func init() {
+	proto.RegisterDefaultTypes()
+
+	err := protobuf.RegisterDynamic[BoardInformationSpec](BoardInformationType, &BoardInformation{})
+	if err != nil {
+		panic(err)
+	}
+}
@@ -2,10 +2,24 @@
 // License, v. 2.0. If a copy of the MPL was not distributed with this
 // file, You can obtain one at http://mozilla.org/MPL/2.0/.
 
-// Code generated by "deep-copy -type MemoryModuleSpec -type ProcessorSpec -type SystemInformationSpec -header-file ../../../../hack/boilerplate.txt -o deep_copy.generated.go ."; DO NOT EDIT.
+// Code generated by "deep-copy -type BoardInformationSpec -type MemoryModuleSpec -type ProcessorSpec -type SystemInformationSpec -type ThermalZoneStatusSpec -header-file ../../../../hack/boilerplate.txt -o deep_copy.generated.go ."; DO NOT EDIT.
 
 package hardware
 
+// DeepCopy generates a deep copy of BoardInformationSpec.
+func (o BoardInformationSpec) DeepCopy() BoardInformationSpec {
+	var cp BoardInformationSpec = o
+	if o.Compatible != nil {
+		cp.Compatible = make([]string, len(o.Compatible))
+		copy(cp.Compatible, o.Compatible)
+	}
+	if o.Features != nil {
+		cp.Features = make([]string, len(o.Features))
+		copy(cp.Features, o.Features)
+	}
+	return cp
+}
+
 // DeepCopy generates a deep copy of MemoryModuleSpec.
 func (o MemoryModuleSpec) DeepCopy() MemoryModuleSpec {
 	var cp MemoryModuleSpec = o
@@ -23,3 +37,9 @@ func (o SystemInformationSpec) DeepCopy() SystemInformationSpec {
 	var cp SystemInformationSpec = o
 	return cp
 }
+
+// DeepCopy generates a deep copy of ThermalZoneStatusSpec.
+func (o ThermalZoneStatusSpec) DeepCopy() ThermalZoneStatusSpec {
+	var cp ThermalZoneStatusSpec = o
+	return cp
+}
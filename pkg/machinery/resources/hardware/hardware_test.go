@@ -27,6 +27,8 @@ func TestRegisterResource(t *testing.T) {
 	for _, resource := range []resource.Resource{
 		&hardware.Processor{},
 		&hardware.MemoryModule{},
+		&hardware.BoardInformation{},
+		&hardware.ThermalZoneStatus{},
 	} {
 		assert.NoError(t, resourceRegistry.Register(ctx, resource))
 	}
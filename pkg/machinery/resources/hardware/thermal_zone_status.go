@@ -0,0 +1,73 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package hardware
+
+import (
+	"github.com/cosi-project/runtime/pkg/resource"
+	"github.com/cosi-project/runtime/pkg/resource/meta"
+	"github.com/cosi-project/runtime/pkg/resource/protobuf"
+	"github.com/cosi-project/runtime/pkg/resource/typed"
+
+	"github.com/siderolabs/talos/pkg/machinery/proto"
+)
+
+// ThermalZoneStatusType is type of ThermalZoneStatus resource.
+const ThermalZoneStatusType = resource.Type("ThermalZoneStatuses.hardware.talos.dev")
+
+// ThermalZoneStatus resource holds the current state of a kernel thermal zone.
+type ThermalZoneStatus = typed.Resource[ThermalZoneStatusSpec, ThermalZoneStatusExtension]
+
+// ThermalZoneStatusSpec represents the thermal zone state read from /sys/class/thermal.
+//
+// Temperatures are in millidegrees Celsius.
+//
+//gotagsrewrite:gen
+type ThermalZoneStatusSpec struct {
+	Type                string `yaml:"type" protobuf:"1"`
+	Temperature         int32  `yaml:"temperature" protobuf:"2"`
+	CriticalTemperature int32  `yaml:"criticalTemperature,omitempty" protobuf:"3"`
+}
+
+// NewThermalZoneStatus initializes a ThermalZoneStatus resource.
+func NewThermalZoneStatus(id string) *ThermalZoneStatus {
+	return typed.NewResource[ThermalZoneStatusSpec, ThermalZoneStatusExtension](
+		resource.NewMetadata(NamespaceName, ThermalZoneStatusType, id, resource.VersionUndefined),
+		ThermalZoneStatusSpec{},
+	)
+}
+
+// ThermalZoneStatusExtension provides auxiliary methods for ThermalZoneStatus.
+type ThermalZoneStatusExtension struct{}
+
+// ResourceDefinition implements [typed.Extension] interface.
+func (ThermalZoneStatusExtension) ResourceDefinition() meta.ResourceDefinitionSpec {
+	return meta.ResourceDefinitionSpec{
+		Type: ThermalZoneStatusType,
+		Aliases: []resource.Type{
+			"thermalzone",
+			"thermalzones",
+		},
+		DefaultNamespace: NamespaceName,
+		PrintColumns: []meta.PrintColumn{
+			{
+				Name:     "Type",
+				JSONPath: `{.type}`,
+			},
+			{
+				Name:     "Temperature",
+				JSONPath: `{.temperature}`,
+			},
+		},
+	}
+}
+
+func init() {
+	proto.RegisterDefaultTypes()
+
+	err := protobuf.RegisterDynamic[ThermalZoneStatusSpec](ThermalZoneStatusType, &ThermalZoneStatus{})
+	if err != nil {
+		panic(err)
+	}
+}
@@ -2,12 +2,14 @@
 // License, v. 2.0. If a copy of the MPL was not distributed with this
 // file, You can obtain one at http://mozilla.org/MPL/2.0/.
 
-// Code generated by "deep-copy -type AddressSpecSpec -type AddressStatusSpec -type HardwareAddrSpec -type HostnameSpecSpec -type HostnameStatusSpec -type LinkRefreshSpec -type LinkSpecSpec -type LinkStatusSpec -type NodeAddressSpec -type NodeAddressFilterSpec -type OperatorSpecSpec -type ProbeSpecSpec -type ProbeStatusSpec -type ResolverSpecSpec -type ResolverStatusSpec -type RouteSpecSpec -type RouteStatusSpec -type StatusSpec -type TimeServerSpecSpec -type TimeServerStatusSpec -header-file ../../../../hack/boilerplate.txt -o deep_copy.generated.go ."; DO NOT EDIT.
+// Code generated by "deep-copy -type AddressSpecSpec -type AddressStatusSpec -type EthernetSpecSpec -type EthernetStatusSpec -type HardwareAddrSpec -type HostnameSpecSpec -type HostnameStatusSpec -type LinkRefreshSpec -type LinkSpecSpec -type LinkStatusSpec -type NfTablesChainSpec -type NodeAddressSpec -type NodeAddressFilterSpec -type OperatorSpecSpec -type ProbeSpecSpec -type ProbeStatusSpec -type ResolverSpecSpec -type ResolverStatusSpec -type RouteSpecSpec -type RouteStatusSpec -type RoutingRuleSpecSpec -type RoutingRuleStatusSpec -type RuleConfigSpec -type StatusSpec -type TimeServerSpecSpec -type TimeServerStatusSpec -header-file ../../../../hack/boilerplate.txt -o deep_copy.generated.go ."; DO NOT EDIT.
 
 package network
 
 import (
 	"net/netip"
+
+	"github.com/siderolabs/talos/pkg/machinery/nethelpers"
 )
 
 // DeepCopy generates a deep copy of AddressSpecSpec.
@@ -22,6 +24,164 @@ func (o AddressStatusSpec) DeepCopy() AddressStatusSpec {
 	return cp
 }
 
+// DeepCopy generates a deep copy of DNSResolveCacheSpec.
+func (o DNSResolveCacheSpec) DeepCopy() DNSResolveCacheSpec {
+	var cp DNSResolveCacheSpec = o
+	if o.ListenAddresses != nil {
+		cp.ListenAddresses = make([]netip.AddrPort, len(o.ListenAddresses))
+		copy(cp.ListenAddresses, o.ListenAddresses)
+	}
+	return cp
+}
+
+// DeepCopy generates a deep copy of DNSUpstreamSpec.
+func (o DNSUpstreamSpec) DeepCopy() DNSUpstreamSpec {
+	var cp DNSUpstreamSpec = o
+	return cp
+}
+
+// DeepCopy generates a deep copy of EthernetSpecSpec.
+func (o EthernetSpecSpec) DeepCopy() EthernetSpecSpec {
+	var cp EthernetSpecSpec = o
+	if o.Rings.RX != nil {
+		cp.Rings.RX = new(uint32)
+		*cp.Rings.RX = *o.Rings.RX
+	}
+	if o.Rings.RXMini != nil {
+		cp.Rings.RXMini = new(uint32)
+		*cp.Rings.RXMini = *o.Rings.RXMini
+	}
+	if o.Rings.RXJumbo != nil {
+		cp.Rings.RXJumbo = new(uint32)
+		*cp.Rings.RXJumbo = *o.Rings.RXJumbo
+	}
+	if o.Rings.TX != nil {
+		cp.Rings.TX = new(uint32)
+		*cp.Rings.TX = *o.Rings.TX
+	}
+	if o.Channels.RX != nil {
+		cp.Channels.RX = new(uint32)
+		*cp.Channels.RX = *o.Channels.RX
+	}
+	if o.Channels.TX != nil {
+		cp.Channels.TX = new(uint32)
+		*cp.Channels.TX = *o.Channels.TX
+	}
+	if o.Channels.Other != nil {
+		cp.Channels.Other = new(uint32)
+		*cp.Channels.Other = *o.Channels.Other
+	}
+	if o.Channels.Combined != nil {
+		cp.Channels.Combined = new(uint32)
+		*cp.Channels.Combined = *o.Channels.Combined
+	}
+	if o.Features != nil {
+		cp.Features = make(map[string]bool, len(o.Features))
+		for k2, v2 := range o.Features {
+			cp.Features[k2] = v2
+		}
+	}
+	if o.Pause.Autonegotiate != nil {
+		cp.Pause.Autonegotiate = new(bool)
+		*cp.Pause.Autonegotiate = *o.Pause.Autonegotiate
+	}
+	if o.Pause.RX != nil {
+		cp.Pause.RX = new(bool)
+		*cp.Pause.RX = *o.Pause.RX
+	}
+	if o.Pause.TX != nil {
+		cp.Pause.TX = new(bool)
+		*cp.Pause.TX = *o.Pause.TX
+	}
+	return cp
+}
+
+// DeepCopy generates a deep copy of EthernetStatusSpec.
+func (o EthernetStatusSpec) DeepCopy() EthernetStatusSpec {
+	var cp EthernetStatusSpec = o
+	if o.Rings != nil {
+		cp.Rings = new(EthernetRingsStatus)
+		*cp.Rings = *o.Rings
+		if o.Rings.RXMax != nil {
+			cp.Rings.RXMax = new(uint32)
+			*cp.Rings.RXMax = *o.Rings.RXMax
+		}
+		if o.Rings.RXMiniMax != nil {
+			cp.Rings.RXMiniMax = new(uint32)
+			*cp.Rings.RXMiniMax = *o.Rings.RXMiniMax
+		}
+		if o.Rings.RXJumboMax != nil {
+			cp.Rings.RXJumboMax = new(uint32)
+			*cp.Rings.RXJumboMax = *o.Rings.RXJumboMax
+		}
+		if o.Rings.TXMax != nil {
+			cp.Rings.TXMax = new(uint32)
+			*cp.Rings.TXMax = *o.Rings.TXMax
+		}
+		if o.Rings.RX != nil {
+			cp.Rings.RX = new(uint32)
+			*cp.Rings.RX = *o.Rings.RX
+		}
+		if o.Rings.RXMini != nil {
+			cp.Rings.RXMini = new(uint32)
+			*cp.Rings.RXMini = *o.Rings.RXMini
+		}
+		if o.Rings.RXJumbo != nil {
+			cp.Rings.RXJumbo = new(uint32)
+			*cp.Rings.RXJumbo = *o.Rings.RXJumbo
+		}
+		if o.Rings.TX != nil {
+			cp.Rings.TX = new(uint32)
+			*cp.Rings.TX = *o.Rings.TX
+		}
+	}
+	if o.Channels != nil {
+		cp.Channels = new(EthernetChannelsStatus)
+		*cp.Channels = *o.Channels
+		if o.Channels.RXMax != nil {
+			cp.Channels.RXMax = new(uint32)
+			*cp.Channels.RXMax = *o.Channels.RXMax
+		}
+		if o.Channels.TXMax != nil {
+			cp.Channels.TXMax = new(uint32)
+			*cp.Channels.TXMax = *o.Channels.TXMax
+		}
+		if o.Channels.OtherMax != nil {
+			cp.Channels.OtherMax = new(uint32)
+			*cp.Channels.OtherMax = *o.Channels.OtherMax
+		}
+		if o.Channels.CombinedMax != nil {
+			cp.Channels.CombinedMax = new(uint32)
+			*cp.Channels.CombinedMax = *o.Channels.CombinedMax
+		}
+		if o.Channels.RX != nil {
+			cp.Channels.RX = new(uint32)
+			*cp.Channels.RX = *o.Channels.RX
+		}
+		if o.Channels.TX != nil {
+			cp.Channels.TX = new(uint32)
+			*cp.Channels.TX = *o.Channels.TX
+		}
+		if o.Channels.Other != nil {
+			cp.Channels.Other = new(uint32)
+			*cp.Channels.Other = *o.Channels.Other
+		}
+		if o.Channels.Combined != nil {
+			cp.Channels.Combined = new(uint32)
+			*cp.Channels.Combined = *o.Channels.Combined
+		}
+	}
+	if o.Features != nil {
+		cp.Features = make([]EthernetFeatureStatus, len(o.Features))
+		copy(cp.Features, o.Features)
+	}
+	if o.Pause != nil {
+		cp.Pause = new(EthernetPauseStatus)
+		*cp.Pause = *o.Pause
+	}
+	return cp
+}
+
 // DeepCopy generates a deep copy of HardwareAddrSpec.
 func (o HardwareAddrSpec) DeepCopy() HardwareAddrSpec {
 	var cp HardwareAddrSpec = o
@@ -32,6 +192,16 @@ func (o HardwareAddrSpec) DeepCopy() HardwareAddrSpec {
 	return cp
 }
 
+// DeepCopy generates a deep copy of HostDNSConfigSpec.
+func (o HostDNSConfigSpec) DeepCopy() HostDNSConfigSpec {
+	var cp HostDNSConfigSpec = o
+	if o.ListenAddresses != nil {
+		cp.ListenAddresses = make([]netip.AddrPort, len(o.ListenAddresses))
+		copy(cp.ListenAddresses, o.ListenAddresses)
+	}
+	return cp
+}
+
 // DeepCopy generates a deep copy of HostnameSpecSpec.
 func (o HostnameSpecSpec) DeepCopy() HostnameSpecSpec {
 	var cp HostnameSpecSpec = o
@@ -94,6 +264,54 @@ func (o LinkStatusSpec) DeepCopy() LinkStatusSpec {
 	return cp
 }
 
+// DeepCopy generates a deep copy of NfTablesChainSpec.
+func (o NfTablesChainSpec) DeepCopy() NfTablesChainSpec {
+	var cp NfTablesChainSpec = o
+	if o.Rules != nil {
+		cp.Rules = make([]NfTablesRule, len(o.Rules))
+		copy(cp.Rules, o.Rules)
+		for i2 := range o.Rules {
+			if o.Rules[i2].MatchSourceAddress != nil {
+				cp.Rules[i2].MatchSourceAddress = new(NfTablesAddressMatch)
+				*cp.Rules[i2].MatchSourceAddress = *o.Rules[i2].MatchSourceAddress
+				if o.Rules[i2].MatchSourceAddress.IncludeSubnets != nil {
+					cp.Rules[i2].MatchSourceAddress.IncludeSubnets = make([]netip.Prefix, len(o.Rules[i2].MatchSourceAddress.IncludeSubnets))
+					copy(cp.Rules[i2].MatchSourceAddress.IncludeSubnets, o.Rules[i2].MatchSourceAddress.IncludeSubnets)
+				}
+				if o.Rules[i2].MatchSourceAddress.ExcludeSubnets != nil {
+					cp.Rules[i2].MatchSourceAddress.ExcludeSubnets = make([]netip.Prefix, len(o.Rules[i2].MatchSourceAddress.ExcludeSubnets))
+					copy(cp.Rules[i2].MatchSourceAddress.ExcludeSubnets, o.Rules[i2].MatchSourceAddress.ExcludeSubnets)
+				}
+			}
+			if o.Rules[i2].MatchLayer4 != nil {
+				cp.Rules[i2].MatchLayer4 = new(NfTablesLayer4Match)
+				*cp.Rules[i2].MatchLayer4 = *o.Rules[i2].MatchLayer4
+				if o.Rules[i2].MatchLayer4.MatchDestinationPort != nil {
+					cp.Rules[i2].MatchLayer4.MatchDestinationPort = new(NfTablesPortMatch)
+					*cp.Rules[i2].MatchLayer4.MatchDestinationPort = *o.Rules[i2].MatchLayer4.MatchDestinationPort
+					if o.Rules[i2].MatchLayer4.MatchDestinationPort.Ranges != nil {
+						cp.Rules[i2].MatchLayer4.MatchDestinationPort.Ranges = make([]PortRange, len(o.Rules[i2].MatchLayer4.MatchDestinationPort.Ranges))
+						copy(cp.Rules[i2].MatchLayer4.MatchDestinationPort.Ranges, o.Rules[i2].MatchLayer4.MatchDestinationPort.Ranges)
+					}
+				}
+			}
+			if o.Rules[i2].MatchConntrackState != nil {
+				cp.Rules[i2].MatchConntrackState = new(NfTablesConntrackStateMatch)
+				*cp.Rules[i2].MatchConntrackState = *o.Rules[i2].MatchConntrackState
+				if o.Rules[i2].MatchConntrackState.States != nil {
+					cp.Rules[i2].MatchConntrackState.States = make([]nethelpers.ConntrackState, len(o.Rules[i2].MatchConntrackState.States))
+					copy(cp.Rules[i2].MatchConntrackState.States, o.Rules[i2].MatchConntrackState.States)
+				}
+			}
+			if o.Rules[i2].Verdict != nil {
+				cp.Rules[i2].Verdict = new(nethelpers.NfTablesVerdict)
+				*cp.Rules[i2].Verdict = *o.Rules[i2].Verdict
+			}
+		}
+	}
+	return cp
+}
+
 // DeepCopy generates a deep copy of NodeAddressSpec.
 func (o NodeAddressSpec) DeepCopy() NodeAddressSpec {
 	var cp NodeAddressSpec = o
@@ -168,6 +386,32 @@ func (o RouteStatusSpec) DeepCopy() RouteStatusSpec {
 	return cp
 }
 
+// DeepCopy generates a deep copy of RoutingRuleSpecSpec.
+func (o RoutingRuleSpecSpec) DeepCopy() RoutingRuleSpecSpec {
+	var cp RoutingRuleSpecSpec = o
+	return cp
+}
+
+// DeepCopy generates a deep copy of RoutingRuleStatusSpec.
+func (o RoutingRuleStatusSpec) DeepCopy() RoutingRuleStatusSpec {
+	var cp RoutingRuleStatusSpec = o
+	return cp
+}
+
+// DeepCopy generates a deep copy of RuleConfigSpec.
+func (o RuleConfigSpec) DeepCopy() RuleConfigSpec {
+	var cp RuleConfigSpec = o
+	if o.PortSelector.Ports != nil {
+		cp.PortSelector.Ports = make([]PortRange, len(o.PortSelector.Ports))
+		copy(cp.PortSelector.Ports, o.PortSelector.Ports)
+	}
+	if o.Ingress != nil {
+		cp.Ingress = make([]IngressRule, len(o.Ingress))
+		copy(cp.Ingress, o.Ingress)
+	}
+	return cp
+}
+
 // DeepCopy generates a deep copy of StatusSpec.
 func (o StatusSpec) DeepCopy() StatusSpec {
 	var cp StatusSpec = o
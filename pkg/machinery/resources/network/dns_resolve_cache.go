@@ -0,0 +1,82 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package network
+
+import (
+	"net/netip"
+
+	"github.com/cosi-project/runtime/pkg/resource"
+	"github.com/cosi-project/runtime/pkg/resource/meta"
+	"github.com/cosi-project/runtime/pkg/resource/protobuf"
+	"github.com/cosi-project/runtime/pkg/resource/typed"
+
+	"github.com/siderolabs/talos/pkg/machinery/proto"
+)
+
+// DNSResolveCacheType is type of DNSResolveCache resource.
+const DNSResolveCacheType = resource.Type("DNSResolveCaches.net.talos.dev")
+
+// DNSResolveCacheID is the resource ID for DNSResolveCache.
+const DNSResolveCacheID resource.ID = "cache"
+
+// DNSResolveCache resource holds the status of the caching DNS resolver on the host.
+type DNSResolveCache = typed.Resource[DNSResolveCacheSpec, DNSResolveCacheExtension]
+
+// DNSResolveCacheSpec describes the caching DNS resolver status.
+//
+//gotagsrewrite:gen
+type DNSResolveCacheSpec struct {
+	ListenAddresses []netip.AddrPort `yaml:"listenAddresses" protobuf:"1"`
+	Entries         uint32           `yaml:"entries" protobuf:"2"`
+	Hits            uint64           `yaml:"hits" protobuf:"3"`
+	Misses          uint64           `yaml:"misses" protobuf:"4"`
+}
+
+// NewDNSResolveCache initializes a DNSResolveCache resource.
+func NewDNSResolveCache(namespace resource.Namespace, id resource.ID) *DNSResolveCache {
+	return typed.NewResource[DNSResolveCacheSpec, DNSResolveCacheExtension](
+		resource.NewMetadata(namespace, DNSResolveCacheType, id, resource.VersionUndefined),
+		DNSResolveCacheSpec{},
+	)
+}
+
+// DNSResolveCacheExtension provides auxiliary methods for DNSResolveCache.
+type DNSResolveCacheExtension struct{}
+
+// ResourceDefinition implements [typed.Extension] interface.
+func (DNSResolveCacheExtension) ResourceDefinition() meta.ResourceDefinitionSpec {
+	return meta.ResourceDefinitionSpec{
+		Type:             DNSResolveCacheType,
+		Aliases:          []resource.Type{"dnscache"},
+		DefaultNamespace: NamespaceName,
+		PrintColumns: []meta.PrintColumn{
+			{
+				Name:     "Listen Addresses",
+				JSONPath: "{.listenAddresses}",
+			},
+			{
+				Name:     "Entries",
+				JSONPath: "{.entries}",
+			},
+			{
+				Name:     "Hits",
+				JSONPath: "{.hits}",
+			},
+			{
+				Name:     "Misses",
+				JSONPath: "{.misses}",
+			},
+		},
+	}
+}
+
+func init() {
+	proto.RegisterDefaultTypes()
+
+	err := protobuf.RegisterDynamic[DNSResolveCacheSpec](DNSResolveCacheType, &DNSResolveCache{})
+	if err != nil {
+		panic(err)
+	}
+}
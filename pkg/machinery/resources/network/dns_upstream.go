@@ -0,0 +1,70 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package network
+
+import (
+	"net/netip"
+
+	"github.com/cosi-project/runtime/pkg/resource"
+	"github.com/cosi-project/runtime/pkg/resource/meta"
+	"github.com/cosi-project/runtime/pkg/resource/protobuf"
+	"github.com/cosi-project/runtime/pkg/resource/typed"
+
+	"github.com/siderolabs/talos/pkg/machinery/proto"
+)
+
+// DNSUpstreamType is type of DNSUpstream resource.
+const DNSUpstreamType = resource.Type("DNSUpstreams.net.talos.dev")
+
+// DNSUpstream resource holds the health of the upstream nameserver used by the host DNS resolver.
+type DNSUpstream = typed.Resource[DNSUpstreamSpec, DNSUpstreamExtension]
+
+// DNSUpstreamSpec describes the upstream nameserver.
+//
+//gotagsrewrite:gen
+type DNSUpstreamSpec struct {
+	Address   netip.Addr `yaml:"address" protobuf:"1"`
+	Healthy   bool       `yaml:"healthy" protobuf:"2"`
+	LastError string     `yaml:"lastError,omitempty" protobuf:"3"`
+}
+
+// NewDNSUpstream initializes a DNSUpstream resource.
+func NewDNSUpstream(namespace resource.Namespace, id resource.ID) *DNSUpstream {
+	return typed.NewResource[DNSUpstreamSpec, DNSUpstreamExtension](
+		resource.NewMetadata(namespace, DNSUpstreamType, id, resource.VersionUndefined),
+		DNSUpstreamSpec{},
+	)
+}
+
+// DNSUpstreamExtension provides auxiliary methods for DNSUpstream.
+type DNSUpstreamExtension struct{}
+
+// ResourceDefinition implements [typed.Extension] interface.
+func (DNSUpstreamExtension) ResourceDefinition() meta.ResourceDefinitionSpec {
+	return meta.ResourceDefinitionSpec{
+		Type:             DNSUpstreamType,
+		Aliases:          []resource.Type{"dnsupstream", "dnsupstreams"},
+		DefaultNamespace: NamespaceName,
+		PrintColumns: []meta.PrintColumn{
+			{
+				Name:     "Healthy",
+				JSONPath: "{.healthy}",
+			},
+			{
+				Name:     "Last Error",
+				JSONPath: "{.lastError}",
+			},
+		},
+	}
+}
+
+func init() {
+	proto.RegisterDefaultTypes()
+
+	err := protobuf.RegisterDynamic[DNSUpstreamSpec](DNSUpstreamType, &DNSUpstream{})
+	if err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,92 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package network
+
+import (
+	"github.com/cosi-project/runtime/pkg/resource"
+	"github.com/cosi-project/runtime/pkg/resource/meta"
+	"github.com/cosi-project/runtime/pkg/resource/protobuf"
+	"github.com/cosi-project/runtime/pkg/resource/typed"
+
+	"github.com/siderolabs/talos/pkg/machinery/proto"
+)
+
+// EthernetSpecType is type of EthernetSpec resource.
+const EthernetSpecType = resource.Type("EthernetSpecs.net.talos.dev")
+
+// EthernetSpec resource holds Ethernet settings of the link.
+//
+// The resource ID is the name of the link.
+type EthernetSpec = typed.Resource[EthernetSpecSpec, EthernetSpecExtension]
+
+// EthernetSpecSpec describes the Ethernet settings of the link.
+//
+// Nil values are not changed.
+//
+//gotagsrewrite:gen
+type EthernetSpecSpec struct {
+	Rings    EthernetRingsSpec    `yaml:"rings,omitempty" protobuf:"1"`
+	Channels EthernetChannelsSpec `yaml:"channels,omitempty" protobuf:"2"`
+	Features map[string]bool      `yaml:"features,omitempty" protobuf:"3"`
+	Pause    EthernetPauseSpec    `yaml:"pause,omitempty" protobuf:"4"`
+}
+
+// EthernetRingsSpec describes the ring buffer sizes.
+//
+//gotagsrewrite:gen
+type EthernetRingsSpec struct {
+	RX      *uint32 `yaml:"rx,omitempty" protobuf:"1"`
+	RXMini  *uint32 `yaml:"rxMini,omitempty" protobuf:"2"`
+	RXJumbo *uint32 `yaml:"rxJumbo,omitempty" protobuf:"3"`
+	TX      *uint32 `yaml:"tx,omitempty" protobuf:"4"`
+}
+
+// EthernetChannelsSpec describes the number of channels.
+//
+//gotagsrewrite:gen
+type EthernetChannelsSpec struct {
+	RX       *uint32 `yaml:"rx,omitempty" protobuf:"1"`
+	TX       *uint32 `yaml:"tx,omitempty" protobuf:"2"`
+	Other    *uint32 `yaml:"other,omitempty" protobuf:"3"`
+	Combined *uint32 `yaml:"combined,omitempty" protobuf:"4"`
+}
+
+// EthernetPauseSpec describes the pause frames settings.
+//
+//gotagsrewrite:gen
+type EthernetPauseSpec struct {
+	Autonegotiate *bool `yaml:"autonegotiate,omitempty" protobuf:"1"`
+	RX            *bool `yaml:"rx,omitempty" protobuf:"2"`
+	TX            *bool `yaml:"tx,omitempty" protobuf:"3"`
+}
+
+// NewEthernetSpec initializes a EthernetSpec resource.
+func NewEthernetSpec(namespace resource.Namespace, id resource.ID) *EthernetSpec {
+	return typed.NewResource[EthernetSpecSpec, EthernetSpecExtension](
+		resource.NewMetadata(namespace, EthernetSpecType, id, resource.VersionUndefined),
+		EthernetSpecSpec{},
+	)
+}
+
+// EthernetSpecExtension provides auxiliary methods for EthernetSpec.
+type EthernetSpecExtension struct{}
+
+// ResourceDefinition implements [typed.Extension] interface.
+func (EthernetSpecExtension) ResourceDefinition() meta.ResourceDefinitionSpec {
+	return meta.ResourceDefinitionSpec{
+		Type:             EthernetSpecType,
+		Aliases:          []resource.Type{"ethspec", "ethspecs"},
+		DefaultNamespace: NamespaceName,
+	}
+}
+
+func init() {
+	proto.RegisterDefaultTypes()
+
+	err := protobuf.RegisterDynamic[EthernetSpecSpec](EthernetSpecType, &EthernetSpec{})
+	if err != nil {
+		panic(err)
+	}
+}
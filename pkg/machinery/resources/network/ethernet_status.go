@@ -0,0 +1,124 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package network
+
+import (
+	"github.com/cosi-project/runtime/pkg/resource"
+	"github.com/cosi-project/runtime/pkg/resource/meta"
+	"github.com/cosi-project/runtime/pkg/resource/protobuf"
+	"github.com/cosi-project/runtime/pkg/resource/typed"
+
+	"github.com/siderolabs/talos/pkg/machinery/proto"
+)
+
+// EthernetStatusType is type of EthernetStatus resource.
+const EthernetStatusType = resource.Type("EthernetStatuses.net.talos.dev")
+
+// EthernetStatus resource holds Ethernet settings of the physical link as reported by ethtool.
+//
+// The resource ID is the name of the link.
+type EthernetStatus = typed.Resource[EthernetStatusSpec, EthernetStatusExtension]
+
+// EthernetStatusSpec describes the Ethernet settings of the link.
+//
+// The settings which are not supported by the driver are not set.
+//
+//gotagsrewrite:gen
+type EthernetStatusSpec struct {
+	Rings    *EthernetRingsStatus    `yaml:"rings,omitempty" protobuf:"1"`
+	Channels *EthernetChannelsStatus `yaml:"channels,omitempty" protobuf:"2"`
+	Features []EthernetFeatureStatus `yaml:"features,omitempty" protobuf:"3"`
+	Pause    *EthernetPauseStatus    `yaml:"pause,omitempty" protobuf:"4"`
+}
+
+// EthernetRingsStatus describes the ring buffer sizes and their maximum values.
+//
+//gotagsrewrite:gen
+type EthernetRingsStatus struct {
+	RXMax      *uint32 `yaml:"rxMax,omitempty" protobuf:"1"`
+	RXMiniMax  *uint32 `yaml:"rxMiniMax,omitempty" protobuf:"2"`
+	RXJumboMax *uint32 `yaml:"rxJumboMax,omitempty" protobuf:"3"`
+	TXMax      *uint32 `yaml:"txMax,omitempty" protobuf:"4"`
+	RX         *uint32 `yaml:"rx,omitempty" protobuf:"5"`
+	RXMini     *uint32 `yaml:"rxMini,omitempty" protobuf:"6"`
+	RXJumbo    *uint32 `yaml:"rxJumbo,omitempty" protobuf:"7"`
+	TX         *uint32 `yaml:"tx,omitempty" protobuf:"8"`
+}
+
+// EthernetChannelsStatus describes the number of channels and their maximum values.
+//
+//gotagsrewrite:gen
+type EthernetChannelsStatus struct {
+	RXMax       *uint32 `yaml:"rxMax,omitempty" protobuf:"1"`
+	TXMax       *uint32 `yaml:"txMax,omitempty" protobuf:"2"`
+	OtherMax    *uint32 `yaml:"otherMax,omitempty" protobuf:"3"`
+	CombinedMax *uint32 `yaml:"combinedMax,omitempty" protobuf:"4"`
+	RX          *uint32 `yaml:"rx,omitempty" protobuf:"5"`
+	TX          *uint32 `yaml:"tx,omitempty" protobuf:"6"`
+	Other       *uint32 `yaml:"other,omitempty" protobuf:"7"`
+	Combined    *uint32 `yaml:"combined,omitempty" protobuf:"8"`
+}
+
+// EthernetFeatureStatus describes a feature (offload) of the link.
+//
+// Status is formatted the same way as `ethtool -k` does, e.g. `on` or `off [fixed]`.
+//
+//gotagsrewrite:gen
+type EthernetFeatureStatus struct {
+	Name   string `yaml:"name" protobuf:"1"`
+	Status string `yaml:"status" protobuf:"2"`
+}
+
+// EthernetPauseStatus describes the pause frames settings.
+//
+//gotagsrewrite:gen
+type EthernetPauseStatus struct {
+	Autonegotiate bool `yaml:"autonegotiate" protobuf:"1"`
+	RX            bool `yaml:"rx" protobuf:"2"`
+	TX            bool `yaml:"tx" protobuf:"3"`
+}
+
+// NewEthernetStatus initializes a EthernetStatus resource.
+func NewEthernetStatus(namespace resource.Namespace, id resource.ID) *EthernetStatus {
+	return typed.NewResource[EthernetStatusSpec, EthernetStatusExtension](
+		resource.NewMetadata(namespace, EthernetStatusType, id, resource.VersionUndefined),
+		EthernetStatusSpec{},
+	)
+}
+
+// EthernetStatusExtension provides auxiliary methods for EthernetStatus.
+type EthernetStatusExtension struct{}
+
+// ResourceDefinition implements [typed.Extension] interface.
+func (EthernetStatusExtension) ResourceDefinition() meta.ResourceDefinitionSpec {
+	return meta.ResourceDefinitionSpec{
+		Type:             EthernetStatusType,
+		Aliases:          []resource.Type{"ethernet", "ethtool"},
+		DefaultNamespace: NamespaceName,
+		PrintColumns: []meta.PrintColumn{
+			{
+				Name:     "RX Ring",
+				JSONPath: "{.rings.rx}",
+			},
+			{
+				Name:     "TX Ring",
+				JSONPath: "{.rings.tx}",
+			},
+			{
+				Name:     "Combined Channels",
+				JSONPath: "{.channels.combined}",
+			},
+		},
+	}
+}
+
+func init() {
+	proto.RegisterDefaultTypes()
+
+	err := protobuf.RegisterDynamic[EthernetStatusSpec](EthernetStatusType, &EthernetStatus{})
+	if err != nil {
+		panic(err)
+	}
+}
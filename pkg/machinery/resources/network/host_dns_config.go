@@ -0,0 +1,72 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package network
+
+import (
+	"net/netip"
+
+	"github.com/cosi-project/runtime/pkg/resource"
+	"github.com/cosi-project/runtime/pkg/resource/meta"
+	"github.com/cosi-project/runtime/pkg/resource/protobuf"
+	"github.com/cosi-project/runtime/pkg/resource/typed"
+
+	"github.com/siderolabs/talos/pkg/machinery/proto"
+)
+
+// HostDNSConfigType is type of HostDNSConfig resource.
+const HostDNSConfigType = resource.Type("HostDNSConfigs.net.talos.dev")
+
+// HostDNSConfigID is the resource ID for HostDNSConfig.
+const HostDNSConfigID resource.ID = "config"
+
+// HostDNSConfig resource holds the configuration of the caching DNS resolver on the host.
+type HostDNSConfig = typed.Resource[HostDNSConfigSpec, HostDNSConfigExtension]
+
+// HostDNSConfigSpec describes the caching DNS resolver on the host.
+//
+//gotagsrewrite:gen
+type HostDNSConfigSpec struct {
+	Enabled         bool             `yaml:"enabled" protobuf:"1"`
+	ListenAddresses []netip.AddrPort `yaml:"listenAddresses" protobuf:"2"`
+}
+
+// NewHostDNSConfig initializes a HostDNSConfig resource.
+func NewHostDNSConfig(namespace resource.Namespace, id resource.ID) *HostDNSConfig {
+	return typed.NewResource[HostDNSConfigSpec, HostDNSConfigExtension](
+		resource.NewMetadata(namespace, HostDNSConfigType, id, resource.VersionUndefined),
+		HostDNSConfigSpec{},
+	)
+}
+
+// HostDNSConfigExtension provides auxiliary methods for HostDNSConfig.
+type HostDNSConfigExtension struct{}
+
+// ResourceDefinition implements [typed.Extension] interface.
+func (HostDNSConfigExtension) ResourceDefinition() meta.ResourceDefinitionSpec {
+	return meta.ResourceDefinitionSpec{
+		Type:             HostDNSConfigType,
+		Aliases:          []resource.Type{},
+		DefaultNamespace: NamespaceName,
+		PrintColumns: []meta.PrintColumn{
+			{
+				Name:     "Enabled",
+				JSONPath: "{.enabled}",
+			},
+			{
+				Name:     "Listen Addresses",
+				JSONPath: "{.listenAddresses}",
+			},
+		},
+	}
+}
+
+func init() {
+	proto.RegisterDefaultTypes()
+
+	err := protobuf.RegisterDynamic[HostDNSConfigSpec](HostDNSConfigType, &HostDNSConfig{})
+	if err != nil {
+		panic(err)
+	}
+}
@@ -67,6 +67,40 @@ type STPSpec struct {
 	Enabled bool `yaml:"enabled" protobuf:"1"`
 }
 
+// VXLANSpec describes VXLAN settings if Kind == "vxlan".
+//
+//gotagsrewrite:gen
+type VXLANSpec struct {
+	// VNI is the VXLAN network identifier.
+	VNI uint32 `yaml:"vni" protobuf:"1"`
+
+	// Remote is the unicast destination address, mutually exclusive with Group.
+	Remote netip.Addr `yaml:"remote" protobuf:"2"`
+
+	// Group is the multicast group address, mutually exclusive with Remote.
+	Group netip.Addr `yaml:"group" protobuf:"3"`
+
+	// Local is the source address of the encapsulated packets.
+	Local netip.Addr `yaml:"local" protobuf:"4"`
+
+	// Port is the UDP destination port.
+	Port uint16 `yaml:"port" protobuf:"5"`
+}
+
+// MACVLANSpec describes macvlan settings if Kind == "macvlan" or Kind == "macvtap".
+//
+//gotagsrewrite:gen
+type MACVLANSpec struct {
+	Mode nethelpers.MACVLANMode `yaml:"mode" protobuf:"1"`
+}
+
+// IPVLANSpec describes ipvlan settings if Kind == "ipvlan".
+//
+//gotagsrewrite:gen
+type IPVLANSpec struct {
+	Mode nethelpers.IPVLANMode `yaml:"mode" protobuf:"1"`
+}
+
 // WireguardSpec describes Wireguard settings if Kind == "wireguard".
 //
 //gotagsrewrite:gen
@@ -77,6 +77,14 @@ func TestLinkSpecMarshalYAML(t *testing.T) {
 				},
 			},
 		},
+		VXLAN: network.VXLANSpec{
+			VNI:    42,
+			Remote: netip.MustParseAddr("10.0.0.2"),
+			Port:   4789,
+		},
+		MACVLAN: network.MACVLANSpec{
+			Mode: nethelpers.MACVLANModeBridge,
+		},
 		ConfigLayer: network.ConfigPlatform,
 	}
 
@@ -132,6 +140,14 @@ wireguard:
           persistentKeepaliveInterval: 30s
           allowedIPs:
             - 192.83.93.94/31
+vxlan:
+    vni: 42
+    remote: 10.0.0.2
+    group: ""
+    local: ""
+    port: 4789
+macvlan:
+    mode: bridge
 layer: platform
 `,
 		string(marshaled))
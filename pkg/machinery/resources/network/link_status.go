@@ -57,6 +57,9 @@ type LinkStatusSpec struct {
 	BridgeMaster BridgeMasterSpec `yaml:"bridgeMaster,omitempty" protobuf:"27"`
 	BondMaster   BondMasterSpec   `yaml:"bondMaster,omitempty" protobuf:"28"`
 	Wireguard    WireguardSpec    `yaml:"wireguard,omitempty" protobuf:"29"`
+	VXLAN        VXLANSpec        `yaml:"vxlan,omitempty" protobuf:"31"`
+	MACVLAN      MACVLANSpec      `yaml:"macvlan,omitempty" protobuf:"32"`
+	IPVLAN       IPVLANSpec       `yaml:"ipvlan,omitempty" protobuf:"33"`
 }
 
 // Physical checks if the link is physical ethernet.
@@ -27,12 +27,18 @@ func TestRegisterResource(t *testing.T) {
 	for _, resource := range []resource.Resource{
 		&network.AddressStatus{},
 		&network.AddressSpec{},
+		&network.DNSResolveCache{},
+		&network.DNSUpstream{},
+		&network.EthernetSpec{},
+		&network.EthernetStatus{},
 		&network.HardwareAddr{},
+		&network.HostDNSConfig{},
 		&network.HostnameStatus{},
 		&network.HostnameSpec{},
 		&network.LinkRefresh{},
 		&network.LinkStatus{},
 		&network.LinkSpec{},
+		&network.NfTablesChain{},
 		&network.NodeAddress{},
 		&network.NodeAddressFilter{},
 		&network.OperatorSpec{},
@@ -41,6 +47,9 @@ func TestRegisterResource(t *testing.T) {
 		&network.ResolverSpec{},
 		&network.RouteStatus{},
 		&network.RouteSpec{},
+		&network.RoutingRuleStatus{},
+		&network.RoutingRuleSpec{},
+		&network.RuleConfig{},
 		&network.Status{},
 		&network.TimeServerStatus{},
 		&network.TimeServerSpec{},
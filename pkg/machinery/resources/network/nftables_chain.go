@@ -0,0 +1,129 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package network
+
+import (
+	"net/netip"
+
+	"github.com/cosi-project/runtime/pkg/resource"
+	"github.com/cosi-project/runtime/pkg/resource/meta"
+	"github.com/cosi-project/runtime/pkg/resource/protobuf"
+	"github.com/cosi-project/runtime/pkg/resource/typed"
+
+	"github.com/siderolabs/talos/pkg/machinery/nethelpers"
+	"github.com/siderolabs/talos/pkg/machinery/proto"
+)
+
+// NfTablesChainType is type of NfTablesChain resource.
+const NfTablesChainType = resource.Type("NfTablesChains.net.talos.dev")
+
+// NfTablesChain resource holds the nftables chain rendered into the Talos nftables table.
+//
+// The resource ID is the name of the chain.
+type NfTablesChain = typed.Resource[NfTablesChainSpec, NfTablesChainExtension]
+
+// NfTablesChainSpec describes the nftables base chain.
+//
+//gotagsrewrite:gen
+type NfTablesChainSpec struct {
+	Hook     nethelpers.NfTablesChainHook `yaml:"hook" protobuf:"1"`
+	Priority int32                        `yaml:"priority" protobuf:"2"`
+	Policy   nethelpers.NfTablesVerdict   `yaml:"policy" protobuf:"3"`
+
+	Rules []NfTablesRule `yaml:"rules" protobuf:"4"`
+}
+
+// NfTablesRule describes a single rule in the chain.
+//
+// All the matches should match for the rule to apply, the verdict is optional.
+//
+//gotagsrewrite:gen
+type NfTablesRule struct {
+	MatchIIfName        string                       `yaml:"matchIIfName,omitempty" protobuf:"1"`
+	MatchSourceAddress  *NfTablesAddressMatch        `yaml:"matchSourceAddress,omitempty" protobuf:"2"`
+	MatchLayer4         *NfTablesLayer4Match         `yaml:"matchLayer4,omitempty" protobuf:"3"`
+	MatchConntrackState *NfTablesConntrackStateMatch `yaml:"matchConntrackState,omitempty" protobuf:"4"`
+
+	Verdict *nethelpers.NfTablesVerdict `yaml:"verdict,omitempty" protobuf:"5"`
+}
+
+// NfTablesAddressMatch matches the address against the subnets.
+//
+// The address matches if it is in one of IncludeSubnets and in none of ExcludeSubnets.
+//
+//gotagsrewrite:gen
+type NfTablesAddressMatch struct {
+	IncludeSubnets []netip.Prefix `yaml:"includeSubnets,omitempty" protobuf:"1"`
+	ExcludeSubnets []netip.Prefix `yaml:"excludeSubnets,omitempty" protobuf:"2"`
+}
+
+// NfTablesLayer4Match matches the layer 4 protocol and the ports.
+//
+//gotagsrewrite:gen
+type NfTablesLayer4Match struct {
+	Protocol             nethelpers.Protocol `yaml:"protocol" protobuf:"1"`
+	MatchDestinationPort *NfTablesPortMatch  `yaml:"matchDestinationPort,omitempty" protobuf:"2"`
+}
+
+// NfTablesPortMatch matches the port against the ranges.
+//
+//gotagsrewrite:gen
+type NfTablesPortMatch struct {
+	Ranges []PortRange `yaml:"ranges,omitempty" protobuf:"1"`
+}
+
+// NfTablesConntrackStateMatch matches the connection tracking state of the packet.
+//
+//gotagsrewrite:gen
+type NfTablesConntrackStateMatch struct {
+	States []nethelpers.ConntrackState `yaml:"states" protobuf:"1"`
+}
+
+// PortRange is an inclusive range of ports.
+//
+//gotagsrewrite:gen
+type PortRange struct {
+	Lo uint16 `yaml:"lo" protobuf:"1"`
+	Hi uint16 `yaml:"hi" protobuf:"2"`
+}
+
+// NewNfTablesChain initializes a NfTablesChain resource.
+func NewNfTablesChain(namespace resource.Namespace, id resource.ID) *NfTablesChain {
+	return typed.NewResource[NfTablesChainSpec, NfTablesChainExtension](
+		resource.NewMetadata(namespace, NfTablesChainType, id, resource.VersionUndefined),
+		NfTablesChainSpec{},
+	)
+}
+
+// NfTablesChainExtension provides auxiliary methods for NfTablesChain.
+type NfTablesChainExtension struct{}
+
+// ResourceDefinition implements [typed.Extension] interface.
+func (NfTablesChainExtension) ResourceDefinition() meta.ResourceDefinitionSpec {
+	return meta.ResourceDefinitionSpec{
+		Type:             NfTablesChainType,
+		Aliases:          []resource.Type{"chain", "chains"},
+		DefaultNamespace: NamespaceName,
+		PrintColumns: []meta.PrintColumn{
+			{
+				Name:     "Hook",
+				JSONPath: "{.hook}",
+			},
+			{
+				Name:     "Policy",
+				JSONPath: "{.policy}",
+			},
+		},
+	}
+}
+
+func init() {
+	proto.RegisterDefaultTypes()
+
+	err := protobuf.RegisterDynamic[NfTablesChainSpec](NfTablesChainType, &NfTablesChain{})
+	if err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,54 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package network_test
+
+import (
+	"net/netip"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gopkg.in/yaml.v3"
+
+	"github.com/siderolabs/talos/pkg/machinery/nethelpers"
+	"github.com/siderolabs/talos/pkg/machinery/resources/network"
+)
+
+func TestRoutingRuleSpecMarshalYAML(t *testing.T) {
+	spec := network.RoutingRuleSpecSpec{
+		Family:      nethelpers.FamilyInet4,
+		Source:      netip.MustParsePrefix("10.10.0.0/24"),
+		IIFName:     "eth1",
+		FwMark:      0x100,
+		FwMask:      0xff00,
+		Table:       nethelpers.TableMain,
+		Priority:    1000,
+		Protocol:    nethelpers.ProtocolStatic,
+		ConfigLayer: network.ConfigMachineConfiguration,
+	}
+
+	marshaled, err := yaml.Marshal(spec)
+	require.NoError(t, err)
+
+	assert.Equal(t,
+		`family: inet4
+src: 10.10.0.0/24
+dst: ""
+iifName: eth1
+fwMark: 256
+fwMask: 65280
+table: main
+priority: 1000
+protocol: static
+layer: configuration
+`,
+		string(marshaled))
+
+	var spec2 network.RoutingRuleSpecSpec
+
+	require.NoError(t, yaml.Unmarshal(marshaled, &spec2))
+
+	assert.Equal(t, spec, spec2)
+}
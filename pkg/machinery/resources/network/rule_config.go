@@ -0,0 +1,86 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package network
+
+import (
+	"net/netip"
+
+	"github.com/cosi-project/runtime/pkg/resource"
+	"github.com/cosi-project/runtime/pkg/resource/meta"
+	"github.com/cosi-project/runtime/pkg/resource/protobuf"
+	"github.com/cosi-project/runtime/pkg/resource/typed"
+
+	"github.com/siderolabs/talos/pkg/machinery/nethelpers"
+	"github.com/siderolabs/talos/pkg/machinery/proto"
+)
+
+// RuleConfigType is type of RuleConfig resource.
+const RuleConfigType = resource.Type("NetworkRuleConfigs.net.talos.dev")
+
+// RuleConfig resource holds the host firewall ingress rule.
+//
+// The resource ID is the name of the rule.
+type RuleConfig = typed.Resource[RuleConfigSpec, RuleConfigExtension]
+
+// RuleConfigSpec describes the ingress rule of the host firewall.
+//
+//gotagsrewrite:gen
+type RuleConfigSpec struct {
+	PortSelector RulePortSelector `yaml:"portSelector" protobuf:"1"`
+	Ingress      []IngressRule    `yaml:"ingress" protobuf:"2"`
+}
+
+// RulePortSelector selects the traffic the rule applies to.
+//
+//gotagsrewrite:gen
+type RulePortSelector struct {
+	Ports    []PortRange         `yaml:"ports,omitempty" protobuf:"1"`
+	Protocol nethelpers.Protocol `yaml:"protocol" protobuf:"2"`
+}
+
+// IngressRule describes the source subnet of the traffic.
+//
+// Empty list of ingress rules accepts the traffic from any address.
+//
+//gotagsrewrite:gen
+type IngressRule struct {
+	Subnet netip.Prefix `yaml:"subnet" protobuf:"1"`
+	Except netip.Prefix `yaml:"except,omitempty" protobuf:"2"`
+}
+
+// NewRuleConfig initializes a RuleConfig resource.
+func NewRuleConfig(namespace resource.Namespace, id resource.ID) *RuleConfig {
+	return typed.NewResource[RuleConfigSpec, RuleConfigExtension](
+		resource.NewMetadata(namespace, RuleConfigType, id, resource.VersionUndefined),
+		RuleConfigSpec{},
+	)
+}
+
+// RuleConfigExtension provides auxiliary methods for RuleConfig.
+type RuleConfigExtension struct{}
+
+// ResourceDefinition implements [typed.Extension] interface.
+func (RuleConfigExtension) ResourceDefinition() meta.ResourceDefinitionSpec {
+	return meta.ResourceDefinitionSpec{
+		Type:             RuleConfigType,
+		Aliases:          []resource.Type{"netrule", "netrules"},
+		DefaultNamespace: NamespaceName,
+		PrintColumns: []meta.PrintColumn{
+			{
+				Name:     "Protocol",
+				JSONPath: "{.portSelector.protocol}",
+			},
+		},
+	}
+}
+
+func init() {
+	proto.RegisterDefaultTypes()
+
+	err := protobuf.RegisterDynamic[RuleConfigSpec](RuleConfigType, &RuleConfig{})
+	if err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,70 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package runtime
+
+import (
+	"github.com/cosi-project/runtime/pkg/resource"
+	"github.com/cosi-project/runtime/pkg/resource/meta"
+	"github.com/cosi-project/runtime/pkg/resource/protobuf"
+	"github.com/cosi-project/runtime/pkg/resource/typed"
+
+	"github.com/siderolabs/talos/pkg/machinery/proto"
+)
+
+// BootloaderStatusType is type of BootloaderStatus resource.
+const BootloaderStatusType = resource.Type("BootloaderStatuses.runtime.talos.dev")
+
+// BootloaderStatusID is the ID of the BootloaderStatus resource.
+const BootloaderStatusID = resource.ID("bootloader")
+
+// BootloaderStatus resource holds the version of the bootloader installed to the disk.
+type BootloaderStatus = typed.Resource[BootloaderStatusSpec, BootloaderStatusExtension]
+
+// BootloaderStatusSpec describes the installed bootloader.
+//
+//gotagsrewrite:gen
+type BootloaderStatusSpec struct {
+	Name    string `yaml:"name" protobuf:"1"`
+	Version string `yaml:"version" protobuf:"2"`
+}
+
+// NewBootloaderStatus initializes a BootloaderStatus resource.
+func NewBootloaderStatus(namespace resource.Namespace, id resource.ID) *BootloaderStatus {
+	return typed.NewResource[BootloaderStatusSpec, BootloaderStatusExtension](
+		resource.NewMetadata(namespace, BootloaderStatusType, id, resource.VersionUndefined),
+		BootloaderStatusSpec{},
+	)
+}
+
+// BootloaderStatusExtension is auxiliary resource data for BootloaderStatus.
+type BootloaderStatusExtension struct{}
+
+// ResourceDefinition implements meta.ResourceDefinitionProvider interface.
+func (BootloaderStatusExtension) ResourceDefinition() meta.ResourceDefinitionSpec {
+	return meta.ResourceDefinitionSpec{
+		Type:             BootloaderStatusType,
+		Aliases:          []resource.Type{"bootloader"},
+		DefaultNamespace: NamespaceName,
+		PrintColumns: []meta.PrintColumn{
+			{
+				Name:     "Name",
+				JSONPath: `{.name}`,
+			},
+			{
+				Name:     "Version",
+				JSONPath: `{.version}`,
+			},
+		},
+	}
+}
+
+func init() {
+	proto.RegisterDefaultTypes()
+
+	err := protobuf.RegisterDynamic[BootloaderStatusSpec](BootloaderStatusType, &BootloaderStatus{})
+	if err != nil {
+		panic(err)
+	}
+}
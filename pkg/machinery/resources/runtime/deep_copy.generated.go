@@ -2,10 +2,16 @@
 // License, v. 2.0. If a copy of the MPL was not distributed with this
 // file, You can obtain one at http://mozilla.org/MPL/2.0/.
 
-// Code generated by "deep-copy -type KernelModuleSpecSpec -type KernelParamSpecSpec -type KernelParamStatusSpec -type MachineStatusSpec -type MetaKeySpec -type MountStatusSpec -type PlatformMetadataSpec -header-file ../../../../hack/boilerplate.txt -o deep_copy.generated.go ."; DO NOT EDIT.
+// Code generated by "deep-copy -type BootloaderStatusSpec -type KernelModuleSpecSpec -type KernelParamSpecSpec -type KernelParamStatusSpec -type MachineStatusSpec -type MetaKeySpec -type MountStatusSpec -type PlatformMetadataSpec -type WatchdogTimerDisarmSignalSpec -type WatchdogTimerStatusSpec -header-file ../../../../hack/boilerplate.txt -o deep_copy.generated.go ."; DO NOT EDIT.
 
 package runtime
 
+// DeepCopy generates a deep copy of BootloaderStatusSpec.
+func (o BootloaderStatusSpec) DeepCopy() BootloaderStatusSpec {
+	var cp BootloaderStatusSpec = o
+	return cp
+}
+
 // DeepCopy generates a deep copy of KernelModuleSpecSpec.
 func (o KernelModuleSpecSpec) DeepCopy() KernelModuleSpecSpec {
 	var cp KernelModuleSpecSpec = o
@@ -59,3 +65,15 @@ func (o PlatformMetadataSpec) DeepCopy() PlatformMetadataSpec {
 	var cp PlatformMetadataSpec = o
 	return cp
 }
+
+// DeepCopy generates a deep copy of WatchdogTimerDisarmSignalSpec.
+func (o WatchdogTimerDisarmSignalSpec) DeepCopy() WatchdogTimerDisarmSignalSpec {
+	var cp WatchdogTimerDisarmSignalSpec = o
+	return cp
+}
+
+// DeepCopy generates a deep copy of WatchdogTimerStatusSpec.
+func (o WatchdogTimerStatusSpec) DeepCopy() WatchdogTimerStatusSpec {
+	var cp WatchdogTimerStatusSpec = o
+	return cp
+}
@@ -25,6 +25,7 @@ func TestRegisterResource(t *testing.T) {
 	resourceRegistry := registry.NewResourceRegistry(resources)
 
 	for _, resource := range []resource.Resource{
+		&runtime.BootloaderStatus{},
 		&runtime.ExtensionStatus{},
 		&runtime.KernelModuleSpec{},
 		&runtime.KernelParamSpec{},
@@ -33,6 +34,8 @@ func TestRegisterResource(t *testing.T) {
 		&runtime.MetaKey{},
 		&runtime.MountStatus{},
 		&runtime.PlatformMetadata{},
+		&runtime.WatchdogTimerDisarmSignal{},
+		&runtime.WatchdogTimerStatus{},
 	} {
 		assert.NoError(t, resourceRegistry.Register(ctx, resource))
 	}
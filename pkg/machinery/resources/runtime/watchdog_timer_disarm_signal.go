@@ -0,0 +1,57 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package runtime
+
+import (
+	"github.com/cosi-project/runtime/pkg/resource"
+	"github.com/cosi-project/runtime/pkg/resource/meta"
+	"github.com/cosi-project/runtime/pkg/resource/protobuf"
+	"github.com/cosi-project/runtime/pkg/resource/typed"
+
+	"github.com/siderolabs/talos/pkg/machinery/proto"
+)
+
+// WatchdogTimerDisarmSignalType is type of WatchdogTimerDisarmSignal resource.
+const WatchdogTimerDisarmSignalType = resource.Type("WatchdogTimerDisarmSignals.runtime.talos.dev")
+
+// WatchdogTimerDisarmSignalID is the singleton ID of the resource.
+const WatchdogTimerDisarmSignalID = resource.ID("timer")
+
+// WatchdogTimerDisarmSignal resource is created by the reboot and shutdown sequences to disarm the watchdog timer.
+//
+// The watchdog timer stays disarmed while the resource exists.
+type WatchdogTimerDisarmSignal = typed.Resource[WatchdogTimerDisarmSignalSpec, WatchdogTimerDisarmSignalExtension]
+
+// WatchdogTimerDisarmSignalSpec is empty.
+type WatchdogTimerDisarmSignalSpec struct{}
+
+// NewWatchdogTimerDisarmSignal initializes an empty WatchdogTimerDisarmSignal resource.
+func NewWatchdogTimerDisarmSignal(namespace resource.Namespace, id resource.ID) *WatchdogTimerDisarmSignal {
+	return typed.NewResource[WatchdogTimerDisarmSignalSpec, WatchdogTimerDisarmSignalExtension](
+		resource.NewMetadata(namespace, WatchdogTimerDisarmSignalType, id, resource.VersionUndefined),
+		WatchdogTimerDisarmSignalSpec{},
+	)
+}
+
+// WatchdogTimerDisarmSignalExtension provides auxiliary methods for WatchdogTimerDisarmSignal.
+type WatchdogTimerDisarmSignalExtension struct{}
+
+// ResourceDefinition implements [typed.Extension] interface.
+func (WatchdogTimerDisarmSignalExtension) ResourceDefinition() meta.ResourceDefinitionSpec {
+	return meta.ResourceDefinitionSpec{
+		Type:             WatchdogTimerDisarmSignalType,
+		Aliases:          []resource.Type{},
+		DefaultNamespace: NamespaceName,
+	}
+}
+
+func init() {
+	proto.RegisterDefaultTypes()
+
+	err := protobuf.RegisterDynamic[WatchdogTimerDisarmSignalSpec](WatchdogTimerDisarmSignalType, &WatchdogTimerDisarmSignal{})
+	if err != nil {
+		panic(err)
+	}
+}